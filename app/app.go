@@ -571,7 +571,7 @@ func NewWasmApp(
 		AddRoute(ibcmock.ModuleName, mockIBCModule)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
-	app.govKeeper = govkeeper.NewKeeper(
+	govKeeper := govkeeper.NewKeeper(
 		appCodec,
 		keys[govtypes.StoreKey],
 		app.getSubspace(govtypes.ModuleName),
@@ -580,6 +580,12 @@ func NewWasmApp(
 		&stakingKeeper,
 		govRouter,
 	)
+	app.govKeeper = *govKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(
+			// register the governance hooks to record the proposal id of contract deactivations
+			wasmkeeper.NewGovHooks(&app.wasmKeeper),
+		),
+	)
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -749,6 +755,7 @@ func NewWasmApp(
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			IBCKeeper:         app.ibcKeeper,
+			WasmKeeper:        &app.wasmKeeper,
 			WasmConfig:        &wasmConfig,
 			TXCounterStoreKey: keys[wasm.StoreKey],
 		},
@@ -0,0 +1,36 @@
+package wasm
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// BeginBlocker calls the privileged contracts that are registered for begin block
+func BeginBlocker(ctx sdk.Context, k *Keeper) {
+	if err := k.BeginBlockPrivilegedContracts(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// EndBlocker activates the inactive contracts with an expired deactivation, deletes the remaining state of purged
+// contracts and expired upload sessions, executes the queued migrations and the scheduled callbacks that are due and
+// calls the privileged contracts that are registered for end block
+func EndBlocker(ctx sdk.Context, k *Keeper) {
+	if err := k.ActivateExpiredContracts(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.DeletePurgedContractStates(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.DeleteExpiredUploadSessions(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.ExecuteQueuedMigrations(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.ExecuteSchedules(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.EndBlockPrivilegedContracts(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -97,6 +97,8 @@ type (
 	MsgStoreCodeResponse                       = types.MsgStoreCodeResponse
 	MsgInstantiateContract                     = types.MsgInstantiateContract
 	MsgInstantiateContractResponse             = types.MsgInstantiateContractResponse
+	MsgInstantiateContract2                    = types.MsgInstantiateContract2
+	MsgInstantiateContract2Response            = types.MsgInstantiateContract2Response
 	MsgStoreCodeAndInstantiateContract         = lbmtypes.MsgStoreCodeAndInstantiateContract
 	MsgStoreCodeAndInstantiateContractResponse = lbmtypes.MsgStoreCodeAndInstantiateContractResponse
 	MsgExecuteContract                         = types.MsgExecuteContract
@@ -108,6 +110,41 @@ type (
 	MsgClearAdmin                              = types.MsgClearAdmin
 	MsgWasmIBCCall                             = types.MsgIBCSend
 	MsgClearAdminResponse                      = types.MsgClearAdminResponse
+	MsgUpdateParams                            = types.MsgUpdateParams
+	MsgUpdateParamsResponse                    = types.MsgUpdateParamsResponse
+	MsgPurgeContract                           = lbmtypes.MsgPurgeContract
+	MsgPurgeContractResponse                   = lbmtypes.MsgPurgeContractResponse
+	MsgStoreCodeBegin                          = lbmtypes.MsgStoreCodeBegin
+	MsgStoreCodeBeginResponse                  = lbmtypes.MsgStoreCodeBeginResponse
+	MsgStoreCodeChunk                          = lbmtypes.MsgStoreCodeChunk
+	MsgStoreCodeChunkResponse                  = lbmtypes.MsgStoreCodeChunkResponse
+	MsgStoreCodeCommit                         = lbmtypes.MsgStoreCodeCommit
+	MsgStoreCodeCommitResponse                 = lbmtypes.MsgStoreCodeCommitResponse
+	MsgProposeAdmin                            = lbmtypes.MsgProposeAdmin
+	MsgProposeAdminResponse                    = lbmtypes.MsgProposeAdminResponse
+	MsgAcceptAdmin                             = lbmtypes.MsgAcceptAdmin
+	MsgAcceptAdminResponse                     = lbmtypes.MsgAcceptAdminResponse
+	MsgCancelPendingAdmin                      = lbmtypes.MsgCancelPendingAdmin
+	MsgCancelPendingAdminResponse              = lbmtypes.MsgCancelPendingAdminResponse
+	MsgUpdateMigrationDelay                    = lbmtypes.MsgUpdateMigrationDelay
+	MsgUpdateMigrationDelayResponse            = lbmtypes.MsgUpdateMigrationDelayResponse
+	MsgCancelMigration                         = lbmtypes.MsgCancelMigration
+	MsgCancelMigrationResponse                 = lbmtypes.MsgCancelMigrationResponse
+	MsgUpdateMigrationAllowlist                = lbmtypes.MsgUpdateMigrationAllowlist
+	MsgUpdateMigrationAllowlistResponse        = lbmtypes.MsgUpdateMigrationAllowlistResponse
+	MsgSetCodeMetadata                         = lbmtypes.MsgSetCodeMetadata
+	MsgSetCodeMetadataResponse                 = lbmtypes.MsgSetCodeMetadataResponse
+	MsgUpdateContractMetadata                  = lbmtypes.MsgUpdateContractMetadata
+	MsgUpdateContractMetadataResponse          = lbmtypes.MsgUpdateContractMetadataResponse
+	MsgUpdateFeeAllowance                      = lbmtypes.MsgUpdateFeeAllowance
+	MsgUpdateFeeAllowanceResponse              = lbmtypes.MsgUpdateFeeAllowanceResponse
+	MsgScheduleCallback                        = lbmtypes.MsgScheduleCallback
+	MsgScheduleCallbackResponse                = lbmtypes.MsgScheduleCallbackResponse
+	MsgCancelSchedule                          = lbmtypes.MsgCancelSchedule
+	MsgCancelScheduleResponse                  = lbmtypes.MsgCancelScheduleResponse
+	MsgExecuteContracts                        = lbmtypes.MsgExecuteContracts
+	MsgExecuteContractsResponse                = lbmtypes.MsgExecuteContractsResponse
+	ContractCall                               = lbmtypes.ContractCall
 	MsgServer                                  = types.MsgServer
 	Model                                      = types.Model
 	CodeInfo                                   = types.CodeInfo
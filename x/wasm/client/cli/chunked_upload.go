@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	flag "github.com/spf13/pflag"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/input"
+	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+const (
+	flagChunked   = "chunked"
+	flagChunkSize = "chunk-size"
+
+	defaultChunkSize = 256 * 1024
+)
+
+// storeCodeChunked uploads the code of the store code msg in a sequence of txs: one to begin the upload session, one
+// for each chunk and a final one to commit the session. The code metadata is set with another tx if given. Each tx is
+// broadcast in block mode as the following tx depends on its result. The sequence is confirmed once and all txs are
+// signed without further confirmation.
+func storeCodeChunked(clientCtx client.Context, flagSet *flag.FlagSet, msg types.MsgStoreCode) error {
+	if clientCtx.GenerateOnly || clientCtx.Simulate {
+		return fmt.Errorf("--%s can not be combined with --%s or --%s", flagChunked, flags.FlagGenerateOnly, flags.FlagDryRun)
+	}
+	chunkSize, err := flagSet.GetUint64(flagChunkSize)
+	if err != nil {
+		return fmt.Errorf("chunk size: %s", err)
+	}
+	if chunkSize == 0 {
+		return fmt.Errorf("chunk size must be greater than 0")
+	}
+	chunks := (uint64(len(msg.WASMByteCode)) + chunkSize - 1) / chunkSize
+	txs := chunks + 2
+	if msg.Metadata != nil {
+		txs++
+	}
+	if !clientCtx.SkipConfirm {
+		prompt := fmt.Sprintf("upload %d bytes in %d chunks with %d txs", len(msg.WASMByteCode), chunks, txs)
+		ok, err := input.GetConfirmation(prompt, bufio.NewReader(os.Stdin), os.Stderr)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled upload")
+			return err
+		}
+	}
+	clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastBlock)
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet).Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.Sum256(msg.WASMByteCode)
+	beginMsg := lbmtypes.MsgStoreCodeBegin{
+		Sender:                msg.Sender,
+		Checksum:              checksum[:],
+		TotalSize:             uint64(len(msg.WASMByteCode)),
+		InstantiatePermission: msg.InstantiatePermission,
+	}
+	var beginRsp lbmtypes.MsgStoreCodeBeginResponse
+	if txf, err = broadcastChunkedUploadTx(clientCtx, txf, &beginMsg, &beginRsp); err != nil {
+		return fmt.Errorf("begin upload session: %s", err)
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "upload session %d started, expires at height %d\n", beginRsp.SessionID, beginRsp.ExpiryHeight)
+
+	code := msg.WASMByteCode
+	for start := uint64(0); start < uint64(len(code)); start += chunkSize {
+		end := start + chunkSize
+		if end > uint64(len(code)) {
+			end = uint64(len(code))
+		}
+		chunkMsg := lbmtypes.MsgStoreCodeChunk{
+			Sender:    msg.Sender,
+			SessionID: beginRsp.SessionID,
+			Data:      code[start:end],
+		}
+		var chunkRsp lbmtypes.MsgStoreCodeChunkResponse
+		if txf, err = broadcastChunkedUploadTx(clientCtx, txf, &chunkMsg, &chunkRsp); err != nil {
+			return fmt.Errorf("upload chunk at offset %d: %s", start, err)
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "uploaded %d of %d bytes\n", chunkRsp.ReceivedSize, len(code))
+	}
+
+	commitMsg := lbmtypes.MsgStoreCodeCommit{
+		Sender:    msg.Sender,
+		SessionID: beginRsp.SessionID,
+	}
+	var commitRsp lbmtypes.MsgStoreCodeCommitResponse
+	if txf, err = broadcastChunkedUploadTx(clientCtx, txf, &commitMsg, &commitRsp); err != nil {
+		return fmt.Errorf("commit upload session: %s", err)
+	}
+
+	if msg.Metadata != nil {
+		metadataMsg := lbmtypes.MsgSetCodeMetadata{
+			Sender:   msg.Sender,
+			CodeID:   commitRsp.CodeID,
+			Metadata: *msg.Metadata,
+		}
+		var metadataRsp lbmtypes.MsgSetCodeMetadataResponse
+		if _, err = broadcastChunkedUploadTx(clientCtx, txf, &metadataMsg, &metadataRsp); err != nil {
+			return fmt.Errorf("set code metadata of code %d: %s", commitRsp.CodeID, err)
+		}
+	}
+	return clientCtx.PrintProto(&commitRsp)
+}
+
+// broadcastChunkedUploadTx signs and broadcasts a tx with the single msg and decodes the msg response. It returns the
+// factory with the sequence of the next tx.
+func broadcastChunkedUploadTx(clientCtx client.Context, txf tx.Factory, msg sdk.Msg, rsp proto.Message) (tx.Factory, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return txf, err
+	}
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return txf, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+	txBuilder, err := tx.BuildUnsignedTx(txf, msg)
+	if err != nil {
+		return txf, err
+	}
+	txBuilder.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return txf, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return txf, err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return txf, err
+	}
+	if res.Code != 0 {
+		return txf, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	txf = txf.WithSequence(txf.Sequence() + 1)
+
+	bz, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return txf, err
+	}
+	var txMsgData sdk.TxMsgData
+	if err := txMsgData.Unmarshal(bz); err != nil {
+		return txf, err
+	}
+	if len(txMsgData.Data) != 1 {
+		return txf, fmt.Errorf("unexpected number of msg responses: %d", len(txMsgData.Data))
+	}
+	return txf, proto.Unmarshal(txMsgData.Data[0].Data, rsp)
+}
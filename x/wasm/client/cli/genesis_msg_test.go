@@ -69,6 +69,25 @@ func TestGenesisStoreCodeCmd(t *testing.T) {
 				flagSet.Set("run-as", defaultTestKeyName)
 			},
 		},
+		"all good with any of addresses instantiate permission": {
+			srcGenesis: minimalWasmGenesis,
+			mutator: func(cmd *cobra.Command) {
+				cmd.SetArgs([]string{anyValidWasmFile.Name()})
+				flagSet := cmd.Flags()
+				flagSet.Set("run-as", keeper.RandomBech32AccountAddress(t))
+				flagSet.Set("instantiate-anyof-addresses", keeper.RandomBech32AccountAddress(t)+","+keeper.RandomBech32AccountAddress(t))
+			},
+		},
+		"with invalid any of addresses should fail": {
+			srcGenesis: minimalWasmGenesis,
+			mutator: func(cmd *cobra.Command) {
+				cmd.SetArgs([]string{anyValidWasmFile.Name()})
+				flagSet := cmd.Flags()
+				flagSet.Set("run-as", keeper.RandomBech32AccountAddress(t))
+				flagSet.Set("instantiate-anyof-addresses", "invalid address")
+			},
+			expError: true,
+		},
 		"with unknown actor key name should fail": {
 			srcGenesis: minimalWasmGenesis,
 			mutator: func(cmd *cobra.Command) {
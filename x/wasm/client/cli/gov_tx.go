@@ -64,6 +64,7 @@ func ProposalStoreCodeCmd() *cobra.Command {
 				RunAs:                 runAs,
 				WASMByteCode:          src.WASMByteCode,
 				InstantiatePermission: src.InstantiatePermission,
+				Metadata:              src.Metadata,
 			}
 
 			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
@@ -82,6 +83,8 @@ func ProposalStoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
+	addCodeMetadataFlags(cmd)
 
 	// proposal flags
 	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
@@ -626,6 +629,10 @@ func parseAccessConfig(config string) (types.AccessConfig, error) {
 	case "everybody":
 		return types.AllowEverybody, nil
 	default:
+		addrs := strings.Split(config, ",")
+		if len(addrs) > 1 {
+			return parseAnyOfAddresses(addrs)
+		}
 		address, err := sdk.AccAddressFromBech32(config)
 		if err != nil {
 			return types.AccessConfig{}, fmt.Errorf("unable to parse address %s", config)
@@ -638,8 +645,8 @@ func parseAccessConfigUpdates(args []string) ([]types.AccessConfigUpdate, error)
 	updates := make([]types.AccessConfigUpdate, len(args))
 	for i, c := range args {
 		// format: code_id,access_config
-		// access_config: nobody|everybody|address
-		parts := strings.Split(c, ",")
+		// access_config: nobody|everybody|address(es)
+		parts := strings.SplitN(c, ",", 2)
 		if len(parts) != 2 {
 			return nil, fmt.Errorf("invalid format")
 		}
@@ -671,8 +678,8 @@ func ProposalUpdateInstantiateConfigCmd() *cobra.Command {
 			fmt.Sprintf(`Submit an update instantiate config  proposal for multiple code ids.
 
 Example: 
-$ %s tx gov submit-proposal update-instantiate-config 1,nobody 2,everybody 3,%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm
-`, version.AppName, bech32Prefix)),
+$ %s tx gov submit-proposal update-instantiate-config 1,nobody 2,everybody 3,%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 4,%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm,%s1vx8knpllrj7n963p9ttd80w47kpacrhuts497x
+`, version.AppName, bech32Prefix, bech32Prefix, bech32Prefix)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -831,3 +838,530 @@ func ProposalActivateContractCmd() *cobra.Command {
 
 	return cmd
 }
+
+func ProposalPurgeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-contract [contract_addr_bech32] [beneficiary_addr_bech32]",
+		Short: "Delete the contract with its state and send the remaining balance to the beneficiary.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := lbmtypes.PurgeContractProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				Beneficiary: args[1],
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalRemoveCodesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-codes [code-ids]",
+		Short: "Remove codes that have no contract instances. The codes can not be instantiated after that.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			codeIds, err := parsePinCodesArgs(args)
+			if err != nil {
+				return err
+			}
+
+			content := lbmtypes.RemoveCodesProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				CodeIDs:     codeIds,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-wasm-params [params-json]",
+		Short: "Submit an update wasm params proposal. All params must be set.",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal update-wasm-params '{"code_upload_access":{"permission":"Everybody"},"instantiate_default_permission":"Everybody","gas_multiplier":"140000000","instance_cost":"60000","compile_cost":"3","max_wasm_size":"819200","max_label_size":"128","max_decompressed_wasm_size":"819200"}'`,
+			version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &params); err != nil {
+				return errors.Wrap(err, "params")
+			}
+
+			content := lbmtypes.UpdateParamsProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Params:      params,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalUpdateMigrationAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-migration-allowlist [contract_addr_bech32]",
+		Short: "Submit a proposal to set the code ids and checksums a contract can be migrated to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			codeIDs, checksums, err := parseMigrationAllowlistFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := lbmtypes.UpdateMigrationAllowlistProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				CodeIDs:     codeIDs,
+				Checksums:   checksums,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMigrationAllowlistFlags(cmd)
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalUpdateContractStorageQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-contract-storage-quota [contract_addr_bech32]",
+		Short: "Submit a proposal to override the default storage quota of a contract",
+		Long:  "Submit a proposal to override the default storage quota of a contract. A zero limit falls back to the default limit of the params, --unlimited exempts the contract from all limits.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			maxBytes, err := cmd.Flags().GetUint64(flagMaxBytes)
+			if err != nil {
+				return fmt.Errorf("max bytes: %s", err)
+			}
+			maxKeys, err := cmd.Flags().GetUint64(flagMaxKeys)
+			if err != nil {
+				return fmt.Errorf("max keys: %s", err)
+			}
+			unlimited, err := cmd.Flags().GetBool(flagUnlimited)
+			if err != nil {
+				return fmt.Errorf("unlimited: %s", err)
+			}
+
+			content := lbmtypes.UpdateContractStorageQuotaProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				Quota:       types.StorageQuota{MaxBytes: maxBytes, MaxKeys: maxKeys, Unlimited: unlimited},
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxBytes, 0, "Max size in bytes of all keys and values in the contract state")
+	cmd.Flags().Uint64(flagMaxKeys, 0, "Max number of keys in the contract state")
+	cmd.Flags().Bool(flagUnlimited, false, "Exempt the contract from all limits including the defaults of the params")
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalRegisterPrivilegedContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-privileged-contract [contract_addr_bech32]",
+		Short: "Submit a proposal to call a contract with sudo on every begin and/or end block",
+		Long:  "Submit a proposal to call a contract with sudo on every begin and/or end block. Registering a contract again replaces the previous registration.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			beginBlock, err := cmd.Flags().GetBool(flagBeginBlock)
+			if err != nil {
+				return fmt.Errorf("begin block: %s", err)
+			}
+			endBlock, err := cmd.Flags().GetBool(flagEndBlock)
+			if err != nil {
+				return fmt.Errorf("end block: %s", err)
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagCallGasLimit)
+			if err != nil {
+				return fmt.Errorf("call gas limit: %s", err)
+			}
+
+			content := lbmtypes.RegisterPrivilegedContractProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+				BeginBlock:  beginBlock,
+				EndBlock:    endBlock,
+				GasLimit:    gasLimit,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagBeginBlock, false, "Call the contract on every begin block")
+	cmd.Flags().Bool(flagEndBlock, false, "Call the contract on every end block")
+	cmd.Flags().Uint64(flagCallGasLimit, 0, "Gas limit of each call")
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalUnregisterPrivilegedContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister-privileged-contract [contract_addr_bech32]",
+		Short: "Submit a proposal to stop the begin and end block calls of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := lbmtypes.UnregisterPrivilegedContractProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Contract:    args[0],
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func ProposalUpdateAcceptedStargateQueriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-accepted-stargate-queries --accept [path=response_type] --accept-json [path=response_type] --remove [path]",
+		Short: "Submit a proposal to update the gRPC query paths that contracts can use for stargate queries",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the gRPC query paths that contracts can use for stargate queries.
+The response of an accepted path is returned protobuf encoded, or json encoded when it is accepted with --accept-json.
+
+Example:
+$ %s tx gov submit-proposal update-accepted-stargate-queries \
+	--accept /cosmos.bank.v1beta1.Query/Balance=cosmos.bank.v1beta1.QueryBalanceResponse \
+	--remove /cosmos.bank.v1beta1.Query/AllBalances
+`, version.AppName)),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return fmt.Errorf("proposal title: %s", err)
+			}
+			proposalDescr, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return fmt.Errorf("proposal description: %s", err)
+			}
+			depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return fmt.Errorf("deposit: %s", err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+			acceptArgs, err := cmd.Flags().GetStringArray(flagAcceptQuery)
+			if err != nil {
+				return fmt.Errorf("accept: %s", err)
+			}
+			accept, err := parseAcceptedStargateQueries(acceptArgs, types.StargateQueryEncodingProtobuf)
+			if err != nil {
+				return err
+			}
+			acceptJSONArgs, err := cmd.Flags().GetStringArray(flagAcceptQueryJSON)
+			if err != nil {
+				return fmt.Errorf("accept json: %s", err)
+			}
+			acceptJSON, err := parseAcceptedStargateQueries(acceptJSONArgs, types.StargateQueryEncodingJSON)
+			if err != nil {
+				return err
+			}
+			removePaths, err := cmd.Flags().GetStringArray(flagRemovePath)
+			if err != nil {
+				return fmt.Errorf("remove: %s", err)
+			}
+
+			content := lbmtypes.UpdateAcceptedStargateQueriesProposal{
+				Title:       proposalTitle,
+				Description: proposalDescr,
+				Accept:      append(accept, acceptJSON...),
+				RemovePaths: removePaths,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray(flagAcceptQuery, []string{}, "Accept a query path with a protobuf encoded response, as path=response_type")
+	cmd.Flags().StringArray(flagAcceptQueryJSON, []string{}, "Accept a query path with a json encoded response, as path=response_type")
+	cmd.Flags().StringArray(flagRemovePath, []string{}, "Remove an accepted query path")
+	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
+
+	return cmd
+}
+
+func parseAcceptedStargateQueries(args []string, encoding types.StargateQueryEncoding) ([]types.AcceptedStargateQuery, error) {
+	queries := make([]types.AcceptedStargateQuery, len(args))
+	for i, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("accepted query %q: expected path=response_type", arg)
+		}
+		queries[i] = types.AcceptedStargateQuery{
+			Path:         parts[0],
+			ResponseType: parts[1],
+			Encoding:     encoding,
+		}
+	}
+	return queries, nil
+}
@@ -1,15 +1,22 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 
+	"github.com/line/wasmd/x/wasm/client/cli/os"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
 	"github.com/line/wasmd/x/wasm/types"
 )
 
@@ -119,3 +126,465 @@ func ClearContractAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// ProposeContractAdminCmd proposes a new admin for a contract
+func ProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-contract-admin [contract_addr_bech32] [new_admin_addr_bech32]",
+		Short:   "Propose a new admin for a contract that takes over once it accepts",
+		Aliases: []string{"propose-admin"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := lbmtypes.MsgProposeAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				NewAdmin: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AcceptContractAdminCmd accepts the proposed admin role for a contract
+func AcceptContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-contract-admin [contract_addr_bech32]",
+		Short:   "Become the admin of a contract where the sender is the pending admin",
+		Aliases: []string{"accept-admin"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := lbmtypes.MsgAcceptAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelPendingContractAdminCmd drops the pending admin of a contract
+func CancelPendingContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-pending-contract-admin [contract_addr_bech32]",
+		Short:   "Drop the pending admin of a contract",
+		Aliases: []string{"cancel-pending-admin"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := lbmtypes.MsgCancelPendingAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateMigrationDelayCmd sets the number of blocks a migration of a contract is queued
+func UpdateMigrationDelayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-migration-delay [contract_addr_bech32] [blocks]",
+		Short: "Set the number of blocks a migration of a contract is queued before it is executed",
+		Long: `Set the number of blocks a migration of a contract is queued before it is executed.
+The admin can only increase the delay. A delay of 0 migrates immediately.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delay, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "blocks")
+			}
+			msg := lbmtypes.MsgUpdateMigrationDelay{
+				Sender:         clientCtx.GetFromAddress().String(),
+				Contract:       args[0],
+				MigrationDelay: delay,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelMigrationCmd drops the queued migration of a contract
+func CancelMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-migration [contract_addr_bech32]",
+		Short: "Drop the queued migration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := lbmtypes.MsgCancelMigration{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// PurgeContractCmd deletes a contract with its state and sends the remaining balance to the beneficiary
+func PurgeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "purge-contract [contract_addr_bech32] [beneficiary_addr_bech32]",
+		Short:   "Deletes a contract with its state and sends the remaining balance to the beneficiary",
+		Aliases: []string{"purge"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := lbmtypes.MsgPurgeContract{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Contract:    args[0],
+				Beneficiary: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateMigrationAllowlistCmd replaces the set of codes a contract can be migrated to
+func UpdateMigrationAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-migration-allowlist [contract_addr_bech32]",
+		Short: "Set the code ids and checksums a contract can be migrated to",
+		Long: `Set the code ids and checksums a contract can be migrated to.
+A migration is allowed when the new code id or its checksum is listed. Without any flag the restriction is removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeIDs, checksums, err := parseMigrationAllowlistFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := lbmtypes.MsgUpdateMigrationAllowlist{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Contract:  args[0],
+				CodeIDs:   codeIDs,
+				Checksums: checksums,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addMigrationAllowlistFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addMigrationAllowlistFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagAllowCodeIDs, []string{}, "Code ids the contract can be migrated to")
+	cmd.Flags().StringSlice(flagAllowChecksums, []string{}, "Hex encoded checksums of the codes the contract can be migrated to")
+}
+
+func parseMigrationAllowlistFlags(flagSet *flag.FlagSet) ([]uint64, [][]byte, error) {
+	codeIDArgs, err := flagSet.GetStringSlice(flagAllowCodeIDs)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "code ids")
+	}
+	codeIDs := make([]uint64, len(codeIDArgs))
+	for i, v := range codeIDArgs {
+		if codeIDs[i], err = strconv.ParseUint(v, 10, 64); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "code id %q", v)
+		}
+	}
+	checksumArgs, err := flagSet.GetStringSlice(flagAllowChecksums)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "checksums")
+	}
+	checksums := make([][]byte, len(checksumArgs))
+	for i, v := range checksumArgs {
+		if checksums[i], err = hex.DecodeString(v); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "checksum %q", v)
+		}
+	}
+	return codeIDs, checksums, nil
+}
+
+// SetCodeMetadataCmd sets the metadata of a code that was stored without it
+func SetCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-metadata [code_id_int64]",
+		Short: "Set the source, builder and code hash attestation of a code that was stored without them",
+		Long: `Set the source, builder and code hash attestation of a code that was stored without them.
+Only the creator of the code can set the metadata and only once.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			metadata, err := parseCodeMetadataFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if metadata == nil {
+				return fmt.Errorf("at least one of --%s, --%s or --%s is required", flagSource, flagBuilder, flagCodeHashAttestation)
+			}
+			msg := lbmtypes.MsgSetCodeMetadata{
+				Sender:   clientCtx.GetFromAddress().String(),
+				CodeID:   codeID,
+				Metadata: *metadata,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addCodeMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateContractMetadataCmd sets the human readable metadata of a contract
+func UpdateContractMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-contract-metadata [contract_addr_bech32]",
+		Short: "Set the description, website, icon and tags of a contract",
+		Long: `Set the description, website, icon and tags of a contract.
+The metadata replaces the current one, without any flag the metadata is removed. Only the admin of the contract can update it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return fmt.Errorf("description: %s", err)
+			}
+			website, err := cmd.Flags().GetString(flagWebsite)
+			if err != nil {
+				return fmt.Errorf("website: %s", err)
+			}
+			iconURI, err := cmd.Flags().GetString(flagIconURI)
+			if err != nil {
+				return fmt.Errorf("icon uri: %s", err)
+			}
+			tags, err := cmd.Flags().GetStringSlice(flagTags)
+			if err != nil {
+				return fmt.Errorf("tags: %s", err)
+			}
+			msg := lbmtypes.MsgUpdateContractMetadata{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Metadata: types.ContractMetadata{
+					Description: description,
+					Website:     website,
+					IconURI:     iconURI,
+					Tags:        tags,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(flagDescription, "", "Short human readable description of the contract")
+	cmd.Flags().String(flagWebsite, "", "HTTPS URI of the project's website")
+	cmd.Flags().String(flagIconURI, "", "HTTPS or IPFS URI of the contract's icon")
+	cmd.Flags().StringSlice(flagTags, []string{}, "Comma separated lowercase keywords to categorize the contract")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateFeeAllowanceCmd sets the tx fees a contract pays for a grantee
+func UpdateFeeAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fee-allowance [contract_addr_bech32] [grantee_addr_bech32] [spend_limit]",
+		Short: "Set the amount of tx fees a contract pays for the executions of a grantee",
+		Long: `Set the amount of tx fees a contract pays for the executions of a grantee.
+The grantee names the contract as fee granter of a tx that only executes the contract. An empty spend limit removes the allowance. Only the contract itself or its admin can update it.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var spendLimit sdk.Coins
+			if len(args) == 3 {
+				if spendLimit, err = sdk.ParseCoinsNormalized(args[2]); err != nil {
+					return fmt.Errorf("spend limit: %s", err)
+				}
+			}
+			msg := lbmtypes.MsgUpdateFeeAllowance{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Contract:   args[0],
+				Grantee:    args[1],
+				SpendLimit: spendLimit,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelScheduleCmd drops a scheduled callback of a contract
+func CancelScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-schedule [contract_addr_bech32] [schedule_id]",
+		Short: "Drop a scheduled callback of a contract and refund its gas deposit to the contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "schedule id")
+			}
+			msg := lbmtypes.MsgCancelSchedule{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Contract:   args[0],
+				ScheduleID: scheduleID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ExecuteContractsCmd executes several contracts atomically in a single message
+func ExecuteContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-contracts [json_file]",
+		Short: "Execute several contracts in order within a single message",
+		Long: `Execute several contracts in order within a single message. When one of the calls fails, all calls are reverted.
+The file contains a json list of calls, the funds of a call are optional:
+[
+  {"contract": "link1...", "msg": {"increase_allowance": {}}, "funds": "100stake"},
+  {"contract": "link1...", "msg": {"swap": {}}}
+]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFileWithSizeLimit(args[0], maxCallsFileSize)
+			if err != nil {
+				return err
+			}
+			msg, err := parseExecuteContractsArgs(bz, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// contractCallJSON is a call of the execute-contracts file
+type contractCallJSON struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+	Funds    string          `json:"funds,omitempty"`
+}
+
+func parseExecuteContractsArgs(bz []byte, sender sdk.AccAddress) (lbmtypes.MsgExecuteContracts, error) {
+	var calls []contractCallJSON
+	if err := json.Unmarshal(bz, &calls); err != nil {
+		return lbmtypes.MsgExecuteContracts{}, sdkerrors.Wrap(err, "calls")
+	}
+
+	msg := lbmtypes.MsgExecuteContracts{
+		Sender: sender.String(),
+		Calls:  make([]lbmtypes.ContractCall, len(calls)),
+	}
+	for i, call := range calls {
+		funds, err := sdk.ParseCoinsNormalized(call.Funds)
+		if err != nil {
+			return lbmtypes.MsgExecuteContracts{}, sdkerrors.Wrapf(err, "funds of call %d", i)
+		}
+		msg.Calls[i] = lbmtypes.ContractCall{
+			Contract: call.Contract,
+			Msg:      types.RawContractMessage(call.Msg),
+			Funds:    funds,
+		}
+	}
+	return msg, nil
+}
@@ -33,8 +33,11 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
+		GetCmdListContractByCreator(),
+		GetCmdListContractByLabel(),
 		GetCmdQueryCode(),
 		GetCmdQueryCodeInfo(),
+		GetCmdQueryCodeByChecksum(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
@@ -42,6 +45,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdLibVersion(),
 		GetCmdListInactiveContracts(),
 		GetCmdIsInactiveContract(),
+		GetCmdListPendingMigrations(),
+		GetCmdMigrationAllowlist(),
+		GetCmdContractStorage(),
+		GetCmdListFeeAllowances(),
+		GetCmdListSchedules(),
+		GetCmdListPrivilegedContracts(),
+		GetCmdListAcceptedStargateQueries(),
+		GetCmdBuildAddress(),
 	)
 	return queryCmd
 }
@@ -66,6 +77,45 @@ func GetCmdLibVersion() *cobra.Command {
 	return cmd
 }
 
+// GetCmdBuildAddress build a contract address
+func GetCmdBuildAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
+		Short:   "Build contract address",
+		Long:    "Build the address of a contract that is instantiated with a salt",
+		Aliases: []string{"address"},
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var initArgs []byte
+			if len(args) == 4 {
+				initArgs = types.RawContractMessage(args[3])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuildAddress(
+				context.Background(),
+				&types.QueryBuildAddressRequest{
+					CodeHash:       args[0],
+					CreatorAddress: args[1],
+					Salt:           args[2],
+					InitArgs:       initArgs,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdListCode lists all wasm code uploaded
 func GetCmdListCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -144,6 +194,85 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractByCreator lists all wasm contracts instantiated by the given creator
+func GetCmdListContractByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-contract-by-creator [creator]",
+		Short:   "List all contracts by creator",
+		Long:    "List all contracts instantiated by the given creator address",
+		Aliases: []string{"list-contracts-by-creator", "lcc"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by creator")
+	return cmd
+}
+
+// GetCmdListContractByLabel lists all wasm contracts with a label that starts with the given prefix
+func GetCmdListContractByLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-contract-by-label [label_prefix]",
+		Short:   "List all contracts by label prefix",
+		Long:    "List all contracts with a label that starts with the given prefix, ordered by label",
+		Aliases: []string{"list-contracts-by-label", "lcl"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractByLabel(
+				context.Background(),
+				&types.QueryContractByLabelRequest{
+					Label:      args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by label")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -225,6 +354,41 @@ func GetCmdQueryCodeInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCodeByChecksum returns the code info of the code stored with the given checksum
+func GetCmdQueryCodeByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code-by-checksum [checksum_hex]",
+		Short:   "Prints out metadata of the code stored with the given checksum",
+		Long:    "Prints out metadata of the code with the lowest code id that was stored with the given hex encoded checksum",
+		Aliases: []string{"checksum"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeByChecksum(
+				context.Background(),
+				&types.QueryCodeByChecksumRequest{
+					Checksum: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if res.CodeInfoResponse == nil {
+				return fmt.Errorf("code not found")
+			}
+
+			return clientCtx.PrintProto(res.CodeInfoResponse)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractInfo gets details about a given contract
 func GetCmdGetContractInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -605,3 +769,229 @@ func GetCmdIsInactiveContract() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdListPendingMigrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "pending-migrations",
+		Long: "List all queued migrations with their execute height",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingMigrations(
+				context.Background(),
+				&lbmtypes.QueryPendingMigrationsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list of pending migrations")
+	return cmd
+}
+
+func GetCmdMigrationAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "migration-allowlist [bech32_address]",
+		Long: "Show the code ids and checksums a contract can be migrated to",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.MigrationAllowlist(
+				context.Background(),
+				&lbmtypes.QueryMigrationAllowlistRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdContractStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "contract-storage [bech32_address]",
+		Long: "Show the storage usage of a contract in bytes and the deposit locked for it",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorage(
+				context.Background(),
+				&lbmtypes.QueryContractStorageRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdListFeeAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "fee-allowances [bech32_address]",
+		Long: "List the tx fees a contract pays for grantees",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeAllowances(
+				context.Background(),
+				&lbmtypes.QueryFeeAllowancesRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list of fee allowances")
+	return cmd
+}
+
+// GetCmdListSchedules lists the scheduled callbacks of a contract
+func GetCmdListSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "schedules [bech32_address]",
+		Long: "List the scheduled callbacks of a contract",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Schedules(
+				context.Background(),
+				&lbmtypes.QuerySchedulesRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list of schedules")
+	return cmd
+}
+
+// GetCmdListPrivilegedContracts lists the contracts that are called on begin and end block
+func GetCmdListPrivilegedContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "privileged-contracts",
+		Long: "List the contracts that governance registered to be called on begin and end block",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.PrivilegedContracts(
+				context.Background(),
+				&lbmtypes.QueryPrivilegedContractsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list of privileged contracts")
+	return cmd
+}
+
+// GetCmdListAcceptedStargateQueries lists the gRPC query paths that contracts can use for stargate queries
+func GetCmdListAcceptedStargateQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "accepted-stargate-queries",
+		Long: "List the gRPC query paths that governance accepted for stargate queries of contracts",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := lbmtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.AcceptedStargateQueries(
+				context.Background(),
+				&lbmtypes.QueryAcceptedStargateQueriesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list of accepted stargate queries")
+	return cmd
+}
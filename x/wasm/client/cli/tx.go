@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -21,17 +22,44 @@ import (
 )
 
 const (
-	flagAmount                 = "amount"
-	flagLabel                  = "label"
-	flagAdmin                  = "admin"
-	flagNoAdmin                = "no-admin"
-	flagRunAs                  = "run-as"
-	flagInstantiateByEverybody = "instantiate-everybody"
-	flagInstantiateNobody      = "instantiate-nobody"
-	flagInstantiateByAddress   = "instantiate-only-address"
-	flagProposalType           = "type"
+	flagAmount                    = "amount"
+	flagLabel                     = "label"
+	flagAdmin                     = "admin"
+	flagNoAdmin                   = "no-admin"
+	flagRunAs                     = "run-as"
+	flagInstantiateByEverybody    = "instantiate-everybody"
+	flagInstantiateNobody         = "instantiate-nobody"
+	flagInstantiateByAddress      = "instantiate-only-address"
+	flagInstantiateByAnyOfAddress = "instantiate-anyof-addresses"
+	flagProposalType              = "type"
+	flagFixMsg                    = "fix-msg"
+	flagAllowCodeIDs              = "allow-code-ids"
+	flagAllowChecksums            = "allow-checksums"
+	flagSource                    = "source"
+	flagBuilder                   = "builder"
+	flagCodeHashAttestation       = "code-hash-attestation"
+	flagDescription               = "description"
+	flagWebsite                   = "website"
+	flagIconURI                   = "icon-uri"
+	flagTags                      = "tags"
+	flagMaxBytes                  = "max-bytes"
+	flagMaxKeys                   = "max-keys"
+	flagUnlimited                 = "unlimited"
+	flagBeginBlock                = "begin-block"
+	flagEndBlock                  = "end-block"
+	flagCallGasLimit              = "call-gas-limit"
+	flagAcceptQuery               = "accept"
+	flagAcceptQueryJSON           = "accept-json"
+	flagRemovePath                = "remove"
 )
 
+// maxWasmFileSize is the largest wasm file that is read from disk. It only protects the client, the size limits
+// of the chain are params that are checked when the code is stored.
+const maxWasmFileSize = 10 * 1024 * 1024
+
+// maxCallsFileSize is the largest file of calls that is read by the execute-contracts command
+const maxCallsFileSize = 1024 * 1024
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -44,11 +72,24 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		StoreCodeAndInstantiateContractCmd(),
 		ExecuteContractCmd(),
+		ExecuteContractsCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		ProposeContractAdminCmd(),
+		AcceptContractAdminCmd(),
+		CancelPendingContractAdminCmd(),
+		UpdateMigrationDelayCmd(),
+		CancelMigrationCmd(),
+		UpdateMigrationAllowlistCmd(),
+		SetCodeMetadataCmd(),
+		UpdateContractMetadataCmd(),
+		UpdateFeeAllowanceCmd(),
+		CancelScheduleCmd(),
+		PurgeContractCmd(),
 	)
 	return txCmd
 }
@@ -72,6 +113,11 @@ func StoreCodeCmd() *cobra.Command {
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
+			if chunked, err := cmd.Flags().GetBool(flagChunked); err != nil {
+				return err
+			} else if chunked {
+				return storeCodeChunked(clientCtx, cmd.Flags(), msg)
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
@@ -79,12 +125,16 @@ func StoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
+	addCodeMetadataFlags(cmd)
+	cmd.Flags().Bool(flagChunked, false, "Upload the code in multiple txs that are broadcast in block mode, optional")
+	cmd.Flags().Uint64(flagChunkSize, defaultChunkSize, "Max size in bytes of each chunk of a chunked upload")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
 func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgStoreCode, error) {
-	wasm, err := os.ReadFileWithSizeLimit(file, int64(types.MaxWasmSize))
+	wasm, err := os.ReadFileWithSizeLimit(file, maxWasmFileSize)
 	if err != nil {
 		return types.MsgStoreCode{}, err
 	}
@@ -105,7 +155,20 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 	if err != nil {
 		return types.MsgStoreCode{}, fmt.Errorf("instantiate by address: %s", err)
 	}
-	if onlyAddrStr != "" {
+	anyOfAddrsStr, err := flags.GetStringSlice(flagInstantiateByAnyOfAddress)
+	if err != nil {
+		return types.MsgStoreCode{}, fmt.Errorf("instantiate by any of addresses: %s", err)
+	}
+	if len(anyOfAddrsStr) != 0 {
+		if onlyAddrStr != "" {
+			return types.MsgStoreCode{}, fmt.Errorf("%s can not be combined with %s", flagInstantiateByAnyOfAddress, flagInstantiateByAddress)
+		}
+		x, err := parseAnyOfAddresses(anyOfAddrsStr)
+		if err != nil {
+			return types.MsgStoreCode{}, sdkerrors.Wrap(err, flagInstantiateByAnyOfAddress)
+		}
+		perm = &x
+	} else if onlyAddrStr != "" {
 		allowedAddr, err := sdk.AccAddressFromBech32(onlyAddrStr)
 		if err != nil {
 			return types.MsgStoreCode{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
@@ -143,14 +206,63 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 
 	}
 
+	metadata, err := parseCodeMetadataFlags(flags)
+	if err != nil {
+		return types.MsgStoreCode{}, err
+	}
+
 	msg := types.MsgStoreCode{
 		Sender:                sender.String(),
 		WASMByteCode:          wasm,
 		InstantiatePermission: perm,
+		Metadata:              metadata,
 	}
 	return msg, nil
 }
 
+func addCodeMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSource, "", "An absolute https url to the source code of the contract, optional")
+	cmd.Flags().String(flagBuilder, "", "The docker image with tag that builds the code from the source, optional")
+	cmd.Flags().String(flagCodeHashAttestation, "", "The hex encoded checksum of the code that the builder produces from the source, optional")
+}
+
+// parseCodeMetadataFlags returns the code metadata of the flags or nil when no metadata flag is set
+func parseCodeMetadataFlags(flags *flag.FlagSet) (*types.CodeMetadata, error) {
+	source, err := flags.GetString(flagSource)
+	if err != nil {
+		return nil, fmt.Errorf("source: %s", err)
+	}
+	builder, err := flags.GetString(flagBuilder)
+	if err != nil {
+		return nil, fmt.Errorf("builder: %s", err)
+	}
+	attestationStr, err := flags.GetString(flagCodeHashAttestation)
+	if err != nil {
+		return nil, fmt.Errorf("code hash attestation: %s", err)
+	}
+	var attestation []byte
+	if attestationStr != "" {
+		if attestation, err = hex.DecodeString(attestationStr); err != nil {
+			return nil, sdkerrors.Wrap(err, flagCodeHashAttestation)
+		}
+	}
+	metadata := types.CodeMetadata{
+		Source:              source,
+		Builder:             builder,
+		CodeHashAttestation: attestation,
+	}
+	if metadata.IsEmpty() {
+		return nil, nil
+	}
+	return &metadata, nil
+}
+
+// parseAnyOfAddresses returns the AnyOfAddresses access config for the given bech32 addresses
+func parseAnyOfAddresses(addrs []string) (types.AccessConfig, error) {
+	config := types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: addrs}
+	return config, config.ValidateBasic()
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -234,6 +346,63 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 	return msg, nil
 }
 
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code with a predictable address.
+func InstantiateContract2Cmd() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use: "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] --amount [coins,optional] " +
+			"--fix-msg [bool,optional]",
+		Short: "Instantiate a wasm contract with predictable address",
+		Long: fmt.Sprintf(`Creates a new instance of an uploaded wasm code with the given 'constructor' message.
+Each contract instance has a unique address assigned. They are assigned deterministically based on the sender address, code hash, salt and
+optionally the init message when --%s is set.
+`, flagFixMsg),
+		Aliases: []string{"start2", "init2", "inst2", "i2"},
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			salt, err := decoder.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("salt: %w", err)
+			}
+			fixMsg, err := cmd.Flags().GetBool(flagFixMsg)
+			if err != nil {
+				return fmt.Errorf("fix msg: %w", err)
+			}
+			data, err := parseInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgInstantiateContract2{
+				Sender: data.Sender,
+				Admin:  data.Admin,
+				CodeID: data.CodeID,
+				Label:  data.Label,
+				Msg:    data.Msg,
+				Funds:  data.Funds,
+				Salt:   salt,
+				FixMsg: fixMsg,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().Bool(flagNoAdmin, false, "You must set this explicitly if you don't want an admin")
+	cmd.Flags().Bool(flagFixMsg, false, "An optional flag to include the json_encoded_init_args for the predictable address generation mode")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // StoreCodeAndInstantiateContractCmd will upload code and instantiate a contract using it
 func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -258,6 +427,7 @@ func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address of an admin")
@@ -266,7 +436,7 @@ func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 }
 
 func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sender sdk.AccAddress, flags *flag.FlagSet) (lbmtypes.MsgStoreCodeAndInstantiateContract, error) {
-	wasm, err := os.ReadFileWithSizeLimit(file, int64(types.MaxWasmSize))
+	wasm, err := os.ReadFileWithSizeLimit(file, maxWasmFileSize)
 	if err != nil {
 		return lbmtypes.MsgStoreCodeAndInstantiateContract{}, err
 	}
@@ -287,7 +457,20 @@ func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sende
 	if err != nil {
 		return lbmtypes.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by address: %s", err)
 	}
-	if onlyAddrStr != "" {
+	anyOfAddrsStr, err := flags.GetStringSlice(flagInstantiateByAnyOfAddress)
+	if err != nil {
+		return lbmtypes.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by any of addresses: %s", err)
+	}
+	if len(anyOfAddrsStr) != 0 {
+		if onlyAddrStr != "" {
+			return lbmtypes.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("%s can not be combined with %s", flagInstantiateByAnyOfAddress, flagInstantiateByAddress)
+		}
+		x, err := parseAnyOfAddresses(anyOfAddrsStr)
+		if err != nil {
+			return lbmtypes.MsgStoreCodeAndInstantiateContract{}, sdkerrors.Wrap(err, flagInstantiateByAnyOfAddress)
+		}
+		perm = &x
+	} else if onlyAddrStr != "" {
 		addr, err := sdk.AccAddressFromBech32(onlyAddrStr)
 		if err != nil {
 			return lbmtypes.MsgStoreCodeAndInstantiateContract{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
@@ -20,4 +20,12 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalUpdateInstantiateConfigCmd),
 	govclient.NewProposalHandler(cli.ProposalDeactivateContractCmd),
 	govclient.NewProposalHandler(cli.ProposalActivateContractCmd),
+	govclient.NewProposalHandler(cli.ProposalPurgeContractCmd),
+	govclient.NewProposalHandler(cli.ProposalRemoveCodesCmd),
+	govclient.NewProposalHandler(cli.ProposalUpdateParamsCmd),
+	govclient.NewProposalHandler(cli.ProposalUpdateMigrationAllowlistCmd),
+	govclient.NewProposalHandler(cli.ProposalUpdateContractStorageQuotaCmd),
+	govclient.NewProposalHandler(cli.ProposalRegisterPrivilegedContractCmd),
+	govclient.NewProposalHandler(cli.ProposalUnregisterPrivilegedContractCmd),
+	govclient.NewProposalHandler(cli.ProposalUpdateAcceptedStargateQueriesCmd),
 }
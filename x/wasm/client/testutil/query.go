@@ -76,6 +76,111 @@ func (s *IntegrationTestSuite) TestGetCmdListContractByCode() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdListContractByCreator() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				val.Address.String(),
+			},
+			true,
+			&types.QueryContractsByCreatorResponse{
+				ContractAddresses: []string{s.contractAddress},
+				Pagination:        &query.PageResponse{},
+			},
+		},
+		"genesis contracts": {
+			[]string{
+				"link146asaycmtydq45kxc8evntqfgepagygelel00h",
+			},
+			true,
+			&types.QueryContractsByCreatorResponse{
+				ContractAddresses: []string{s.inactiveContractAddress},
+				Pagination:        &query.PageResponse{},
+			},
+		},
+		"invalid creator": {
+			[]string{
+				"invalid",
+			},
+			false,
+			nil,
+		},
+		"no creator": {
+			[]string{},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdListContractByCreator()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var contracts types.QueryContractsByCreatorResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &contracts), out.String())
+			s.Require().Equal(tc.expected, &contracts)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdListContractByLabel() {
+	val := s.network.Validators[0]
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"unknown label": {
+			[]string{
+				"unknown label",
+			},
+			true,
+			&types.QueryContractByLabelResponse{
+				Contracts:  []types.QueryContractInfoResponse{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"no label": {
+			[]string{},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.GetCmdListContractByLabel()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, s.queryCommonArgs()...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var contracts types.QueryContractByLabelResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &contracts), out.String())
+			s.Require().Equal(tc.expected, &contracts)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryCodeInfo() {
 	val := s.network.Validators[0]
 
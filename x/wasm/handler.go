@@ -30,6 +30,8 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.StoreCode(sdk.WrapSDKContext(ctx), msg)
 		case *MsgInstantiateContract:
 			res, err = msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), msg)
+		case *MsgInstantiateContract2:
+			res, err = msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), msg)
 		case *MsgStoreCodeAndInstantiateContract:
 			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
 			if !ok {
@@ -45,6 +47,120 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.UpdateAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *MsgClearAdmin:
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateParams:
+			res, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+		case *MsgPurgeContract:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.PurgeContract(sdk.WrapSDKContext(ctx), msg)
+		case *MsgStoreCodeBegin:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.StoreCodeBegin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgStoreCodeChunk:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.StoreCodeChunk(sdk.WrapSDKContext(ctx), msg)
+		case *MsgStoreCodeCommit:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.StoreCodeCommit(sdk.WrapSDKContext(ctx), msg)
+		case *MsgProposeAdmin:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgAcceptAdmin:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelPendingAdmin:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.CancelPendingAdmin(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateMigrationDelay:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.UpdateMigrationDelay(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelMigration:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.CancelMigration(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateMigrationAllowlist:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.UpdateMigrationAllowlist(sdk.WrapSDKContext(ctx), msg)
+		case *MsgSetCodeMetadata:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.SetCodeMetadata(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateContractMetadata:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.UpdateContractMetadata(sdk.WrapSDKContext(ctx), msg)
+		case *MsgUpdateFeeAllowance:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.UpdateFeeAllowance(sdk.WrapSDKContext(ctx), msg)
+		case *MsgScheduleCallback:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.ScheduleCallback(sdk.WrapSDKContext(ctx), msg)
+		case *MsgCancelSchedule:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.CancelSchedule(sdk.WrapSDKContext(ctx), msg)
+		case *MsgExecuteContracts:
+			lbmMsgServer, ok := msgServer.(lbmtypes.MsgServer)
+			if !ok {
+				errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+			}
+			res, err = lbmMsgServer.ExecuteContracts(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
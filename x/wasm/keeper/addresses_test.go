@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func TestBuildContractAddressPredictable(t *testing.T) {
+	var (
+		checksum = bytes.Repeat([]byte{1}, 32)
+		creator  = sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+		salt     = []byte("my salt")
+		initMsg  = []byte(`{"foo":"bar"}`)
+	)
+	exp := BuildContractAddressPredictable(checksum, creator, salt, initMsg)
+	assert.Len(t, exp, 32)
+	// deterministic
+	assert.Equal(t, exp, BuildContractAddressPredictable(checksum, creator, salt, initMsg))
+
+	// every input is part of the address
+	assert.NotEqual(t, exp, BuildContractAddressPredictable(bytes.Repeat([]byte{3}, 32), creator, salt, initMsg))
+	assert.NotEqual(t, exp, BuildContractAddressPredictable(checksum, sdk.AccAddress(bytes.Repeat([]byte{3}, 20)), salt, initMsg))
+	assert.NotEqual(t, exp, BuildContractAddressPredictable(checksum, creator, []byte("other salt"), initMsg))
+	assert.NotEqual(t, exp, BuildContractAddressPredictable(checksum, creator, salt, []byte(`{}`)))
+	assert.NotEqual(t, exp, BuildContractAddressPredictable(checksum, creator, salt, []byte{}))
+
+	// length prefixes prevent collisions between shifted segments
+	assert.NotEqual(t,
+		BuildContractAddressPredictable(checksum, creator, []byte("a1"), []byte(`2`)),
+		BuildContractAddressPredictable(checksum, creator, []byte("a"), []byte(`12`)),
+	)
+}
+
+func TestBuildContractAddressPredictableRejectsInvalidInput(t *testing.T) {
+	var (
+		checksum = bytes.Repeat([]byte{1}, 32)
+		creator  = sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+		salt     = []byte("my salt")
+	)
+	specs := map[string]func(){
+		"invalid checksum": func() {
+			BuildContractAddressPredictable([]byte{1}, creator, salt, nil)
+		},
+		"empty creator": func() {
+			BuildContractAddressPredictable(checksum, nil, salt, nil)
+		},
+		"empty salt": func() {
+			BuildContractAddressPredictable(checksum, creator, nil, nil)
+		},
+		"salt too long": func() {
+			BuildContractAddressPredictable(checksum, creator, bytes.Repeat([]byte{1}, 65), nil)
+		},
+		"invalid init msg": func() {
+			BuildContractAddressPredictable(checksum, creator, salt, []byte("invalid"))
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Panics(t, spec)
+		})
+	}
+}
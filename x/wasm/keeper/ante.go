@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 
 	"github.com/line/wasmd/x/wasm/types"
 )
@@ -94,3 +95,92 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	}
 	return next(ctx, tx, simulate)
 }
+
+// contractFeeDeductedKey marks the context of a tx whose fees were deducted from the fee granter contract
+type contractFeeDeductedKey struct{}
+
+// ContractSponsoredFeeDecorator ante decorator that lets a contract pay the fees of the txs that execute it. It takes
+// the place of the `DeductFeeDecorator` of the auth module, which it wraps for all txs that are not sponsored by a
+// contract. The fees of sponsored txs are deducted later by the DeductContractSponsoredFeeDecorator, after the
+// signature verification, so that contracts are asked for signed txs only.
+type ContractSponsoredFeeDecorator struct {
+	keeper    *Keeper
+	deductFee sdk.AnteDecorator
+}
+
+// NewContractSponsoredFeeDecorator constructor. The deduct fee decorator handles all txs that are not sponsored by a
+// contract, usually the `DeductFeeDecorator` of the auth module.
+func NewContractSponsoredFeeDecorator(keeper *Keeper, deductFee sdk.AnteDecorator) *ContractSponsoredFeeDecorator {
+	return &ContractSponsoredFeeDecorator{keeper: keeper, deductFee: deductFee}
+}
+
+// AnteHandle passes txs without a contract as fee granter to the wrapped deduct fee decorator. A tx that names a
+// contract as fee granter must only execute the contract. Its fees are not deducted here and the tx fails when they
+// were not deducted by the DeductContractSponsoredFeeDecorator further down the chain.
+// The fee payer of a tx must sign it so that the contract, which can not sign, is named as fee granter instead.
+func (d ContractSponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	contractAddr, err := d.keeper.sponsoringContract(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if contractAddr == nil {
+		return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if m, ok := msg.(*types.MsgExecuteContract); !ok || m.Contract != contractAddr.String() {
+			return ctx, sdkerrors.Wrap(types.ErrFeeNotSponsored, "all msgs must execute the fee granter contract")
+		}
+	}
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	if newCtx.Value(contractFeeDeductedKey{}) == nil {
+		return newCtx, sdkerrors.Wrap(sdkerrors.ErrLogic, "contract sponsored fee was not deducted")
+	}
+	return newCtx, nil
+}
+
+// DeductContractSponsoredFeeDecorator ante decorator that deducts the fees of a tx from the fee granter contract. It
+// must run after the signature verification and after the ContractSponsoredFeeDecorator.
+type DeductContractSponsoredFeeDecorator struct {
+	keeper *Keeper
+}
+
+// NewDeductContractSponsoredFeeDecorator constructor
+func NewDeductContractSponsoredFeeDecorator(keeper *Keeper) *DeductContractSponsoredFeeDecorator {
+	return &DeductContractSponsoredFeeDecorator{keeper: keeper}
+}
+
+// AnteHandle deducts the fees of a tx that names a contract as fee granter from the contract balance. The contract
+// must approve the fees for the fee payer, either by a fee allowance or by its `sponsor` sudo entry point.
+// Txs without a contract as fee granter are passed on unchanged.
+func (d DeductContractSponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	contractAddr, err := d.keeper.sponsoringContract(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if contractAddr == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx := tx.(sdk.FeeTx)
+	if err := d.keeper.sponsorFee(ctx, contractAddr, feeTx.FeePayer(), feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+	return next(ctx.WithValue(contractFeeDeductedKey{}, true), tx, simulate)
+}
+
+// sponsoringContract returns the fee granter of the tx when it is a contract, nil otherwise
+func (k Keeper) sponsoringContract(ctx sdk.Context, tx sdk.Tx) (sdk.AccAddress, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	contractAddr := feeTx.FeeGranter()
+	if contractAddr == nil || !k.HasContractInfo(ctx, contractAddr) {
+		return nil, nil
+	}
+	return contractAddr, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -10,11 +12,18 @@ import (
 
 	"github.com/line/lbm-sdk/store"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/auth/ante"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
 	abci "github.com/line/ostracon/abci/types"
 	"github.com/line/ostracon/libs/log"
 	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
 
 	"github.com/line/wasmd/x/wasm/keeper"
+	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/line/wasmd/x/wasm/types"
 )
 
@@ -184,3 +193,167 @@ func consumeGasAnteHandler(gasToConsume sdk.Gas) sdk.AnteHandler {
 		return ctx, nil
 	}
 }
+
+func TestContractSponsoredFeeDecorator(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	specs := map[string]struct {
+		allowance    sdk.Coins
+		sponsor      bool
+		sponsorMsgs  bool
+		otherMsg     bool
+		noGranter    bool
+		expErr       *sdkerrors.Error
+		expAllowance sdk.Coins
+		expSudoCall  bool
+	}{
+		"fee paid from allowance": {
+			allowance:    sdk.NewCoins(sdk.NewInt64Coin("denom", 15)),
+			expAllowance: sdk.NewCoins(sdk.NewInt64Coin("denom", 5)),
+		},
+		"allowance used up": {
+			allowance: fee,
+		},
+		"fee exceeds allowance": {
+			allowance:    sdk.NewCoins(sdk.NewInt64Coin("denom", 9)),
+			sponsor:      true,
+			expErr:       types.ErrFeeNotSponsored,
+			expAllowance: sdk.NewCoins(sdk.NewInt64Coin("denom", 9)),
+		},
+		"fee sponsored by contract": {
+			sponsor:     true,
+			expSudoCall: true,
+		},
+		"fee not sponsored by contract": {
+			expErr:      types.ErrFeeNotSponsored,
+			expSudoCall: true,
+		},
+		"sponsor response with messages": {
+			sponsor:     true,
+			sponsorMsgs: true,
+			expErr:      types.ErrFeeNotSponsored,
+			expSudoCall: true,
+		},
+		"other msg in tx": {
+			sponsor:  true,
+			otherMsg: true,
+			expErr:   types.ErrFeeNotSponsored,
+		},
+		"fee paid by sender without granter": {
+			noGranter: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := keeper.CreateTestInput(t, false, keeper.SupportedFeatures, nil, nil)
+			var gotSudoMsg []byte
+			mock := &wasmtesting.MockWasmer{SudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				gotSudoMsg = sudoMsg
+				if !spec.sponsor {
+					return nil, 0, errors.New("not sponsored")
+				}
+				if spec.sponsorMsgs {
+					return &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{Amount: wasmvmtypes.Coins{wasmvmtypes.NewCoin(1, "denom")}}}}}}}, 0, nil
+				}
+				return &wasmvmtypes.Response{}, 0, nil
+			}}
+			wasmtesting.MakeInstantiable(mock)
+			example := keeper.SeedNewContractInstance(t, ctx, keepers, mock)
+			keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 100))
+			sender := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100))
+			if spec.allowance != nil {
+				allowance := types.FeeAllowance{Grantee: sender.String(), SpendLimit: spec.allowance}
+				require.NoError(t, keepers.ContractKeeper.UpdateFeeAllowance(ctx, example.Contract, example.CreatorAddr, allowance))
+			}
+
+			txBuilder := keepers.EncodingConfig.TxConfig.NewTxBuilder()
+			msgs := []sdk.Msg{&types.MsgExecuteContract{Sender: sender.String(), Contract: example.Contract.String(), Msg: []byte(`{}`)}}
+			if spec.otherMsg {
+				msgs = append(msgs, banktypes.NewMsgSend(sender, example.Contract, fee))
+			}
+			require.NoError(t, txBuilder.SetMsgs(msgs...))
+			txBuilder.SetFeeAmount(fee)
+			if !spec.noGranter {
+				txBuilder.SetFeeGranter(example.Contract)
+			}
+			early := keeper.NewContractSponsoredFeeDecorator(keepers.WasmKeeper, ante.NewDeductFeeDecorator(keepers.AccountKeeper, keepers.BankKeeper, nil))
+			late := keeper.NewDeductContractSponsoredFeeDecorator(keepers.WasmKeeper)
+			var nextCalled bool
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+			anteHandler := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				return early.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+					return late.AnteHandle(ctx, tx, simulate, next)
+				})
+			}
+			contractBalance := keepers.BankKeeper.GetAllBalances(ctx, example.Contract)
+			senderBalance := keepers.BankKeeper.GetAllBalances(ctx, sender)
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := keepers.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+			// when
+			_, gotErr := anteHandler(ctx, txBuilder.GetTx(), false)
+
+			// then
+			if spec.expSudoCall {
+				expSudoMsg, err := json.Marshal(map[string]interface{}{
+					"sponsor": map[string]interface{}{
+						"sender": sender.String(),
+						"fee":    wasmvmtypes.Coins{wasmvmtypes.NewCoin(10, "denom")},
+					},
+				})
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expSudoMsg), string(gotSudoMsg))
+			} else {
+				assert.Nil(t, gotSudoMsg)
+			}
+			var gotAllowance sdk.Coins
+			if a := keepers.WasmKeeper.GetFeeAllowance(ctx, example.Contract, sender); a != nil {
+				gotAllowance = a.SpendLimit
+			}
+			assert.Equal(t, spec.expAllowance, gotAllowance)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.False(t, nextCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			payer, payerBalance := example.Contract, contractBalance
+			if spec.noGranter {
+				payer, payerBalance = sender, senderBalance
+			}
+			assert.Equal(t, payerBalance.Sub(fee), keepers.BankKeeper.GetAllBalances(ctx, payer))
+			assert.Equal(t, feeCollectorBalance.Add(fee...), keepers.BankKeeper.GetAllBalances(ctx, feeCollector))
+		})
+	}
+}
+
+func TestContractSponsoredFeeDecoratorRequiresDeduction(t *testing.T) {
+	ctx, keepers := keeper.CreateTestInput(t, false, keeper.SupportedFeatures, nil, nil)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	example := keeper.SeedNewContractInstance(t, ctx, keepers, mock)
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 100))
+	sender := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	allowance := types.FeeAllowance{Grantee: sender.String(), SpendLimit: fee}
+	require.NoError(t, keepers.ContractKeeper.UpdateFeeAllowance(ctx, example.Contract, example.CreatorAddr, allowance))
+
+	txBuilder := keepers.EncodingConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&types.MsgExecuteContract{Sender: sender.String(), Contract: example.Contract.String(), Msg: []byte(`{}`)}))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetFeeGranter(example.Contract)
+	decorator := keeper.NewContractSponsoredFeeDecorator(keepers.WasmKeeper, ante.NewDeductFeeDecorator(keepers.AccountKeeper, keepers.BankKeeper, nil))
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+
+	// when the deduct decorator is missing in the chain
+	_, gotErr := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, next)
+
+	// then
+	assert.True(t, sdkerrors.ErrLogic.Is(gotErr), gotErr)
+	assert.Equal(t, fee, keepers.WasmKeeper.GetFeeAllowance(ctx, example.Contract, sender).SpendLimit)
+}
@@ -10,6 +10,9 @@ type AuthorizationPolicy interface {
 	CanCreateCode(c types.AccessConfig, creator sdk.AccAddress) bool
 	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
 	CanModifyContract(admin, actor sdk.AccAddress) bool
+	CanMigrateImmediately() bool
+	CanModifyInactiveContract() bool
+	CanModifyGovernanceAllowlist() bool
 }
 
 type DefaultAuthorizationPolicy struct {
@@ -27,6 +30,18 @@ func (p DefaultAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAddres
 	return admin != nil && admin.Equals(actor)
 }
 
+func (p DefaultAuthorizationPolicy) CanMigrateImmediately() bool {
+	return false
+}
+
+func (p DefaultAuthorizationPolicy) CanModifyInactiveContract() bool {
+	return false
+}
+
+func (p DefaultAuthorizationPolicy) CanModifyGovernanceAllowlist() bool {
+	return false
+}
+
 // GovAuthorizationPolicy is for the gov handler(proposal_handler.go) authorities
 type GovAuthorizationPolicy struct {
 }
@@ -45,3 +60,28 @@ func (p GovAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress
 	// The gov handler can migrate contract regardless of the contract admin
 	return true
 }
+
+func (p GovAuthorizationPolicy) CanMigrateImmediately() bool {
+	// The gov handler migrates contracts regardless of the migration delay as the proposal had a voting period
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanModifyInactiveContract() bool {
+	// The gov handler can purge contracts that it deactivated before
+	return true
+}
+
+func (p GovAuthorizationPolicy) CanModifyGovernanceAllowlist() bool {
+	// The gov handler can replace the migration allowlists that it set before
+	return true
+}
+
+// queuedMigrationAuthorizationPolicy is for the queued migrations that are executed in the end blocker once the
+// migration delay has passed. The caller must still be the admin.
+type queuedMigrationAuthorizationPolicy struct {
+	DefaultAuthorizationPolicy
+}
+
+func (p queuedMigrationAuthorizationPolicy) CanMigrateImmediately() bool {
+	return true
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"bytes"
+
+	storetypes "github.com/line/lbm-sdk/store/types"
+)
+
+// codeInfoStore is a read only view on the code infos and the removed code infos. Both are keyed by the code id so that
+// the iterators merge them into one sequence ordered by code id.
+type codeInfoStore struct {
+	storetypes.KVStore
+	removed storetypes.KVStore
+}
+
+func (s codeInfoStore) Iterator(start, end []byte) storetypes.Iterator {
+	return &mergedIterator{a: s.KVStore.Iterator(start, end), b: s.removed.Iterator(start, end), ascending: true}
+}
+
+func (s codeInfoStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return &mergedIterator{a: s.KVStore.ReverseIterator(start, end), b: s.removed.ReverseIterator(start, end)}
+}
+
+// mergedIterator iterates over two iterators with distinct keys in the order of the keys
+type mergedIterator struct {
+	a, b      storetypes.Iterator
+	ascending bool
+}
+
+func (it *mergedIterator) current() storetypes.Iterator {
+	switch {
+	case !it.a.Valid():
+		return it.b
+	case !it.b.Valid():
+		return it.a
+	}
+	c := bytes.Compare(it.a.Key(), it.b.Key())
+	if c == 0 || (c < 0) == it.ascending {
+		return it.a
+	}
+	return it.b
+}
+
+func (it *mergedIterator) Domain() (start []byte, end []byte) {
+	return it.a.Domain()
+}
+
+func (it *mergedIterator) Valid() bool {
+	return it.a.Valid() || it.b.Valid()
+}
+
+func (it *mergedIterator) Next() {
+	it.current().Next()
+}
+
+func (it *mergedIterator) Key() []byte {
+	return it.current().Key()
+}
+
+func (it *mergedIterator) Value() []byte {
+	return it.current().Value()
+}
+
+// Error returns the error of the current iterator only. Prefix store iterators report an error once they are exhausted
+// which is expected for one of both while the other is still valid.
+func (it *mergedIterator) Error() error {
+	return it.current().Error()
+}
+
+func (it *mergedIterator) Close() error {
+	errA, errB := it.a.Close(), it.b.Close()
+	if errA != nil {
+		return errA
+	}
+	return errB
+}
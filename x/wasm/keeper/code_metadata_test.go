@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestCreateWithMetadata(t *testing.T) {
+	checksum := sha256.Sum256(hackatomWasm)
+	specs := map[string]struct {
+		metadata    *types.CodeMetadata
+		expMetadata *types.CodeMetadata
+		expErr      *sdkerrors.Error
+	}{
+		"without metadata": {},
+		"empty metadata": {
+			metadata: &types.CodeMetadata{},
+		},
+		"all fields set": {
+			metadata: &types.CodeMetadata{
+				Source:              "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+				Builder:             "cosmwasm/workspace-optimizer:0.12.9",
+				CodeHashAttestation: checksum[:],
+			},
+			expMetadata: &types.CodeMetadata{
+				Source:              "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+				Builder:             "cosmwasm/workspace-optimizer:0.12.9",
+				CodeHashAttestation: checksum[:],
+			},
+		},
+		"code hash attestation does not match": {
+			metadata: &types.CodeMetadata{CodeHashAttestation: make([]byte, sha256.Size)},
+			expErr:   types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			creator := RandomAccountAddress(t)
+
+			// when
+			codeID, gotErr := keepers.ContractKeeper.CreateWithMetadata(ctx, creator, hackatomWasm, nil, spec.metadata)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMetadata, keepers.WasmKeeper.GetCodeInfo(ctx, codeID).Metadata)
+			// and returned by the code query
+			res, err := Querier(keepers.WasmKeeper).Code(sdk.WrapSDKContext(ctx), &types.QueryCodeRequest{CodeId: codeID})
+			require.NoError(t, err)
+			assert.Equal(t, spec.expMetadata, res.Metadata)
+		})
+	}
+}
+
+func TestCreateWithMetadataReusesCodeByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithCodeReuseByChecksum())
+	creator, otherCreator := RandomAccountAddress(t), RandomAccountAddress(t)
+	metadata := &types.CodeMetadata{Source: "https://example.com"}
+	codeID, err := keepers.ContractKeeper.CreateWithMetadata(ctx, creator, hackatomWasm, nil, metadata)
+	require.NoError(t, err)
+
+	// when another creator uploads the same code with the same metadata
+	reusedID, err := keepers.ContractKeeper.CreateWithMetadata(ctx, otherCreator, hackatomWasm, nil, metadata)
+
+	// then the code with the final metadata is reused
+	require.NoError(t, err)
+	assert.Equal(t, codeID, reusedID)
+
+	// and when uploaded with other metadata, a new code is stored
+	otherID, err := keepers.ContractKeeper.CreateWithMetadata(ctx, otherCreator, hackatomWasm, nil, &types.CodeMetadata{Source: "https://example.org"})
+	require.NoError(t, err)
+	assert.NotEqual(t, codeID, otherID)
+}
+
+func TestSetCodeMetadata(t *testing.T) {
+	checksum := sha256.Sum256(hackatomWasm)
+	myMetadata := types.CodeMetadata{
+		Source:              "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+		Builder:             "cosmwasm/workspace-optimizer:0.12.9",
+		CodeHashAttestation: checksum[:],
+	}
+	creator := RandomAccountAddress(t)
+	specs := map[string]struct {
+		existing *types.CodeMetadata
+		caller   sdk.AccAddress
+		codeID   uint64
+		metadata types.CodeMetadata
+		expErr   *sdkerrors.Error
+	}{
+		"set by creator": {
+			caller:   creator,
+			codeID:   1,
+			metadata: myMetadata,
+		},
+		"set by other account": {
+			caller:   RandomAccountAddress(t),
+			codeID:   1,
+			metadata: myMetadata,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"already set": {
+			existing: &types.CodeMetadata{Source: "https://example.com/source"},
+			caller:   creator,
+			codeID:   1,
+			metadata: myMetadata,
+			expErr:   types.ErrInvalid,
+		},
+		"empty metadata": {
+			caller: creator,
+			codeID: 1,
+			expErr: types.ErrEmpty,
+		},
+		"code hash attestation does not match": {
+			caller:   creator,
+			codeID:   1,
+			metadata: types.CodeMetadata{CodeHashAttestation: make([]byte, sha256.Size)},
+			expErr:   types.ErrInvalid,
+		},
+		"unknown code": {
+			caller:   creator,
+			codeID:   2,
+			metadata: myMetadata,
+			expErr:   types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			codeID, err := keepers.ContractKeeper.CreateWithMetadata(ctx, creator, hackatomWasm, nil, spec.existing)
+			require.NoError(t, err)
+
+			// when
+			gotErr := keepers.ContractKeeper.SetCodeMetadata(ctx, spec.codeID, spec.caller, spec.metadata)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, spec.existing, keepers.WasmKeeper.GetCodeInfo(ctx, codeID).Metadata)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, &spec.metadata, keepers.WasmKeeper.GetCodeInfo(ctx, codeID).Metadata)
+		})
+	}
+}
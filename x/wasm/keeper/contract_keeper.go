@@ -10,19 +10,43 @@ var _ types.ContractOpsKeeper = PermissionedKeeper{}
 
 // decoratedKeeper contains a subset of the wasm keeper that are already or can be guarded by an authorization policy in the future
 type decoratedKeeper interface {
-	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
-	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, metadata *types.CodeMetadata, authZ AuthorizationPolicy) (codeID uint64, err error)
+	setCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeMetadata) error
+	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
+	proposeContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
+	acceptContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error
+	cancelPendingContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error
+	setMigrationDelay(ctx sdk.Context, contractAddress, caller sdk.AccAddress, delay uint64, authZ AuthorizationPolicy) error
+	cancelMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error
+	setMigrationAllowlist(ctx sdk.Context, contractAddress, caller sdk.AccAddress, allowlist types.MigrationAllowlist, authZ AuthorizationPolicy) error
+	purgeContract(ctx sdk.Context, contractAddress, caller, beneficiary sdk.AccAddress, authZ AuthorizationPolicy) error
+	beginStoreCode(ctx sdk.Context, uploader sdk.AccAddress, checksum []byte, totalSize uint64, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (uint64, int64, error)
+	appendCodeChunk(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, data []byte) (uint64, error)
+	commitStoreCode(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, authZ AuthorizationPolicy) (uint64, error)
 	pinCode(ctx sdk.Context, codeID uint64) error
 	unpinCode(ctx sdk.Context, codeID uint64) error
+	removeCode(ctx sdk.Context, codeID uint64) error
 	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
+	setContractMetadata(ctx sdk.Context, contractAddress, caller sdk.AccAddress, metadata types.ContractMetadata, authZ AuthorizationPolicy) error
+	setFeeAllowance(ctx sdk.Context, contractAddress, caller sdk.AccAddress, allowance types.FeeAllowance, authZ AuthorizationPolicy) error
+	scheduleCallback(ctx sdk.Context, contractAddress sdk.AccAddress, executeHeight int64, interval uint64, msg types.RawContractMessage, gasLimit uint64, gasDeposit sdk.Coins) (uint64, error)
+	cancelSchedule(ctx sdk.Context, contractAddress, caller sdk.AccAddress, scheduleID uint64, authZ AuthorizationPolicy) error
 	setAccessConfig(ctx sdk.Context, codeID uint64, config types.AccessConfig) error
+	setContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) error
+	registerPrivilegedContract(ctx sdk.Context, privileged types.PrivilegedContract) error
+	unregisterPrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	setAcceptedStargateQuery(ctx sdk.Context, query types.AcceptedStargateQuery) error
+	removeAcceptedStargateQuery(ctx sdk.Context, path string) error
+	updateParams(ctx sdk.Context, authority sdk.AccAddress, ps types.Params) error
+	ClassicAddressGenerator() AddressGenerator
 
 	activateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
-	deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress, info types.InactiveContractInfo) error
+	setPendingDeactivationProposal(ctx sdk.Context, contractAddress sdk.AccAddress)
 }
 
 type PermissionedKeeper struct {
@@ -43,11 +67,45 @@ func NewDefaultPermissionKeeper(nested decoratedKeeper) *PermissionedKeeper {
 }
 
 func (p PermissionedKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig) (codeID uint64, err error) {
-	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, p.authZPolicy)
+	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, nil, p.authZPolicy)
+}
+
+// CreateWithMetadata uploads and compiles a WASM contract like Create and stores the metadata with the code.
+func (p PermissionedKeeper) CreateWithMetadata(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, metadata *types.CodeMetadata) (codeID uint64, err error) {
+	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, metadata, p.authZPolicy)
+}
+
+// SetCodeMetadata sets the metadata of a code that was stored without it.
+func (p PermissionedKeeper) SetCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeMetadata) error {
+	return p.nested.setCodeMetadata(ctx, codeID, caller, metadata)
 }
 
 func (p PermissionedKeeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
-	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.authZPolicy)
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.nested.ClassicAddressGenerator(), p.authZPolicy)
+}
+
+// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+func (p PermissionedKeeper) Instantiate2(
+	ctx sdk.Context,
+	codeID uint64,
+	creator, admin sdk.AccAddress,
+	initMsg []byte,
+	label string,
+	deposit sdk.Coins,
+	salt []byte,
+	fixMsg bool,
+) (sdk.AccAddress, []byte, error) {
+	return p.nested.instantiate(
+		ctx,
+		codeID,
+		creator,
+		admin,
+		initMsg,
+		label,
+		deposit,
+		PredicableAddressGenerator(creator, salt, initMsg, fixMsg),
+		p.authZPolicy,
+	)
 }
 
 func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
@@ -70,6 +128,76 @@ func (p PermissionedKeeper) ClearContractAdmin(ctx sdk.Context, contractAddress
 	return p.nested.setContractAdmin(ctx, contractAddress, caller, nil, p.authZPolicy)
 }
 
+// ProposeContractAdmin sets the pending admin that becomes the admin once it accepts.
+func (p PermissionedKeeper) ProposeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) error {
+	return p.nested.proposeContractAdmin(ctx, contractAddress, caller, newAdmin, p.authZPolicy)
+}
+
+// AcceptContractAdmin makes the caller the admin when it is the pending admin.
+func (p PermissionedKeeper) AcceptContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.acceptContractAdmin(ctx, contractAddress, caller)
+}
+
+// CancelPendingContractAdmin drops the pending admin.
+func (p PermissionedKeeper) CancelPendingContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.cancelPendingContractAdmin(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// UpdateMigrationDelay sets the number of blocks a migration of the contract is queued.
+func (p PermissionedKeeper) UpdateMigrationDelay(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, delay uint64) error {
+	return p.nested.setMigrationDelay(ctx, contractAddress, caller, delay, p.authZPolicy)
+}
+
+// CancelMigration drops the queued migration of the contract.
+func (p PermissionedKeeper) CancelMigration(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.cancelMigration(ctx, contractAddress, caller, p.authZPolicy)
+}
+
+// UpdateMigrationAllowlist replaces the set of codes the contract can be migrated to.
+func (p PermissionedKeeper) UpdateMigrationAllowlist(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, allowlist types.MigrationAllowlist) error {
+	return p.nested.setMigrationAllowlist(ctx, contractAddress, caller, allowlist, p.authZPolicy)
+}
+
+// UpdateContractMetadata replaces the human readable metadata of the contract.
+func (p PermissionedKeeper) UpdateContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, metadata types.ContractMetadata) error {
+	return p.nested.setContractMetadata(ctx, contractAddress, caller, metadata, p.authZPolicy)
+}
+
+// UpdateFeeAllowance replaces the amount of tx fees the contract pays for a grantee.
+func (p PermissionedKeeper) UpdateFeeAllowance(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, allowance types.FeeAllowance) error {
+	return p.nested.setFeeAllowance(ctx, contractAddress, caller, allowance, p.authZPolicy)
+}
+
+// ScheduleCallback registers a callback of the contract that the end blocker executes at a future height.
+func (p PermissionedKeeper) ScheduleCallback(ctx sdk.Context, contractAddress sdk.AccAddress, executeHeight int64, interval uint64, msg types.RawContractMessage, gasLimit uint64, gasDeposit sdk.Coins) (uint64, error) {
+	return p.nested.scheduleCallback(ctx, contractAddress, executeHeight, interval, msg, gasLimit, gasDeposit)
+}
+
+// CancelSchedule drops a schedule of the contract and refunds its gas deposit.
+func (p PermissionedKeeper) CancelSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, scheduleID uint64) error {
+	return p.nested.cancelSchedule(ctx, contractAddress, caller, scheduleID, p.authZPolicy)
+}
+
+// PurgeContract deletes the contract with its state and sends the remaining balance to the beneficiary.
+func (p PermissionedKeeper) PurgeContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, beneficiary sdk.AccAddress) error {
+	return p.nested.purgeContract(ctx, contractAddress, caller, beneficiary, p.authZPolicy)
+}
+
+// BeginStoreCode starts a session to upload a code in multiple chunks.
+func (p PermissionedKeeper) BeginStoreCode(ctx sdk.Context, uploader sdk.AccAddress, checksum []byte, totalSize uint64, instantiateAccess *types.AccessConfig) (uint64, int64, error) {
+	return p.nested.beginStoreCode(ctx, uploader, checksum, totalSize, instantiateAccess, p.authZPolicy)
+}
+
+// StoreCodeChunk appends the next chunk of the code to an upload session.
+func (p PermissionedKeeper) StoreCodeChunk(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, data []byte) (uint64, error) {
+	return p.nested.appendCodeChunk(ctx, uploader, sessionID, data)
+}
+
+// CommitStoreCode verifies and stores the code of a completely uploaded session.
+func (p PermissionedKeeper) CommitStoreCode(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64) (uint64, error) {
+	return p.nested.commitStoreCode(ctx, uploader, sessionID, p.authZPolicy)
+}
+
 func (p PermissionedKeeper) PinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.pinCode(ctx, codeID)
 }
@@ -78,6 +206,16 @@ func (p PermissionedKeeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.unpinCode(ctx, codeID)
 }
 
+// RemoveCode deletes a code without contract instances.
+func (p PermissionedKeeper) RemoveCode(ctx sdk.Context, codeID uint64) error {
+	return p.nested.removeCode(ctx, codeID)
+}
+
+// UpdateParams replaces the wasm params when the given authority matches the one of the keeper.
+func (p PermissionedKeeper) UpdateParams(ctx sdk.Context, authority sdk.AccAddress, ps types.Params) error {
+	return p.nested.updateParams(ctx, authority, ps)
+}
+
 // SetExtraContractAttributes updates the extra attributes that can be stored with the contract info
 func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error {
 	return p.nested.setContractInfoExtension(ctx, contract, extra)
@@ -88,8 +226,40 @@ func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, conf
 	return p.nested.setAccessConfig(ctx, codeID, config)
 }
 
-func (p PermissionedKeeper) DeactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
-	return p.nested.deactivateContract(ctx, contractAddress)
+// SetContractStorageQuota overrides the default storage quota of the params for a contract.
+func (p PermissionedKeeper) SetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) error {
+	return p.nested.setContractStorageQuota(ctx, contractAddress, quota)
+}
+
+// RegisterPrivilegedContract registers a contract to receive a sudo call on every begin and/or end block.
+func (p PermissionedKeeper) RegisterPrivilegedContract(ctx sdk.Context, privileged types.PrivilegedContract) error {
+	return p.nested.registerPrivilegedContract(ctx, privileged)
+}
+
+// UnregisterPrivilegedContract stops the begin and end block calls of a contract.
+func (p PermissionedKeeper) UnregisterPrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return p.nested.unregisterPrivilegedContract(ctx, contractAddress)
+}
+
+// SetAcceptedStargateQuery accepts a gRPC query path for stargate queries of contracts.
+func (p PermissionedKeeper) SetAcceptedStargateQuery(ctx sdk.Context, query types.AcceptedStargateQuery) error {
+	return p.nested.setAcceptedStargateQuery(ctx, query)
+}
+
+// RemoveAcceptedStargateQuery disables stargate queries of contracts for the given path.
+func (p PermissionedKeeper) RemoveAcceptedStargateQuery(ctx sdk.Context, path string) error {
+	return p.nested.removeAcceptedStargateQuery(ctx, path)
+}
+
+// DeactivateContract adds the contract to the inactive contract list with the given deactivation details.
+func (p PermissionedKeeper) DeactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress, info types.InactiveContractInfo) error {
+	return p.nested.deactivateContract(ctx, contractAddress, info)
+}
+
+// SetPendingDeactivationProposal marks the contract as deactivated by the governance proposal that is executed
+// currently so that the proposal id is assigned to the details after execution by the GovHooks.
+func (p PermissionedKeeper) SetPendingDeactivationProposal(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	p.nested.setPendingDeactivationProposal(ctx, contractAddress)
 }
 
 func (p PermissionedKeeper) ActivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
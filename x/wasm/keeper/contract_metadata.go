@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// setContractMetadata replaces the human readable metadata that is stored as extension with the contract info. Empty
+// metadata removes the extension. A contract info extension of any other type is never overwritten.
+func (k Keeper) setContractMetadata(ctx sdk.Context, contractAddress, caller sdk.AccAddress, metadata types.ContractMetadata, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if ext := contractInfo.Extension; ext != nil && ext.TypeUrl != "/"+proto.MessageName(&types.ContractMetadata{}) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "contract has an extension of type %s", ext.TypeUrl)
+	}
+	var ext types.ContractInfoExtension
+	if !metadata.IsEmpty() {
+		ext = &metadata
+	}
+	if err := contractInfo.SetExtension(ext); err != nil {
+		return sdkerrors.Wrap(err, "metadata")
+	}
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	return ctx.EventManager().EmitTypedEvent(&lbmtypes.EventContractMetadataUpdated{
+		Contract: contractAddress.String(),
+		Sender:   caller.String(),
+	})
+}
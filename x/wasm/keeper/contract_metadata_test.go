@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestUpdateContractMetadata(t *testing.T) {
+	myMetadata := types.ContractMetadata{
+		Description: "An example contract",
+		Website:     "https://example.com",
+		IconURI:     "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+		Tags:        []string{"example", "escrow"},
+	}
+	specs := map[string]struct {
+		existing    *types.ContractMetadata
+		callerAdmin bool
+		metadata    types.ContractMetadata
+		expMetadata *types.ContractMetadata
+		expErr      *sdkerrors.Error
+	}{
+		"set by admin": {
+			callerAdmin: true,
+			metadata:    myMetadata,
+			expMetadata: &myMetadata,
+		},
+		"replaced by admin": {
+			existing:    &types.ContractMetadata{Description: "old"},
+			callerAdmin: true,
+			metadata:    myMetadata,
+			expMetadata: &myMetadata,
+		},
+		"removed by admin": {
+			existing:    &myMetadata,
+			callerAdmin: true,
+		},
+		"set by other account": {
+			existing:    &types.ContractMetadata{Description: "old"},
+			metadata:    myMetadata,
+			expMetadata: &types.ContractMetadata{Description: "old"},
+			expErr:      sdkerrors.ErrUnauthorized,
+		},
+		"invalid metadata": {
+			callerAdmin: true,
+			metadata:    types.ContractMetadata{Website: "http://example.com"},
+			expErr:      types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			if spec.existing != nil {
+				require.NoError(t, keepers.ContractKeeper.UpdateContractMetadata(ctx, example.Contract, example.CreatorAddr, *spec.existing))
+			}
+			caller := RandomAccountAddress(t)
+			if spec.callerAdmin {
+				caller = example.CreatorAddr
+			}
+
+			// when
+			em := sdk.NewEventManager()
+			gotErr := keepers.ContractKeeper.UpdateContractMetadata(ctx.WithEventManager(em), example.Contract, caller, spec.metadata)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Empty(t, em.Events())
+			} else {
+				require.NoError(t, gotErr)
+				expEvent, err := sdk.TypedEventToEvent(&lbmtypes.EventContractMetadataUpdated{
+					Contract: example.Contract.String(),
+					Sender:   caller.String(),
+				})
+				require.NoError(t, err)
+				assert.Equal(t, sdk.Events{expEvent}, em.Events())
+			}
+			// and returned decoded by the contract info query
+			res, err := Querier(keepers.WasmKeeper).ContractInfo(sdk.WrapSDKContext(ctx), &types.QueryContractInfoRequest{Address: example.Contract.String()})
+			require.NoError(t, err)
+			if spec.expMetadata == nil {
+				assert.Nil(t, res.ContractInfo.Extension)
+				return
+			}
+			var gotMetadata types.ContractMetadata
+			require.NoError(t, res.ContractInfo.ReadExtension(&gotMetadata))
+			assert.Equal(t, *spec.expMetadata, gotMetadata)
+			bz, err := keepers.EncodingConfig.Marshaler.MarshalJSON(res)
+			require.NoError(t, err)
+			assert.Contains(t, string(bz), `"@type":"/cosmwasm.wasm.v1.ContractMetadata"`)
+			assert.Contains(t, string(bz), `"description":"`+spec.expMetadata.Description+`"`)
+		})
+	}
+}
+
+func TestUpdateContractMetadataUnknownContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	gotErr := keepers.ContractKeeper.UpdateContractMetadata(ctx, RandomAccountAddress(t), RandomAccountAddress(t), types.ContractMetadata{Description: "foo"})
+	assert.True(t, types.ErrNotFound.Is(gotErr), gotErr)
+}
+
+func TestUpdateContractMetadataKeepsOtherExtension(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	// register an example extension. must be protobuf
+	keepers.EncodingConfig.InterfaceRegistry.RegisterImplementations(
+		(*types.ContractInfoExtension)(nil),
+		&govtypes.TextProposal{},
+	)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherExt := &govtypes.TextProposal{Title: "foo", Description: "bar"}
+	require.NoError(t, keepers.ContractKeeper.SetContractInfoExtension(ctx, example.Contract, otherExt))
+
+	// when
+	gotErr := keepers.ContractKeeper.UpdateContractMetadata(ctx, example.Contract, example.CreatorAddr, types.ContractMetadata{})
+
+	// then
+	assert.True(t, types.ErrInvalid.Is(gotErr), gotErr)
+	var gotExt govtypes.TextProposal
+	require.NoError(t, keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).ReadExtension(&gotExt))
+	assert.Equal(t, *otherExt, gotExt)
+}
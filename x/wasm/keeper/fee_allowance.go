@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// sponsorSudoMsg is the sudo msg that asks a contract to pay the tx fees of a sender
+type sponsorSudoMsg struct {
+	Sponsor sponsorMsg `json:"sponsor"`
+}
+
+type sponsorMsg struct {
+	Sender string            `json:"sender"`
+	Fee    wasmvmtypes.Coins `json:"fee"`
+}
+
+// setFeeAllowance replaces the amount of tx fees a contract pays for a grantee. The allowance is set by the contract
+// itself or its admin, an empty spend limit removes it.
+func (k Keeper) setFeeAllowance(ctx sdk.Context, contractAddress, caller sdk.AccAddress, allowance types.FeeAllowance, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !caller.Equals(contractAddress) && !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := allowance.ValidateBasic(); err != nil {
+		return err
+	}
+	k.storeFeeAllowance(ctx, contractAddress, allowance)
+	return nil
+}
+
+// GetFeeAllowance returns the amount of tx fees a contract pays for a grantee or nil when the contract has no
+// allowance for the grantee.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, contractAddress, grantee sdk.AccAddress) *types.FeeAllowance {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeAllowanceKey(contractAddress, grantee))
+	if bz == nil {
+		return nil
+	}
+	var allowance types.FeeAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+	return &allowance
+}
+
+// IterateFeeAllowances iterates over the fee allowances of a contract ordered by grantee address. The callback
+// returns true to stop the iteration.
+func (k Keeper) IterateFeeAllowances(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(types.FeeAllowance) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFeeAllowancePrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.FeeAllowance
+		k.cdc.MustUnmarshal(iter.Value(), &allowance)
+		if cb(allowance) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeFeeAllowance(ctx sdk.Context, contractAddress sdk.AccAddress, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeAllowanceKey(contractAddress, sdk.MustAccAddressFromBech32(allowance.Grantee))
+	if allowance.SpendLimit.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&allowance))
+}
+
+// deleteFeeAllowances deletes all fee allowances of a contract
+func (k Keeper) deleteFeeAllowances(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFeeAllowancePrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// sponsorFee deducts the tx fees of the sender from the contract. When the contract has a fee allowance for the sender
+// the fees are deducted from it, otherwise the contract is asked with a `sponsor` sudo msg and pays unless the call
+// fails.
+func (k Keeper) sponsorFee(ctx sdk.Context, contractAddress, sender sdk.AccAddress, fee sdk.Coins) error {
+	if allowance := k.GetFeeAllowance(ctx, contractAddress, sender); allowance != nil {
+		remaining, isNeg := allowance.SpendLimit.SafeSub(fee)
+		if isNeg {
+			return sdkerrors.Wrapf(types.ErrFeeNotSponsored, "fee exceeds the allowance of %s", allowance.SpendLimit)
+		}
+		allowance.SpendLimit = remaining
+		k.storeFeeAllowance(ctx, contractAddress, *allowance)
+	} else {
+		msg, err := json.Marshal(sponsorSudoMsg{Sponsor: sponsorMsg{Sender: sender.String(), Fee: ConvertSdkCoinsToWasmCoins(fee)}})
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		if err := k.askSponsor(ctx, contractAddress, msg); err != nil {
+			return sdkerrors.Wrap(types.ErrFeeNotSponsored, err.Error())
+		}
+	}
+
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddress, authtypes.FeeCollectorName, fee); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, contractAddress.String()),
+	))
+	return nil
+}
+
+// askSponsor passes the `sponsor` sudo msg to the contract. The call only approves or rejects the fees: it runs in
+// the ante handler, so the response must not contain messages or data and is never dispatched. Attributes and events
+// of the response are dropped.
+func (k Keeper) askSponsor(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) error {
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointSudo); err != nil {
+		return err
+	}
+
+	sudoSetupCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
+	ctx.GasMeter().ConsumeGas(sudoSetupCosts, "Loading CosmWasm module: sponsor")
+
+	env := types.NewEnv(ctx, contractAddress)
+	querier := k.newQueryHandler(ctx, contractAddress)
+	wasmStore, counter := k.contractStore(ctx, contractAddress, contractAddress)
+	gas := k.runtimeGasForContract(ctx)
+	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	k.consumeRuntimeGas(ctx, gasUsed)
+	if execErr != nil {
+		return wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if len(res.Messages) != 0 || len(res.Data) != 0 {
+		return sdkerrors.Wrap(types.ErrInvalid, "sponsor response must not contain messages or data")
+	}
+	return k.settleStorageDeposit(ctx, contractAddress, contractAddress, counter)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	abci "github.com/line/ostracon/abci/types"
 
 	"github.com/line/wasmd/x/wasm/types"
@@ -35,7 +36,18 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		}
 	}
 
+	for i, code := range data.RemovedCodes {
+		if err := keeper.importRemovedCode(ctx, code.CodeID, code.CodeInfo); err != nil {
+			return nil, sdkerrors.Wrapf(err, "removed code %d with id: %d", i, code.CodeID)
+		}
+		if code.CodeID > maxCodeID {
+			maxCodeID = code.CodeID
+		}
+	}
+
 	var maxContractID int
+	var maxScheduleID uint64
+	var storageDeposits sdk.Coins
 	for i, contract := range data.Contracts {
 		contractAddr, err := sdk.AccAddressFromBech32(contract.ContractAddress)
 		if err != nil {
@@ -45,8 +57,45 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		if contract.MigrationAllowlist != nil {
+			keeper.storeMigrationAllowlist(ctx, contractAddr, *contract.MigrationAllowlist)
+		}
+		if !contract.StorageDeposit.IsZero() {
+			storage := keeper.GetContractStorage(ctx, contractAddr)
+			storage.Deposit = contract.StorageDeposit
+			keeper.storeContractStorage(ctx, contractAddr, storage)
+			storageDeposits = storageDeposits.Add(contract.StorageDeposit...)
+		}
+		if contract.StorageQuota != nil {
+			keeper.storeContractStorageQuota(ctx, contractAddr, *contract.StorageQuota)
+		}
+		for _, allowance := range contract.FeeAllowances {
+			keeper.storeFeeAllowance(ctx, contractAddr, allowance)
+		}
+		for _, schedule := range contract.Schedules {
+			if schedule.GasLimit > keeper.scheduleBlockGasLimit {
+				return nil, sdkerrors.Wrapf(types.ErrLimit, "schedule %d gas limit must not exceed %d", schedule.ID, keeper.scheduleBlockGasLimit)
+			}
+			keeper.storeSchedule(ctx, contractAddr, schedule)
+			if schedule.ID > maxScheduleID {
+				maxScheduleID = schedule.ID
+			}
+		}
+		if contract.Privileged != nil {
+			keeper.storePrivilegedContract(ctx, contractAddr, *contract.Privileged)
+		}
+		if contract.PendingMigration != nil {
+			keeper.storePendingMigration(ctx, contractAddr, *contract.PendingMigration)
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
+	// the storage deposits are refunded from the module account so that it must hold them
+	if !storageDeposits.IsZero() {
+		moduleBalance := keeper.bankViewKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		if !moduleBalance.IsAllGTE(storageDeposits) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "module account balance %s does not cover the storage deposits %s", moduleBalance, storageDeposits)
+		}
+	}
 
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
@@ -64,17 +113,34 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 	if seqVal <= uint64(maxContractID) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastInstanceID), seqVal, maxContractID)
 	}
+	seqVal = keeper.PeekAutoIncrementID(ctx, types.KeyLastScheduleID)
+	if seqVal <= maxScheduleID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastScheduleID), seqVal, maxScheduleID)
+	}
 
 	for i, contractAddr := range data.InactiveContractAddresses {
 		inactiveContractAddr, err := sdk.AccAddressFromBech32(contractAddr)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "wrong contract address %s", contractAddr)
 		}
-		err = keeper.deactivateContract(ctx, inactiveContractAddr)
+		err = keeper.deactivateContract(ctx, inactiveContractAddr, types.InactiveContractInfo{})
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
 	}
+	for i, c := range data.InactiveContracts {
+		inactiveContractAddr, err := sdk.AccAddressFromBech32(c.ContractAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "wrong contract address %s", c.ContractAddress)
+		}
+		err = keeper.deactivateContract(ctx, inactiveContractAddr, c.Info)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "inactive contract number %d", i)
+		}
+	}
+	for _, query := range data.AcceptedStargateQueries {
+		keeper.storeAcceptedStargateQuery(ctx, query)
+	}
 
 	if len(data.GenMsgs) == 0 {
 		return nil, nil
@@ -112,18 +178,51 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		return false
 	})
 
+	keeper.IterateRemovedCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		genState.RemovedCodes = append(genState.RemovedCodes, types.RemovedCode{
+			CodeID:   codeID,
+			CodeInfo: info,
+		})
+		return false
+	})
+
 	keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, contract types.ContractInfo) bool {
 		var state []types.Model
 		keeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
 			state = append(state, types.Model{Key: key, Value: value})
 			return false
 		})
+		var allowlist *types.MigrationAllowlist
+		if a := keeper.GetMigrationAllowlist(ctx, addr); !a.IsEmpty() {
+			allowlist = &a
+		}
+		var quota *types.StorageQuota
+		if q := keeper.getContractStorageQuotaOverride(ctx, addr); !q.IsEmpty() {
+			quota = &q
+		}
+		var allowances []types.FeeAllowance
+		keeper.IterateFeeAllowances(ctx, addr, func(allowance types.FeeAllowance) bool {
+			allowances = append(allowances, allowance)
+			return false
+		})
+		var schedules []types.Schedule
+		keeper.IterateSchedules(ctx, addr, func(schedule types.Schedule) bool {
+			schedules = append(schedules, schedule)
+			return false
+		})
 		// redact contract info
 		contract.Created = nil
 		genState.Contracts = append(genState.Contracts, types.Contract{
-			ContractAddress: addr.String(),
-			ContractInfo:    contract,
-			ContractState:   state,
+			ContractAddress:    addr.String(),
+			ContractInfo:       contract,
+			ContractState:      state,
+			MigrationAllowlist: allowlist,
+			StorageDeposit:     keeper.GetContractStorage(ctx, addr).Deposit,
+			StorageQuota:       quota,
+			FeeAllowances:      allowances,
+			Schedules:          schedules,
+			Privileged:         keeper.GetPrivilegedContract(ctx, addr),
+			PendingMigration:   keeper.GetPendingMigration(ctx, addr),
 		})
 		return false
 	})
@@ -134,9 +233,24 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			Value: keeper.PeekAutoIncrementID(ctx, k),
 		})
 	}
+	// the schedule sequence is only set once a contract registered a callback
+	if ctx.KVStore(keeper.storeKey).Has(types.KeyLastScheduleID) {
+		genState.Sequences = append(genState.Sequences, types.Sequence{
+			IDKey: types.KeyLastScheduleID,
+			Value: keeper.PeekAutoIncrementID(ctx, types.KeyLastScheduleID),
+		})
+	}
 
 	keeper.IterateInactiveContracts(ctx, func(contractAddr sdk.AccAddress) (stop bool) {
-		genState.InactiveContractAddresses = append(genState.InactiveContractAddresses, contractAddr.String())
+		genState.InactiveContracts = append(genState.InactiveContracts, types.InactiveContract{
+			ContractAddress: contractAddr.String(),
+			Info:            *keeper.GetInactiveContractInfo(ctx, contractAddr),
+		})
+		return false
+	})
+
+	keeper.IterateAcceptedStargateQueries(ctx, func(query types.AcceptedStargateQuery) bool {
+		genState.AcceptedStargateQueries = append(genState.AcceptedStargateQueries, query)
 		return false
 	})
 
@@ -57,13 +57,23 @@ func TestGenesisExportImport(t *testing.T) {
 			history           []types.ContractCodeHistoryEntry
 			pinned            bool
 			contractExtension bool
+			allowlisted       bool
+			scheduled         bool
+			privileged        bool
+			queued            bool
 		)
 		f.Fuzz(&codeInfo)
 		f.Fuzz(&contract)
 		f.Fuzz(&stateModels)
 		f.NilChance(0).Fuzz(&history)
+		// a contract history starts with the instantiation, the admin operations follow
+		history[0].Operation = types.ContractCodeHistoryOperationTypeInit
 		f.Fuzz(&pinned)
 		f.Fuzz(&contractExtension)
+		f.Fuzz(&allowlisted)
+		f.Fuzz(&scheduled)
+		f.Fuzz(&privileged)
+		f.Fuzz(&queued)
 
 		creatorAddr, err := sdk.AccAddressFromBech32(codeInfo.Creator)
 		require.NoError(t, err)
@@ -86,9 +96,52 @@ func TestGenesisExportImport(t *testing.T) {
 		wasmKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		if allowlisted {
+			wasmKeeper.storeMigrationAllowlist(srcCtx, contractAddr, types.MigrationAllowlist{
+				CodeIDs:   []uint64{codeID},
+				Checksums: [][]byte{wasmKeeper.GetCodeInfo(srcCtx, codeID).CodeHash},
+			})
+		}
+		if scheduled {
+			wasmKeeper.storeSchedule(srcCtx, contractAddr, types.Schedule{
+				ID:         wasmKeeper.autoIncrementID(srcCtx, types.KeyLastScheduleID),
+				Contract:   contractAddr.String(),
+				NextHeight: 10,
+				Interval:   5,
+				Msg:        []byte(`{}`),
+				GasLimit:   100_000,
+				GasDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			})
+		}
+		if queued {
+			wasmKeeper.storePendingMigration(srcCtx, contractAddr, types.PendingMigration{
+				Contract:      contractAddr.String(),
+				Sender:        contract.Creator,
+				CodeID:        codeID,
+				Msg:           []byte(`{}`),
+				ExecuteHeight: 10,
+			})
+		}
+		if privileged {
+			wasmKeeper.storePrivilegedContract(srcCtx, contractAddr, types.PrivilegedContract{
+				Contract: contractAddr.String(),
+				EndBlock: true,
+				GasLimit: 100_000,
+			})
+		}
 	}
+	removedCodeID, err := contractKeeper.Create(srcCtx, RandomAccountAddress(t), wasmCode, nil)
+	require.NoError(t, err)
+	require.NoError(t, wasmKeeper.removeCode(srcCtx, removedCodeID))
+	wasmKeeper.storeAcceptedStargateQuery(srcCtx, types.AcceptedStargateQuery{
+		Path:         "/cosmos.bank.v1beta1.Query/Balance",
+		ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse",
+		Encoding:     types.StargateQueryEncodingJSON,
+	})
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
+	wasmParams.MaxDecompressedWasmSize = wasmParams.MaxDecompressedWasmSize%types.MaxImportedWasmSize + 1
+	wasmParams.UploadSessionDeposit = sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
 	wasmKeeper.SetParams(srcCtx, wasmParams)
 
 	// export
@@ -122,6 +175,11 @@ func TestGenesisExportImport(t *testing.T) {
 		newHistory := x.ResetFromGenesis(dstCtx)
 		wasmKeeper.storeContractInfo(srcCtx, address, x)
 		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, newHistory)
+		creatorAddress, err := sdk.AccAddressFromBech32(info.Creator)
+		require.NoError(t, err)
+		wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, newHistory.Updated, address)
+		wasmKeeper.addToContractLabelSecondaryIndex(srcCtx, info.Label, address)
+		wasmKeeper.addToContractCreatorAndLabelIndex(srcCtx, creatorAddress, info.Label)
 		wasmKeeper.appendToContractHistory(srcCtx, address, newHistory)
 		iter.Close()
 		return false
@@ -226,6 +284,56 @@ func TestGenesisInit(t *testing.T) {
 			}},
 			Params: types.DefaultParams(),
 		}},
+		"happy path: removed codes": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				RemovedCodes: []types.RemovedCode{{
+					CodeID:   2,
+					CodeInfo: myCodeInfo,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 3},
+					{IDKey: types.KeyLastInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			expSuccess: true,
+		},
+		"prevent removed code id that is in use": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				RemovedCodes: []types.RemovedCode{{
+					CodeID:   firstCodeID,
+					CodeInfo: myCodeInfo,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 2},
+					{IDKey: types.KeyLastInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
+		"prevent code id seq init value == max removed codeID": {
+			src: types.GenesisState{
+				RemovedCodes: []types.RemovedCode{{
+					CodeID:   2,
+					CodeInfo: myCodeInfo,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 2},
+					{IDKey: types.KeyLastInstanceID, Value: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
 		"prevent duplicate codeIDs": {src: types.GenesisState{
 			Codes: []types.Code{
 				{
@@ -403,6 +511,34 @@ func TestGenesisInit(t *testing.T) {
 				Params: types.DefaultParams(),
 			},
 		},
+		"prevent schedule gas limit above the schedule block gas limit": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    firstCodeID,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: BuildContractAddress(1, 1).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *wasmTypes.ContractInfo) { c.CodeID = 1 }, types.OnlyGenesisFields),
+						Schedules: []types.Schedule{{
+							ID:         1,
+							Contract:   BuildContractAddress(1, 1).String(),
+							NextHeight: 1,
+							Msg:        []byte(`{}`),
+							GasLimit:   defaultScheduleBlockGasLimit + 1,
+						}},
+					},
+				},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 2},
+					{IDKey: types.KeyLastInstanceID, Value: 2},
+					{IDKey: types.KeyLastScheduleID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
 		"validator set update called for any genesis messages": {
 			src: types.GenesisState{
 				GenMsgs: []types.GenesisState_GenMsgs{
@@ -465,7 +601,10 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 		"instantiate_default_permission": "Everybody",
 		"gas_multiplier": 100,
 		"instance_cost": 40000,
-		"compile_cost": 2
+		"compile_cost": 2,
+		"max_wasm_size": 819200,
+		"max_label_size": 128,
+		"max_decompressed_wasm_size": 819200
 	},
   "codes": [
     {
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+)
+
+var _ govtypes.GovHooks = GovHooks{}
+
+// GovHooks records the id of the governance proposal that deactivated a contract in the inactive contract details.
+// The hooks must be registered with the gov keeper as the proposal id is not passed to the proposal handler.
+type GovHooks struct {
+	keeper *Keeper
+}
+
+// NewGovHooks constructor
+func NewGovHooks(k *Keeper) GovHooks {
+	return GovHooks{keeper: k}
+}
+
+// AfterProposalSubmission noop
+func (h GovHooks) AfterProposalSubmission(sdk.Context, uint64) {}
+
+// AfterProposalDeposit noop
+func (h GovHooks) AfterProposalDeposit(sdk.Context, uint64, sdk.AccAddress) {}
+
+// AfterProposalVote noop
+func (h GovHooks) AfterProposalVote(sdk.Context, uint64, sdk.AccAddress) {}
+
+// AfterProposalFailedMinDeposit noop
+func (h GovHooks) AfterProposalFailedMinDeposit(sdk.Context, uint64) {}
+
+// AfterProposalVotingPeriodEnded is called right after the proposal content was executed
+func (h GovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.keeper.assignDeactivationProposalID(ctx, proposalID)
+}
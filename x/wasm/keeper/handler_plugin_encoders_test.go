@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	wasmvmtypes "github.com/line/wasmvm/types"
 
 	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
 	"github.com/line/wasmd/x/wasm/types"
 )
 
@@ -58,6 +60,59 @@ func TestEncoding(t *testing.T) {
 	proposalMsgBin, err := proto.Marshal(proposalMsg)
 	require.NoError(t, err)
 
+	instantiate2Msg := &types.MsgInstantiateContract2{
+		Sender: addr1.String(),
+		Admin:  addr2.String(),
+		CodeID: 7,
+		Label:  "myLabel",
+		Msg:    jsonMsg,
+		Funds:  sdk.NewCoins(sdk.NewInt64Coin("eth", 123)),
+		Salt:   []byte("mySalt"),
+		FixMsg: true,
+	}
+	instantiate2MsgBin, err := proto.Marshal(instantiate2Msg)
+	require.NoError(t, err)
+
+	proposeAdminMsg := &lbmtypes.MsgProposeAdmin{
+		Sender:   addr2.String(),
+		Contract: addr1.String(),
+		NewAdmin: addr3.String(),
+	}
+	proposeAdminMsgBin, err := proto.Marshal(proposeAdminMsg)
+	require.NoError(t, err)
+
+	acceptAdminMsg := &lbmtypes.MsgAcceptAdmin{
+		Sender:   addr3.String(),
+		Contract: addr1.String(),
+	}
+	acceptAdminMsgBin, err := proto.Marshal(acceptAdminMsg)
+	require.NoError(t, err)
+
+	cancelPendingAdminMsg := &lbmtypes.MsgCancelPendingAdmin{
+		Sender:   addr2.String(),
+		Contract: addr1.String(),
+	}
+	cancelPendingAdminMsgBin, err := proto.Marshal(cancelPendingAdminMsg)
+	require.NoError(t, err)
+
+	scheduleCallbackMsg := &lbmtypes.MsgScheduleCallback{
+		Sender:     addr1.String(),
+		Interval:   10,
+		Msg:        []byte(`{"foo":"bar"}`),
+		GasLimit:   100000,
+		GasDeposit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+	scheduleCallbackMsgBin, err := proto.Marshal(scheduleCallbackMsg)
+	require.NoError(t, err)
+
+	cancelScheduleMsg := &lbmtypes.MsgCancelSchedule{
+		Sender:     addr2.String(),
+		Contract:   addr1.String(),
+		ScheduleID: 1,
+	}
+	cancelScheduleMsgBin, err := proto.Marshal(cancelScheduleMsg)
+	require.NoError(t, err)
+
 	cases := map[string]struct {
 		sender             sdk.AccAddress
 		srcMsg             wasmvmtypes.CosmosMsg
@@ -243,6 +298,20 @@ func TestEncoding(t *testing.T) {
 				},
 			},
 		},
+		"custom propose admin is not supported": {
+			sender: addr2,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(fmt.Sprintf(`{"propose_admin":{"contract_addr":%q,"admin":%q}}`, addr1.String(), addr3.String())),
+			},
+			isError: true,
+		},
+		"custom unknown variant": {
+			sender: addr2,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"foo":{}}`),
+			},
+			isError: true,
+		},
 		"staking delegate": {
 			sender: addr1,
 			srcMsg: wasmvmtypes.CosmosMsg{
@@ -370,6 +439,66 @@ func TestEncoding(t *testing.T) {
 			},
 			output: []sdk.Msg{proposalMsg},
 		},
+		"stargate encoded wasm instantiate2": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/cosmwasm.wasm.v1.MsgInstantiateContract2",
+					Value:   instantiate2MsgBin,
+				},
+			},
+			output: []sdk.Msg{instantiate2Msg},
+		},
+		"stargate encoded propose admin": {
+			sender: addr2,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/lbm.wasm.v1.MsgProposeAdmin",
+					Value:   proposeAdminMsgBin,
+				},
+			},
+			output: []sdk.Msg{proposeAdminMsg},
+		},
+		"stargate encoded accept admin": {
+			sender: addr3,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/lbm.wasm.v1.MsgAcceptAdmin",
+					Value:   acceptAdminMsgBin,
+				},
+			},
+			output: []sdk.Msg{acceptAdminMsg},
+		},
+		"stargate encoded cancel pending admin": {
+			sender: addr2,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/lbm.wasm.v1.MsgCancelPendingAdmin",
+					Value:   cancelPendingAdminMsgBin,
+				},
+			},
+			output: []sdk.Msg{cancelPendingAdminMsg},
+		},
+		"stargate encoded schedule callback": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/lbm.wasm.v1.MsgScheduleCallback",
+					Value:   scheduleCallbackMsgBin,
+				},
+			},
+			output: []sdk.Msg{scheduleCallbackMsg},
+		},
+		"stargate encoded cancel schedule": {
+			sender: addr2,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{
+					TypeURL: "/lbm.wasm.v1.MsgCancelSchedule",
+					Value:   cancelScheduleMsgBin,
+				},
+			},
+			output: []sdk.Msg{cancelScheduleMsg},
+		},
 		"stargate encoded invalid typeUrl": {
 			sender: addr2,
 			srcMsg: wasmvmtypes.CosmosMsg{
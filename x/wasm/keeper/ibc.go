@@ -28,9 +28,22 @@ func (k Keeper) ensureIbcPort(ctx sdk.Context, contractAddr sdk.AccAddress) (str
 	if _, ok := k.capabilityKeeper.GetCapability(ctx, host.PortPath(portID)); ok {
 		return portID, nil
 	}
+	if k.portKeeper.IsBound(ctx, portID) {
+		// the port of a purged contract is released by wasm but can not be bound again
+		return "", sdkerrors.Wrapf(types.ErrDuplicate, "port %s is bound already", portID)
+	}
 	return portID, k.bindIbcPort(ctx, portID)
 }
 
+// releaseIbcPort releases the capability that wasm holds for the port. This is a noop when wasm does not hold it.
+func (k Keeper) releaseIbcPort(ctx sdk.Context, portID string) error {
+	cap, ok := k.capabilityKeeper.GetCapability(ctx, host.PortPath(portID))
+	if !ok {
+		return nil
+	}
+	return k.capabilityKeeper.ReleaseCapability(ctx, cap)
+}
+
 const portIDPrefix = "wasm."
 
 func PortIDForContract(addr sdk.AccAddress) string {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -18,13 +19,16 @@ import (
 	"github.com/line/lbm-sdk/types/address"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	authkeeper "github.com/line/lbm-sdk/x/auth/keeper"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	bankpluskeeper "github.com/line/lbm-sdk/x/bankplus/keeper"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
 	paramtypes "github.com/line/lbm-sdk/x/params/types"
 	"github.com/line/ostracon/libs/log"
 	wasmvm "github.com/line/wasmvm"
 	wasmvmtypes "github.com/line/wasmvm/types"
 
 	"github.com/line/wasmd/x/wasm/ioutils"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
 	"github.com/line/wasmd/x/wasm/types"
 )
 
@@ -32,6 +36,25 @@ import (
 // constant value so all nodes run with the same limit.
 const contractMemoryLimit = 32
 
+// defaultContractPurgeChunkSize is the default max number of contract state entries that are deleted at once when a
+// contract is purged
+const defaultContractPurgeChunkSize = 1000
+
+// defaultUploadSessionExpiry is the default number of blocks after which an uncommitted upload session expires
+const defaultUploadSessionExpiry = 100
+
+// defaultQueuedMigrationGasLimit is the default gas limit of a queued migration that is executed in the end blocker
+const defaultQueuedMigrationGasLimit = 10_000_000
+
+// defaultScheduleBlockGasLimit is the default gas that all scheduled callbacks of a block can consume together
+const defaultScheduleBlockGasLimit = 10_000_000
+
+// defaultMaxSchedulesPerContract is the default max number of scheduled callbacks that a contract can register
+const defaultMaxSchedulesPerContract = 10
+
+// defaultScheduleGasPrice is the default price per gas of scheduled callbacks in the default bond denom
+var defaultScheduleGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+
 type contextKey int
 
 const (
@@ -75,6 +98,7 @@ type Keeper struct {
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	bankViewKeeper        types.BankViewKeeper
 	portKeeper            types.PortKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmerEngine
@@ -83,10 +107,36 @@ type Keeper struct {
 	messenger             Messenger
 	metrics               *Metrics
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
-	queryGasLimit     uint64
+	queryGasLimit uint64
+	// paramSpace is the legacy params subspace that is only read to migrate the params into the wasm store
 	paramSpace        paramtypes.Subspace
 	gasRegister       WasmGasRegister
 	maxQueryStackSize uint32
+	// reuseCodeByChecksum returns the existing code id on upload when the same wasm code was stored before
+	reuseCodeByChecksum bool
+	// inactiveContractAllowedEntryPoints are the entry points that can still be called on an inactive contract
+	inactiveContractAllowedEntryPoints map[types.ContractEntryPoint]struct{}
+	// contractPurgeChunkSize is the max number of contract state entries that are deleted at once on a purge and in
+	// each end blocker afterwards
+	contractPurgeChunkSize uint32
+	// authority is the address that is allowed to update the params
+	authority string
+	// uploadSessionExpiry is the number of blocks after which an uncommitted upload session expires
+	uploadSessionExpiry int64
+	// bankKeeper holds the deposits of the upload sessions in escrow of the module account
+	bankKeeper types.BankKeeper
+	// queuedMigrationGasLimit is the gas limit of each queued migration that is executed in the end blocker
+	queuedMigrationGasLimit sdk.Gas
+	// scheduleBlockGasLimit is the gas that all scheduled callbacks of a block can consume together
+	scheduleBlockGasLimit sdk.Gas
+	// scheduleGasPrice is the price per gas of scheduled callbacks that is paid from their gas deposit
+	scheduleGasPrice sdk.DecCoins
+	// maxSchedulesPerContract is the max number of scheduled callbacks that a contract can register
+	maxSchedulesPerContract uint32
+	// queryRouter serves the stargate queries of contracts and checks the paths that are accepted for them
+	queryRouter GRPCQueryRouter
+	// subMsgDataAsTxMsgData passes the data of all sdk messages of a submessage to the reply instead of the first one
+	subMsgDataAsTxMsgData bool
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -127,6 +177,8 @@ func NewKeeper(
 		wasmVM:            wasmer,
 		accountKeeper:     accountKeeper,
 		bank:              NewBankCoinTransferrer(bankKeeper),
+		bankViewKeeper:    bankKeeper,
+		bankKeeper:        bankKeeper,
 		portKeeper:        portKeeper,
 		capabilityKeeper:  capabilityKeeper,
 		messenger:         NewDefaultMessageHandler(router, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource, customEncoders),
@@ -135,38 +187,42 @@ func NewKeeper(
 		metrics:           NopMetrics(),
 		gasRegister:       NewDefaultWasmGasRegister(),
 		maxQueryStackSize: types.DefaultMaxQueryStackSize,
+
+		contractPurgeChunkSize: defaultContractPurgeChunkSize,
+		authority:              authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		uploadSessionExpiry:    defaultUploadSessionExpiry,
+
+		queuedMigrationGasLimit: defaultQueuedMigrationGasLimit,
+		scheduleBlockGasLimit:   defaultScheduleBlockGasLimit,
+		scheduleGasPrice:        defaultScheduleGasPrice,
+		maxSchedulesPerContract: defaultMaxSchedulesPerContract,
+		queryRouter:             queryRouter,
 	}
-	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper).Merge(customPlugins)
+	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, cdc, keeper).Merge(customPlugins)
 	for _, o := range opts {
 		o.apply(keeper)
 	}
 	// not updateable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	dispatcher := NewMessageDispatcher(keeper.messenger, keeper)
+	dispatcher.subMsgDataAsTxMsgData = keeper.subMsgDataAsTxMsgData
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(dispatcher)
 	return *keeper
 }
 
 func (k Keeper) getUploadAccessConfig(ctx sdk.Context) types.AccessConfig {
-	var a types.AccessConfig
-	k.paramSpace.Get(ctx, types.ParamStoreKeyUploadAccess, &a)
-	return a
+	return k.GetParams(ctx).CodeUploadAccess
 }
 
 func (k Keeper) getInstantiateAccessConfig(ctx sdk.Context) types.AccessType {
-	var a types.AccessType
-	k.paramSpace.Get(ctx, types.ParamStoreKeyInstantiateAccess, &a)
-	return a
+	return k.GetParams(ctx).InstantiateDefaultPermission
 }
 
 func (k Keeper) getGasMultiplier(ctx sdk.Context) GasMultiplier {
-	var a uint64
-	k.paramSpace.Get(ctx, types.ParamStoreKeyGasMultiplier, &a)
-	return NewGasMultiplier(a)
+	return NewGasMultiplier(k.GetParams(ctx).GasMultiplier)
 }
 
 func (k Keeper) getInstanceCost(ctx sdk.Context) uint64 {
-	var a uint64
-	k.paramSpace.Get(ctx, types.ParamStoreKeyInstanceCost, &a)
-	return a
+	return k.GetParams(ctx).InstanceCost
 }
 
 // NewContractInstanceCosts costs to crate a new contract instance from code
@@ -203,10 +259,24 @@ func (k Keeper) replyCosts(g WasmGasRegister, ctx sdk.Context, pinned bool, repl
 	return eventGas + k.instantiateContractCosts(g, ctx, pinned, msgLen)
 }
 
+func (k Keeper) getUniqueLabelPerCreator(ctx sdk.Context) bool {
+	return k.GetParams(ctx).UniqueLabelPerCreator
+}
+
 func (k Keeper) getCompileCost(ctx sdk.Context) uint64 {
-	var a uint64
-	k.paramSpace.Get(ctx, types.ParamStoreKeyCompileCost, &a)
-	return a
+	return k.GetParams(ctx).CompileCost
+}
+
+func (k Keeper) getMaxWasmSize(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxWasmSize
+}
+
+func (k Keeper) getMaxLabelSize(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxLabelSize
+}
+
+func (k Keeper) getMaxDecompressedWasmSize(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxDecompressedWasmSize
 }
 
 // CompileCosts costs to persist and "compile" a new wasm contract
@@ -218,17 +288,42 @@ func (k Keeper) compileCosts(ctx sdk.Context, byteLength int) storetypes.Gas {
 }
 
 // GetParams returns the total set of wasm parameters.
+// The params are read without gas consumption like they were read from the legacy params subspace before.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
+// SetParams stores the total set of wasm parameters. It panics on invalid params.
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
-	k.paramSpace.SetParamSet(ctx, &ps)
+	if err := ps.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&ps))
 }
 
-func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error) {
+// GetAuthority returns the address that is allowed to update the params
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) updateParams(ctx sdk.Context, authority sdk.AccAddress, ps types.Params) error {
+	if authority.String() != k.authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected %s got %s", k.authority, authority)
+	}
+	if err := ps.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	k.SetParams(ctx, ps)
+	return nil
+}
+
+func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, metadata *types.CodeMetadata, authZ AuthorizationPolicy) (codeID uint64, err error) {
 	if creator == nil {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot be empty")
 	}
@@ -245,23 +340,44 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "instantiate access must be subset of default upload access")
 	}
 
-	wasmCode, err = ioutils.Uncompress(wasmCode, uint64(types.MaxWasmSize))
+	if maxSize := k.getMaxWasmSize(ctx); uint64(len(wasmCode)) > maxSize {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "code cannot be longer than %d bytes", maxSize)
+	}
+	wasmCode, err = ioutils.Uncompress(wasmCode, k.getMaxDecompressedWasmSize(ctx))
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	checksum := sha256.Sum256(wasmCode)
+	if metadata != nil && metadata.IsEmpty() {
+		metadata = nil
+	}
+	if metadata != nil && len(metadata.CodeHashAttestation) != 0 && !bytes.Equal(metadata.CodeHashAttestation, checksum[:]) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "code hash attestation does not match the code hash")
+	}
+	if k.reuseCodeByChecksum {
+		if codeID, ok := k.findReusableCode(ctx, checksum, creator, *instantiateAccess, metadata); ok {
+			k.Logger(ctx).Debug("reusing stored contract", "code_id", codeID)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeStoreCode,
+				sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+			))
+			return codeID, nil
+		}
+	}
 	ctx.GasMeter().ConsumeGas(k.compileCosts(ctx, len(wasmCode)), "Compiling WASM Bytecode")
 
-	checksum, err := k.wasmVM.Create(wasmCode)
+	codeHash, err := k.wasmVM.Create(wasmCode)
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
-	report, err := k.wasmVM.AnalyzeCode(checksum)
+	report, err := k.wasmVM.AnalyzeCode(codeHash)
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 	k.Logger(ctx).Debug("storing new contract", "features", report.RequiredFeatures, "code_id", codeID)
-	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
+	codeInfo := types.NewCodeInfo(codeHash, creator, *instantiateAccess)
+	codeInfo.Metadata = metadata
 	k.storeCodeInfo(ctx, codeID, codeInfo)
 
 	evt := sdk.NewEvent(
@@ -276,14 +392,90 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	return codeID, nil
 }
 
+// setCodeMetadata sets the metadata of a code that was stored without it. The metadata can be set once by the creator
+// of the code so that the link to the source can not be changed afterwards.
+func (k Keeper) setCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata types.CodeMetadata) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if codeInfo.Creator != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify code")
+	}
+	if codeInfo.Metadata != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "code metadata already set")
+	}
+	if metadata.IsEmpty() {
+		return sdkerrors.Wrap(types.ErrEmpty, "code metadata")
+	}
+	codeInfo.Metadata = &metadata
+	if err := codeInfo.ValidateBasic(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(codeInfo))
+	return nil
+}
+
 func (k Keeper) storeCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) {
 	store := ctx.KVStore(k.storeKey)
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&codeInfo))
+	k.addToCodeByChecksumIndex(ctx, codeInfo.CodeHash, codeID)
+}
+
+// addToCodeByChecksumIndex adds the code id to the checksum secondary index
+func (k Keeper) addToCodeByChecksumIndex(ctx sdk.Context, checksum []byte, codeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	// 0x09 | checksum | codeID (uint64) -> []byte{}
+	store.Set(types.GetCodeByChecksumIndexKey(checksum, codeID), []byte{})
+}
+
+// GetCodeIDByChecksum returns the lowest code id that was stored with the given checksum
+func (k Keeper) GetCodeIDByChecksum(ctx sdk.Context, checksum []byte) (uint64, bool) {
+	var codeID uint64
+	var found bool
+	k.IterateCodeIDsByChecksum(ctx, checksum, func(id uint64) bool {
+		codeID, found = id, true
+		return true
+	})
+	return codeID, found
+}
+
+// IterateCodeIDsByChecksum iterates over all code ids stored with the given checksum in ascending order.
+// The callback returns true to stop the iteration.
+func (k Keeper) IterateCodeIDsByChecksum(ctx sdk.Context, checksum []byte, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeByChecksumIndexPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(sdk.BigEndianToUint64(iter.Key())) {
+			return
+		}
+	}
+}
+
+// findReusableCode returns a stored code id with the same checksum, instantiate permission and code metadata. A code
+// without metadata is only reused for its creator as nobody else can set the metadata of the returned code id later.
+func (k Keeper) findReusableCode(ctx sdk.Context, checksum [sha256.Size]byte, creator sdk.AccAddress, instantiateAccess types.AccessConfig, metadata *types.CodeMetadata) (uint64, bool) {
+	var codeID uint64
+	var found bool
+	k.IterateCodeIDsByChecksum(ctx, checksum[:], func(id uint64) bool {
+		codeInfo := k.GetCodeInfo(ctx, id)
+		if codeInfo == nil || !codeInfo.InstantiateConfig.Equals(instantiateAccess) || !codeInfo.Metadata.Equal(metadata) {
+			return false
+		}
+		if codeInfo.Metadata == nil && codeInfo.Creator != creator.String() {
+			return false
+		}
+		codeID, found = id, true
+		return true
+	})
+	return codeID, found
 }
 
 func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) error {
-	wasmCode, err := ioutils.Uncompress(wasmCode, uint64(types.MaxWasmSize))
+	wasmCode, err := ioutils.Uncompress(wasmCode, types.MaxImportedWasmSize)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
@@ -297,25 +489,64 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetCodeKey(codeID)
-	if store.Has(key) {
+	if store.Has(key) || store.Has(types.GetRemovedCodeKey(codeID)) {
 		return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	k.addToCodeByChecksumIndex(ctx, codeInfo.CodeHash, codeID)
 	return nil
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
+func (k Keeper) instantiate(
+	ctx sdk.Context,
+	codeID uint64,
+	creator, admin sdk.AccAddress,
+	initMsg []byte,
+	label string,
+	deposit sdk.Coins,
+	addressGenerator AddressGenerator,
+	authZ AuthorizationPolicy,
+) (sdk.AccAddress, []byte, error) {
 	defer func(begin time.Time) { k.metrics.InstantiateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
 
 	instanceCosts := k.newContractInstanceCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, codeID), len(initMsg))
 	ctx.GasMeter().ConsumeGas(instanceCosts, "Loading CosmWasm module: instantiate")
 
+	// get contact info
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+
+	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
+
+	if maxSize := k.getMaxLabelSize(ctx); uint64(len(label)) > maxSize {
+		return nil, nil, sdkerrors.Wrapf(types.ErrLimit, "label cannot be longer than %d characters", maxSize)
+	}
+
+	if k.getUniqueLabelPerCreator(ctx) && k.hasContractWithCreatorAndLabel(ctx, creator, label) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrDuplicate, "label %q already used by creator", label)
+	}
+
 	// create contract address
-	contractAddress := k.generateContractAddress(ctx, codeID)
+	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	if k.HasContractInfo(ctx, contractAddress) {
+		return nil, nil, sdkerrors.Wrap(types.ErrDuplicate, "instance with this code id, sender, salt and init msg exists")
+	}
+	if k.isContractPurgePending(ctx, contractAddress) {
+		return nil, nil, sdkerrors.Wrap(types.ErrDuplicate, "state of a purged instance with this address is not deleted yet")
+	}
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		// a predictable address can be funded before the contract is instantiated. Such a pristine base account is
+		// taken over by the contract while any account that was ever used by a signer or of another type, like a
+		// vesting account with locked coins, is rejected.
+		if _, ok := existingAcct.(*authtypes.BaseAccount); !ok || existingAcct.GetSequence() != 0 || existingAcct.GetPubKey() != nil {
+			return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		}
 	}
 
 	// deposit initial contract funds
@@ -323,35 +554,20 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		if err := k.bank.TransferCoins(ctx, creator, contractAddress, deposit); err != nil {
 			return nil, nil, err
 		}
-	} else {
+	} else if existingAcct == nil {
 		// create an empty account (so we don't have issues later)
 		// TODO: can we remove this?
 		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshal(bz, &codeInfo)
-
-	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
-		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
-	}
-
 	// prepare params for contract instantiate call
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(creator, deposit)
 
-	// create prefixed data store
+	// create prefixed data store, the instantiator pays the storage deposit of the initial state
 	// 0x03 | BuildContractAddress (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddress, creator)
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
@@ -361,7 +577,10 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
+		return nil, nil, wasmVMError(counter, err, types.ErrInstantiateFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, creator, counter); err != nil {
+		return nil, nil, err
 	}
 
 	// persist instance first
@@ -385,6 +604,9 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	// store contract before dispatch so that contract could be called back
 	historyEntry := contractInfo.InitialHistory(initMsg)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
+	k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
+	k.addToContractLabelSecondaryIndex(ctx, label, contractAddress)
+	k.addToContractCreatorAndLabelIndex(ctx, creator, label)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
@@ -405,12 +627,12 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 // Execute executes the contract instance
 func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.ExecuteElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
-	if k.IsInactiveContract(ctx, contractAddress) {
-		return nil, sdkerrors.Wrap(types.ErrInactiveContract, "can not execute")
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointExecute); err != nil {
+		return nil, err
 	}
 
 	executeCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
@@ -429,11 +651,14 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddress, contractAddress)
 	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
-		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return nil, wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, contractAddress, counter); err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -458,8 +683,8 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if contractInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if k.IsInactiveContract(ctx, contractAddress) {
-		return nil, sdkerrors.Wrap(types.ErrInactiveContract, "can not migrate")
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointMigrate); err != nil {
+		return nil, err
 	}
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
@@ -469,6 +694,12 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if newCodeInfo == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if err := k.assertMigrationAllowed(ctx, contractAddress, newCodeID, *newCodeInfo); err != nil {
+		return nil, err
+	}
+	if contractInfo.MigrationDelay != 0 && !authZ.CanMigrateImmediately() {
+		return nil, k.enqueueMigration(ctx, contractAddress, caller, newCodeID, msg, contractInfo.MigrationDelay)
+	}
 
 	// check for IBC flag
 	switch report, err := k.wasmVM.AnalyzeCode(newCodeInfo.CodeHash); {
@@ -491,14 +722,15 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddress, contractAddress)
 	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, &wasmStore, k.cosmwasmAPI(ctx), &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
+		return nil, wasmVMError(counter, err, types.ErrMigrationFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, contractAddress, counter); err != nil {
+		return nil, err
 	}
 
 	// delete old secondary index entry
@@ -528,10 +760,13 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 // place any access controls on it, that is the responsibility or the app developer (who passes the wasm.Keeper in app.go)
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.SudoElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointSudo); err != nil {
+		return nil, err
+	}
 
 	sudoSetupCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(sudoSetupCosts, "Loading CosmWasm module: sudo")
@@ -540,12 +775,15 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddress, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
-		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return nil, wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, contractAddress, counter); err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -563,10 +801,13 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointReply); err != nil {
+		return nil, err
+	}
 
 	// always consider this pinned
 	replyCosts := k.replyCosts(k.gasRegister, ctx, true, reply)
@@ -577,11 +818,14 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddress, contractAddress)
 	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
-		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return nil, wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, contractAddress, counter); err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
@@ -608,6 +852,81 @@ func (k Keeper) removeFromContractCodeSecondaryIndex(ctx sdk.Context, contractAd
 	ctx.KVStore(k.storeKey).Delete(types.GetContractByCreatedSecondaryIndexKey(contractAddress, entry))
 }
 
+// addToContractCreatorSecondaryIndex adds element to the index for contracts-by-creator queries
+func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creatorAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, *position, contractAddress), []byte{})
+}
+
+// IterateContractsByCreator iterates over all contracts with given creator address ASC on creation time.
+func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCreatorPrefix(creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(key[types.AbsoluteTxPositionLen:]) {
+			return
+		}
+	}
+}
+
+// addToContractLabelSecondaryIndex adds element to the index for contracts-by-label queries
+func (k Keeper) addToContractLabelSecondaryIndex(ctx sdk.Context, label string, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByLabelSecondaryIndexKey(label, contractAddress), contractAddress)
+}
+
+// IterateContractsByLabel iterates over all contracts with a label that starts with the given label prefix,
+// ordered by label ASC and contract address ASC.
+func (k Keeper) IterateContractsByLabel(ctx sdk.Context, labelPrefix string, cb func(label string, address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByLabelPrefix(labelPrefix))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, contractAddr := iter.Key(), iter.Value()
+		label := labelPrefix + string(key[:len(key)-len(contractAddr)-1])
+		if cb(label, contractAddr) {
+			return
+		}
+	}
+}
+
+// addToContractCreatorAndLabelIndex counts the contracts instantiated by the creator with exactly the given label
+func (k Keeper) addToContractCreatorAndLabelIndex(ctx sdk.Context, creator sdk.AccAddress, label string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractByCreatorAndLabelKey(creator, label)
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+}
+
+// removeFromContractCreatorAndLabelIndex decrements the contract count of the creator and label and drops the entry
+// with the last contract. A creator can own multiple contracts with the same label when uniqueness was not enforced.
+func (k Keeper) removeFromContractCreatorAndLabelIndex(ctx sdk.Context, creator sdk.AccAddress, label string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractByCreatorAndLabelKey(creator, label)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	if count := sdk.BigEndianToUint64(bz); count > 1 {
+		store.Set(key, sdk.Uint64ToBigEndian(count-1))
+		return
+	}
+	store.Delete(key)
+}
+
+// hasContractWithCreatorAndLabel returns true when the creator has already instantiated a contract with exactly the
+// given label
+func (k Keeper) hasContractWithCreatorAndLabel(ctx sdk.Context, creator sdk.AccAddress, label string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetContractByCreatorAndLabelKey(creator, label))
+}
+
 // IterateContractsByCode iterates over all contracts with given codeID ASC on code update time.
 func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
@@ -627,17 +946,217 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if contractInfo == nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if k.IsInactiveContract(ctx, contractAddress) {
-		return sdkerrors.Wrap(types.ErrInactiveContract, "can not modify contract")
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointUpdateAdmin); err != nil {
+		return err
 	}
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
 	contractInfo.Admin = newAdmin.String()
+	if contractInfo.PendingAdmin != "" {
+		// a proposal of the replaced admin must not be accepted anymore
+		k.appendToContractHistory(ctx, contractAddress, contractInfo.CancelPendingAdmin(ctx))
+	}
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
 	return nil
 }
 
+// proposeContractAdmin sets the pending admin of a contract. The admin is changed only when the pending admin accepts.
+func (k Keeper) proposeContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointUpdateAdmin); err != nil {
+		return err
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	k.appendToContractHistory(ctx, contractAddress, contractInfo.ProposeAdmin(ctx, newAdmin))
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	return nil
+}
+
+// acceptContractAdmin makes the caller the admin of a contract where it is the pending admin.
+func (k Keeper) acceptContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointUpdateAdmin); err != nil {
+		return err
+	}
+	if contractInfo.PendingAdmin == "" {
+		return sdkerrors.Wrap(types.ErrNotFound, "pending admin")
+	}
+	if !contractInfo.PendingAdminAddr().Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the pending admin")
+	}
+	k.appendToContractHistory(ctx, contractAddress, contractInfo.AcceptAdmin(ctx))
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	return nil
+}
+
+// cancelPendingContractAdmin drops the pending admin of a contract.
+func (k Keeper) cancelPendingContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if err := k.assertContractActive(ctx, contractAddress, types.EntryPointUpdateAdmin); err != nil {
+		return err
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if contractInfo.PendingAdmin == "" {
+		return sdkerrors.Wrap(types.ErrNotFound, "pending admin")
+	}
+	k.appendToContractHistory(ctx, contractAddress, contractInfo.CancelPendingAdmin(ctx))
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	return nil
+}
+
+// purgeContract deletes the contract info, the code history, all secondary index entries, the fee allowances, the
+// schedules, the privileged registration and the inactive contract details of a contract. The storage and gas
+// deposits are refunded, the remaining balance is sent to the beneficiary and the IBC port is released. The contract
+// state is deleted in chunks, entries beyond the first chunk are deleted by the following end blockers.
+func (k Keeper) purgeContract(ctx sdk.Context, contractAddress, caller, beneficiary sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not purge contract")
+	}
+	// only governance can purge a contract that it deactivated, the admin must not sweep the frozen balance
+	if !authZ.CanModifyInactiveContract() {
+		if err := k.assertContractActive(ctx, contractAddress, types.EntryPointPurge); err != nil {
+			return err
+		}
+	}
+
+	// the bank does not let inactive contracts receive funds so that the address must be cleared first
+	if k.IsInactiveContract(ctx, contractAddress) {
+		if err := k.activateContract(ctx, contractAddress); err != nil {
+			return err
+		}
+	}
+	if err := k.refundStorageDeposit(ctx, contractAddress); err != nil {
+		return err
+	}
+	if err := k.deleteSchedules(ctx, contractAddress); err != nil {
+		return err
+	}
+	balance := k.bankViewKeeper.GetAllBalances(ctx, contractAddress)
+	if !balance.IsZero() {
+		if err := k.bank.TransferCoins(ctx, contractAddress, beneficiary, balance); err != nil {
+			return sdkerrors.Wrap(err, "sweep balance")
+		}
+	}
+	if contractInfo.IBCPortID != "" {
+		if err := k.releaseIbcPort(ctx, contractInfo.IBCPortID); err != nil {
+			return sdkerrors.Wrap(err, "release ibc port")
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractHistoryEntry(ctx, contractAddress))
+	creator := sdk.MustAccAddressFromBech32(contractInfo.Creator)
+	if contractInfo.Created != nil {
+		store.Delete(types.GetContractByCreatorSecondaryIndexKey(creator, *contractInfo.Created, contractAddress))
+	}
+	k.removeFromContractCreatorAndLabelIndex(ctx, creator, contractInfo.Label)
+	store.Delete(types.GetContractByLabelSecondaryIndexKey(contractInfo.Label, contractAddress))
+	k.deleteContractHistory(ctx, contractAddress)
+	if pending := k.GetPendingMigration(ctx, contractAddress); pending != nil {
+		k.deletePendingMigration(ctx, contractAddress, *pending)
+	}
+	store.Delete(types.GetMigrationAllowlistKey(contractAddress))
+	store.Delete(types.GetContractStorageQuotaKey(contractAddress))
+	store.Delete(types.GetPrivilegedContractKey(contractAddress))
+	k.deleteFeeAllowances(ctx, contractAddress)
+	store.Delete(types.GetContractAddressKey(contractAddress))
+
+	if _, done := k.deleteContractState(ctx, contractAddress, k.contractPurgeChunkSize); !done {
+		store.Set(types.GetPendingContractPurgeKey(contractAddress), []byte{})
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&lbmtypes.EventPurgeContract{
+		Contract:    contractAddress.String(),
+		Beneficiary: beneficiary.String(),
+		Amount:      balance,
+	})
+}
+
+// deleteContractHistory deletes all code history entries of the contract
+func (k Keeper) deleteContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCodeHistoryElementPrefix(contractAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+// deleteContractState deletes up to limit entries of the contract state. It returns the number of deleted entries
+// and true when no entries are left.
+func (k Keeper) deleteContractState(ctx sdk.Context, contractAddr sdk.AccAddress, limit uint32) (uint32, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid() && uint32(len(keys)) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	done := !iter.Valid()
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	return uint32(len(keys)), done
+}
+
+// isContractPurgePending returns true when the contract was purged but not all state entries are deleted yet
+func (k Keeper) isContractPurgePending(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetPendingContractPurgeKey(contractAddr))
+}
+
+// DeletePurgedContractStates deletes the remaining state entries of purged contracts. No more than the purge chunk
+// size entries are deleted in total so that the work is spread over multiple blocks.
+func (k Keeper) DeletePurgedContractStates(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(types.PendingContractPurgePrefix)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingContractPurgePrefix)
+	var pending []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		pending = append(pending, iter.Key()[prefixLen:])
+	}
+	iter.Close()
+
+	budget := k.contractPurgeChunkSize
+	for _, contractAddr := range pending {
+		if budget == 0 {
+			return nil
+		}
+		deleted, done := k.deleteContractState(ctx, contractAddr, budget)
+		if !done {
+			return nil
+		}
+		budget -= deleted
+		store.Delete(types.GetPendingContractPurgeKey(contractAddr))
+		event := lbmtypes.EventContractStatePurged{Contract: contractAddr.String()}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k Keeper) appendToContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress, newEntries ...types.ContractCodeHistoryEntry) {
 	store := ctx.KVStore(k.storeKey)
 	// find last element position
@@ -647,7 +1166,7 @@ func (k Keeper) appendToContractHistory(ctx sdk.Context, contractAddr sdk.AccAdd
 	defer iter.Close()
 
 	if iter.Valid() {
-		pos = sdk.BigEndianToUint64(iter.Value())
+		pos = sdk.BigEndianToUint64(iter.Key())
 	}
 	// then store with incrementing position
 	for i := range newEntries {
@@ -671,19 +1190,22 @@ func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress)
 	return r
 }
 
-// getLastContractHistoryEntry returns the last element from history. To be used internally only as it panics when none exists
+// getLastContractHistoryEntry returns the last code changing element from history. Admin changes are skipped as they
+// are not part of the contracts-by-code index. To be used internally only as it panics when none exists
 func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractCodeHistoryEntry {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCodeHistoryElementPrefix(contractAddr))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 
 	var r types.ContractCodeHistoryEntry
-	if !iter.Valid() {
-		// all contracts have a history
-		panic(fmt.Sprintf("no history for %s", contractAddr.String()))
+	for ; iter.Valid(); iter.Next() {
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		if r.Operation.IsCodeChange() {
+			return r
+		}
 	}
-	k.cdc.MustUnmarshal(iter.Value(), &r)
-	return r
+	// all contracts have a history
+	panic(fmt.Sprintf("no history for %s", contractAddr.String()))
 }
 
 // QuerySmart queries the smart contract itself.
@@ -700,6 +1222,9 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointQuery); err != nil {
+		return nil, err
+	}
 
 	smartQuerySetupCosts := k.instantiateContractCosts(k.gasRegister, ctx, k.IsPinnedCode(ctx, contractInfo.CodeID), len(req))
 	ctx.GasMeter().ConsumeGas(smartQuerySetupCosts, "Loading CosmWasm module: query")
@@ -828,15 +1353,24 @@ func (k Keeper) IterateContractState(ctx sdk.Context, contractAddress sdk.AccAdd
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	// check all keys first so that the state and the storage usage are imported together or not at all
+	seen := make(map[string]struct{}, len(models))
+	for _, model := range models {
+		if _, ok := seen[string(model.Key)]; ok || prefixStore.Has(model.Key) {
+			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
+		}
+		seen[string(model.Key)] = struct{}{}
+	}
+	storage := k.GetContractStorage(ctx, contractAddress)
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
 		}
-		if prefixStore.Has(model.Key) {
-			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
-		}
 		prefixStore.Set(model.Key, model.Value)
+		storage.Bytes += uint64(len(model.Key) + len(model.Value))
+		storage.Keys++
 	}
+	k.storeContractStorage(ctx, contractAddress, storage)
 	return nil
 }
 
@@ -882,6 +1416,73 @@ func (k Keeper) GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error) {
 	return k.wasmVM.GetCode(codeInfo.CodeHash)
 }
 
+// removeCode deletes the code info and the checksum index entry of a code without contract instances and unpins the
+// code. The code info is kept as removed code info so that queries can tell removed codes from unknown ones.
+// The wasm file is not deleted from the wasmvm cache as it can not be removed there.
+func (k Keeper) removeCode(ctx sdk.Context, codeID uint64) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	var hasContracts bool
+	k.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+		hasContracts = true
+		return true
+	})
+	if hasContracts {
+		return sdkerrors.Wrap(types.ErrInvalid, "code has contract instances")
+	}
+	if k.IsPinnedCode(ctx, codeID) {
+		if err := k.unpinCode(ctx, codeID); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCodeKey(codeID))
+	store.Delete(types.GetCodeByChecksumIndexKey(codeInfo.CodeHash, codeID))
+	store.Set(types.GetRemovedCodeKey(codeID), k.cdc.MustMarshal(codeInfo))
+	return nil
+}
+
+// importRemovedCode stores the code info of a removed code from genesis.
+func (k Keeper) importRemovedCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRemovedCodeKey(codeID)
+	if store.Has(key) || store.Has(types.GetCodeKey(codeID)) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
+	}
+	store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	return nil
+}
+
+// IterateRemovedCodeInfos iterates over the code infos of all removed codes ordered by code id.
+func (k Keeper) IterateRemovedCodeInfos(ctx sdk.Context, cb func(uint64, types.CodeInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RemovedCodeKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var c types.CodeInfo
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		// cb returns true to stop early
+		if cb(binary.BigEndian.Uint64(iter.Key()), c) {
+			return
+		}
+	}
+}
+
+// GetRemovedCodeInfo returns the code info of a removed code or nil when the code was not removed.
+func (k Keeper) GetRemovedCodeInfo(ctx sdk.Context, codeID uint64) *types.CodeInfo {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRemovedCodeKey(codeID))
+	if bz == nil {
+		return nil
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshal(bz, &codeInfo)
+	return &codeInfo
+}
+
 // PinCode pins the wasm contract in wasmvm cache
 func (k Keeper) pinCode(ctx sdk.Context, codeID uint64) error {
 	codeInfo := k.GetCodeInfo(ctx, codeID)
@@ -1078,10 +1679,18 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 		return sdkerrors.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
 	}
 
+	creatorAddress, err := sdk.AccAddressFromBech32(c.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+
 	historyEntry := c.ResetFromGenesis(ctx)
 	k.appendToContractHistory(ctx, contractAddr, historyEntry)
 	k.storeContractInfo(ctx, contractAddr, c)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddr, historyEntry)
+	k.addToContractCreatorSecondaryIndex(ctx, creatorAddress, historyEntry.Updated, contractAddr)
+	k.addToContractLabelSecondaryIndex(ctx, c.Label, contractAddr)
+	k.addToContractCreatorAndLabelIndex(ctx, creatorAddress, c.Label)
 	return k.importContractState(ctx, contractAddr, state)
 }
 
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 
+	"github.com/line/wasmd/x/wasm/lbmtypes"
 	wasmtypes "github.com/line/wasmd/x/wasm/types"
 )
 
@@ -12,6 +13,30 @@ func (k Keeper) IsInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddre
 	return store.Has(wasmtypes.GetInactiveContractKey(contractAddress))
 }
 
+// GetInactiveContractInfo returns the deactivation details of an inactive contract or nil when the contract is active.
+func (k Keeper) GetInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.InactiveContractInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(wasmtypes.GetInactiveContractKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var info wasmtypes.InactiveContractInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return &info
+}
+
+// assertContractActive returns an ErrInactiveContract error with the contract address and the entry point when the
+// contract is inactive and the entry point is not allowed for inactive contracts.
+func (k Keeper) assertContractActive(ctx sdk.Context, contractAddress sdk.AccAddress, entryPoint wasmtypes.ContractEntryPoint) error {
+	if _, ok := k.inactiveContractAllowedEntryPoints[entryPoint]; ok {
+		return nil
+	}
+	if !k.IsInactiveContract(ctx, contractAddress) {
+		return nil
+	}
+	return sdkerrors.Wrapf(wasmtypes.ErrInactiveContract, "contract %s: can not %s", contractAddress, entryPoint)
+}
+
 func (k Keeper) IterateInactiveContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	prefix := wasmtypes.InactiveContractPrefix
@@ -19,24 +44,34 @@ func (k Keeper) IterateInactiveContracts(ctx sdk.Context, fn func(contractAddres
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		contractAddress := sdk.AccAddress(iterator.Value())
+		contractAddress := sdk.AccAddress(iterator.Key()[len(prefix):])
 		if stop := fn(contractAddress); stop {
 			break
 		}
 	}
 }
 
-func (k Keeper) addInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) {
+func (k Keeper) addInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress, info wasmtypes.InactiveContractInfo) {
 	store := ctx.KVStore(k.storeKey)
 	key := wasmtypes.GetInactiveContractKey(contractAddress)
 
-	store.Set(key, contractAddress)
+	store.Set(key, k.cdc.MustMarshal(&info))
+	if info.ExpiryHeight != 0 {
+		store.Set(wasmtypes.GetInactiveContractExpiryKey(info.ExpiryHeight, contractAddress), []byte{})
+	}
 }
 
 func (k Keeper) deleteInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	info := k.GetInactiveContractInfo(ctx, contractAddress)
+	if info == nil {
+		return
+	}
 	store := ctx.KVStore(k.storeKey)
 	key := wasmtypes.GetInactiveContractKey(contractAddress)
 	store.Delete(key)
+	if info.ExpiryHeight != 0 {
+		store.Delete(wasmtypes.GetInactiveContractExpiryKey(info.ExpiryHeight, contractAddress))
+	}
 }
 
 // activateContract delete the contract address from inactivateContract list if the contract is deactivated.
@@ -52,16 +87,71 @@ func (k Keeper) activateContract(ctx sdk.Context, contractAddress sdk.AccAddress
 }
 
 // deactivateContract add the contract address to inactivateContract list.
-func (k Keeper) deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+// The deactivated position is set to the current one when not given.
+func (k Keeper) deactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress, info wasmtypes.InactiveContractInfo) error {
 	if k.IsInactiveContract(ctx, contractAddress) {
 		return sdkerrors.Wrapf(wasmtypes.ErrAccountExists, "already inactivate contract %s", contractAddress.String())
 	}
 	if !k.HasContractInfo(ctx, contractAddress) {
 		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "no contract %s", contractAddress.String())
 	}
+	if info.ExpiryHeight < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "negative expiry height")
+	}
+	if info.Deactivated == nil {
+		info.Deactivated = wasmtypes.NewAbsoluteTxPosition(ctx)
+	}
 
-	k.addInactiveContract(ctx, contractAddress)
+	k.addInactiveContract(ctx, contractAddress, info)
 	k.bank.AddToInactiveAddr(ctx, contractAddress)
 
 	return nil
 }
+
+// ActivateExpiredContracts activates all inactive contracts with an expiry height lower than or equal to the current
+// block height.
+func (k Keeper) ActivateExpiredContracts(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(wasmtypes.InactiveContractExpiryPrefix)
+	end := wasmtypes.GetInactiveContractExpiryKey(ctx.BlockHeight()+1, nil)
+	iter := store.Iterator(wasmtypes.InactiveContractExpiryPrefix, end)
+	var expired []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key()[prefixLen+8:])
+	}
+	iter.Close()
+
+	for _, contractAddress := range expired {
+		if err := k.activateContract(ctx, contractAddress); err != nil {
+			return err
+		}
+		event := lbmtypes.EventInactiveContractExpired{Contract: contractAddress.String()}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setPendingDeactivationProposal marks the contract as deactivated by the governance proposal that is executed
+// currently so that the proposal id can be assigned by the GovHooks.
+func (k Keeper) setPendingDeactivationProposal(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(wasmtypes.PendingDeactivationProposalKey, contractAddress)
+}
+
+// assignDeactivationProposalID sets the proposal id on the inactive contract record of the contract that was
+// deactivated by the proposal.
+func (k Keeper) assignDeactivationProposalID(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	contractAddress := store.Get(wasmtypes.PendingDeactivationProposalKey)
+	if contractAddress == nil {
+		return
+	}
+	store.Delete(wasmtypes.PendingDeactivationProposalKey)
+	info := k.GetInactiveContractInfo(ctx, contractAddress)
+	if info == nil {
+		return
+	}
+	info.ProposalID = proposalID
+	store.Set(wasmtypes.GetInactiveContractKey(contractAddress), k.cdc.MustMarshal(info))
+}
@@ -7,23 +7,28 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/line/lbm-sdk/store/prefix"
 	stypes "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	vestingtypes "github.com/line/lbm-sdk/x/auth/vesting/types"
 	banktypes "github.com/line/lbm-sdk/x/bank/types"
 	distributiontypes "github.com/line/lbm-sdk/x/distribution/types"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
 	ocproto "github.com/line/ostracon/proto/ostracon/types"
 	wasmvm "github.com/line/wasmvm"
 	wasmvmtypes "github.com/line/wasmvm/types"
 
 	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
 	"github.com/line/wasmd/x/wasm/types"
 )
 
@@ -127,6 +132,9 @@ func TestCreateStoresInstantiatePermission(t *testing.T) {
 				GasMultiplier:                types.DefaultGasMultiplier,
 				InstanceCost:                 types.DefaultInstanceCost,
 				CompileCost:                  types.DefaultCompileCost,
+				MaxWasmSize:                  types.DefaultMaxWasmSize,
+				MaxLabelSize:                 types.DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      types.DefaultMaxDecompressedWasmSize,
 			})
 			fundAccounts(t, ctx, accKeeper, bankKeeper, myAddr, deposit)
 
@@ -169,6 +177,13 @@ func TestCreateWithParamPermissions(t *testing.T) {
 			srcPermission: types.AccessTypeOnlyAddress.With(otherAddr),
 			expError:      sdkerrors.ErrUnauthorized,
 		},
+		"anyAddress with matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr, creator),
+		},
+		"anyAddress with non matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr),
+			expError:      sdkerrors.ErrUnauthorized,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -197,6 +212,8 @@ func TestEnforceValidPermissionsOnCreate(t *testing.T) {
 
 	onlyCreator := types.AccessTypeOnlyAddress.With(creator)
 	onlyOther := types.AccessTypeOnlyAddress.With(other)
+	anyCreatorAndOther := types.AccessTypeAnyOfAddresses.With(creator, other)
+	anyCreator := types.AccessTypeAnyOfAddresses.With(creator)
 
 	specs := map[string]struct {
 		defaultPermssion    types.AccessType
@@ -246,6 +263,26 @@ func TestEnforceValidPermissionsOnCreate(t *testing.T) {
 			requestedPermission: &onlyOther,
 			expError:            sdkerrors.ErrUnauthorized,
 		},
+		"override everybody with anyOf": {
+			defaultPermssion:    types.AccessTypeEverybody,
+			requestedPermission: &anyCreatorAndOther,
+			grantedPermission:   anyCreatorAndOther,
+		},
+		"anyOf defaults to code creator": {
+			defaultPermssion:    types.AccessTypeAnyOfAddresses,
+			requestedPermission: nil,
+			grantedPermission:   anyCreator,
+		},
+		"can explicitly set to code creator in anyOf": {
+			defaultPermssion:    types.AccessTypeAnyOfAddresses,
+			requestedPermission: &onlyCreator,
+			grantedPermission:   onlyCreator,
+		},
+		"cannot add other addresses to anyOf": {
+			defaultPermssion:    types.AccessTypeAnyOfAddresses,
+			requestedPermission: &anyCreatorAndOther,
+			expError:            sdkerrors.ErrUnauthorized,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -288,6 +325,51 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestCreateReusesCodeByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithCodeReuseByChecksum())
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+	otherCreator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+
+	codeID, err := keeper.Create(ctx, creator, hackatomWasm, &types.AllowEverybody)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), codeID)
+
+	// same code and instantiate permission
+	gasBefore := ctx.GasMeter().GasConsumed()
+	em := sdk.NewEventManager()
+	reusedID, err := keeper.Create(ctx.WithEventManager(em), creator, hackatomWasm, &types.AllowEverybody)
+	require.NoError(t, err)
+	assert.Equal(t, codeID, reusedID)
+	assert.Less(t, ctx.GasMeter().GasConsumed()-gasBefore, keepers.WasmKeeper.compileCosts(ctx, len(hackatomWasm)))
+	expEvt := sdk.Events{sdk.NewEvent("store_code", sdk.NewAttribute("code_id", "1"))}
+	assert.Equal(t, expEvt, em.Events())
+
+	// gzipped payload of the same code
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+	gzippedID, err := keeper.Create(ctx, creator, gzippedWasm, &types.AllowEverybody)
+	require.NoError(t, err)
+	assert.Equal(t, codeID, gzippedID)
+
+	// different instantiate permission stores a new code
+	otherID, err := keeper.Create(ctx, creator, hackatomWasm, &types.AllowNobody)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), otherID)
+
+	// a code without metadata is not reused for another creator that must be able to set the metadata of its code
+	otherCreatorID, err := keeper.Create(ctx, otherCreator, hackatomWasm, &types.AllowEverybody)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), otherCreatorID)
+	require.NoError(t, keeper.SetCodeMetadata(ctx, otherCreatorID, otherCreator, types.CodeMetadata{Source: "https://example.com"}))
+
+	gotID, found := keepers.WasmKeeper.GetCodeIDByChecksum(ctx, keepers.WasmKeeper.GetCodeInfo(ctx, codeID).CodeHash)
+	require.True(t, found)
+	assert.Equal(t, codeID, gotID)
+}
+
 func TestCreateWithSimulation(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 
@@ -361,6 +443,87 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestCreateWithSizeLimits(t *testing.T) {
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcCode   []byte
+		setParams func(p *types.Params)
+		expErr    *sdkerrors.Error
+	}{
+		"within limits": {
+			srcCode:   hackatomWasm,
+			setParams: func(p *types.Params) {},
+		},
+		"exceeds max wasm size": {
+			srcCode: hackatomWasm,
+			setParams: func(p *types.Params) {
+				p.MaxWasmSize = uint64(len(hackatomWasm) - 1)
+			},
+			expErr: types.ErrLimit,
+		},
+		"gzipped within max wasm size": {
+			srcCode: gzippedWasm,
+			setParams: func(p *types.Params) {
+				p.MaxWasmSize = uint64(len(gzippedWasm))
+			},
+		},
+		"gzipped exceeds max decompressed wasm size": {
+			srcCode: gzippedWasm,
+			setParams: func(p *types.Params) {
+				p.MaxDecompressedWasmSize = uint64(len(hackatomWasm) - 1)
+			},
+			expErr: types.ErrCreateFailed,
+		},
+		"max wasm size above default": {
+			srcCode: append(hackatomWasm, make([]byte, types.DefaultMaxWasmSize)...),
+			setParams: func(p *types.Params) {
+				p.MaxWasmSize = 2 * types.DefaultMaxWasmSize
+			},
+			// passes the size limit and fails on compilation
+			expErr: types.ErrCreateFailed,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			params := types.DefaultParams()
+			spec.setParams(&params)
+			keepers.WasmKeeper.SetParams(ctx, params)
+			creator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+
+			// when
+			_, err := keepers.ContractKeeper.Create(ctx, creator, spec.srcCode, nil)
+
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestImportCodeIgnoresMaxDecompressedWasmSize(t *testing.T) {
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.MaxDecompressedWasmSize = uint64(len(hackatomWasm) - 1)
+	k.SetParams(ctx, params)
+	codeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(hackatomWasm))
+
+	// when
+	err = k.importCode(ctx, 1, codeInfo, gzippedWasm)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &codeInfo, k.GetCodeInfo(ctx, 1))
+}
+
 func TestInstantiate(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	keeper := keepers.ContractKeeper
@@ -391,7 +554,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x18bfc), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1c68b), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -517,6 +680,15 @@ func TestInstantiateWithPermissions(t *testing.T) {
 			srcPermission: types.AccessTypeOnlyAddress.With(otherAddr),
 			expError:      sdkerrors.ErrUnauthorized,
 		},
+		"anyAddress with matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr, myAddr),
+			srcActor:      myAddr,
+		},
+		"anyAddress with non matching address": {
+			srcPermission: types.AccessTypeAnyOfAddresses.With(otherAddr),
+			srcActor:      myAddr,
+			expError:      sdkerrors.ErrUnauthorized,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -549,6 +721,102 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.Nil(t, addr)
 }
 
+func TestInstantiateWithUniqueLabelPerCreator(t *testing.T) {
+	specs := map[string]struct {
+		uniqueLabel bool
+		expErr      bool
+	}{
+		"unique label per creator enabled": {
+			uniqueLabel: true,
+			expErr:      true,
+		},
+		"unique label per creator disabled": {
+			uniqueLabel: false,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			params := types.DefaultParams()
+			params.UniqueLabelPerCreator = spec.uniqueLabel
+			keepers.WasmKeeper.SetParams(ctx, params)
+
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			creator := example.CreatorAddr
+			otherCreator := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+			initMsgBz := HackatomExampleInitMsg{Verifier: creator, Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+			const label = "my contract"
+			_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, label, nil)
+			require.NoError(t, err)
+
+			// another creator can always use the same label
+			_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, otherCreator, nil, initMsgBz, label, nil)
+			require.NoError(t, err)
+			// a label that only shares the prefix is not a duplicate
+			_, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, label+" v2", nil)
+			require.NoError(t, err)
+
+			// when
+			addr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, label, nil)
+
+			// then
+			if spec.expErr {
+				require.True(t, types.ErrDuplicate.Is(err), err)
+				require.Nil(t, addr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, addr)
+		})
+	}
+}
+
+func TestContractCreatorAndLabelIndex(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	creator := RandomAccountAddress(t)
+	const label = "my contract"
+
+	// contracts with the same label from the time uniqueness was not enforced
+	k.addToContractCreatorAndLabelIndex(ctx, creator, label)
+	k.addToContractCreatorAndLabelIndex(ctx, creator, label)
+	assert.True(t, k.hasContractWithCreatorAndLabel(ctx, creator, label))
+	assert.False(t, k.hasContractWithCreatorAndLabel(ctx, creator, label+" v2"))
+	assert.False(t, k.hasContractWithCreatorAndLabel(ctx, RandomAccountAddress(t), label))
+
+	// when one of them is removed
+	k.removeFromContractCreatorAndLabelIndex(ctx, creator, label)
+	// then the label is still taken
+	assert.True(t, k.hasContractWithCreatorAndLabel(ctx, creator, label))
+
+	// when the last one is removed
+	k.removeFromContractCreatorAndLabelIndex(ctx, creator, label)
+	// then the label is free again
+	assert.False(t, k.hasContractWithCreatorAndLabel(ctx, creator, label))
+}
+
+func TestInstantiateWithMaxLabelSize(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	params := types.DefaultParams()
+	params.MaxLabelSize = 10
+	keepers.WasmKeeper.SetParams(ctx, params)
+
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsgBz := HackatomExampleInitMsg{Verifier: example.CreatorAddr, Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	// when
+	addr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, strings.Repeat("a", 11), nil)
+
+	// then
+	require.True(t, types.ErrLimit.Is(err), err)
+	require.Nil(t, addr)
+
+	// and a label within the limit is accepted
+	addr, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, strings.Repeat("a", 10), nil)
+	require.NoError(t, err)
+	require.NotNil(t, addr)
+}
+
 func TestInstantiateWithContractDataResponse(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 
@@ -566,6 +834,222 @@ func TestInstantiateWithContractDataResponse(t *testing.T) {
 	assert.Equal(t, []byte("my-response-data"), data)
 }
 
+func TestInstantiate2(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	parentCtx = parentCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	otherCodeID := StoreReflectContract(t, parentCtx, keepers)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	keepers.WasmKeeper.wasmVM = mock // set mock to not fail on contract init message
+
+	verifierAddr := RandomAccountAddress(t)
+	beneficiaryAddr := RandomAccountAddress(t)
+	initMsg := mustMarshal(t, HackatomExampleInitMsg{Verifier: verifierAddr, Beneficiary: beneficiaryAddr})
+
+	otherAddr := keepers.Faucet.NewFundedAccount(parentCtx, sdk.NewInt64Coin("denom", 1_000_000_000))
+
+	const (
+		mySalt  = "my salt"
+		myLabel = "my label"
+	)
+	// create instances for duplicate checks
+	exampleContract := func(t *testing.T, ctx sdk.Context, fixMsg bool) {
+		_, _, err := keepers.ContractKeeper.Instantiate2(
+			ctx,
+			example.CodeID,
+			example.CreatorAddr,
+			nil,
+			initMsg,
+			myLabel,
+			sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			[]byte(mySalt),
+			fixMsg,
+		)
+		require.NoError(t, err)
+	}
+	exampleWithFixMsg := func(t *testing.T, ctx sdk.Context) {
+		exampleContract(t, ctx, true)
+	}
+	exampleWithoutFixMsg := func(t *testing.T, ctx sdk.Context) {
+		exampleContract(t, ctx, false)
+	}
+	specs := map[string]struct {
+		setup   func(t *testing.T, ctx sdk.Context)
+		codeID  uint64
+		sender  sdk.AccAddress
+		salt    []byte
+		initMsg json.RawMessage
+		fixMsg  bool
+		expErr  error
+	}{
+		"fix msg - generates different address than without fixMsg": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: initMsg,
+			fixMsg:  true,
+		},
+		"fix msg - different sender": {
+			setup:   exampleWithFixMsg,
+			codeID:  example.CodeID,
+			sender:  otherAddr,
+			salt:    []byte(mySalt),
+			initMsg: initMsg,
+			fixMsg:  true,
+		},
+		"fix msg - different code": {
+			setup:   exampleWithFixMsg,
+			codeID:  otherCodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: []byte(`{}`),
+			fixMsg:  true,
+		},
+		"fix msg - different salt": {
+			setup:   exampleWithFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte("other salt"),
+			initMsg: initMsg,
+			fixMsg:  true,
+		},
+		"fix msg - different init msg": {
+			setup:   exampleWithFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: mustMarshal(t, HackatomExampleInitMsg{Verifier: otherAddr, Beneficiary: beneficiaryAddr}),
+			fixMsg:  true,
+		},
+		"different sender": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  example.CodeID,
+			sender:  otherAddr,
+			salt:    []byte(mySalt),
+			initMsg: initMsg,
+		},
+		"different code": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  otherCodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: []byte(`{}`),
+		},
+		"different salt": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(`other salt`),
+			initMsg: []byte(`{}`),
+		},
+		"different init msg - reject same address": {
+			setup:   exampleWithoutFixMsg,
+			codeID:  example.CodeID,
+			sender:  example.CreatorAddr,
+			salt:    []byte(mySalt),
+			initMsg: mustMarshal(t, HackatomExampleInitMsg{Verifier: otherAddr, Beneficiary: beneficiaryAddr}),
+			expErr:  types.ErrDuplicate,
+		},
+		"fix msg - long msg": {
+			setup:   exampleWithFixMsg,
+			codeID:  example.CodeID,
+			sender:  otherAddr,
+			salt:    []byte(mySalt),
+			initMsg: []byte(fmt.Sprintf(`{"foo":%q}`, strings.Repeat("b", math.MaxInt16+1))), // too long kills CI
+			fixMsg:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			spec.setup(t, ctx)
+			gotAddr, _, gotErr := keepers.ContractKeeper.Instantiate2(
+				ctx,
+				spec.codeID,
+				spec.sender,
+				nil,
+				spec.initMsg,
+				myLabel,
+				sdk.NewCoins(sdk.NewInt64Coin("denom", 2)),
+				spec.salt,
+				spec.fixMsg,
+			)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotEmpty(t, gotAddr)
+		})
+	}
+}
+
+func TestInstantiate2WithPreFundedAddress(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	keepers.WasmKeeper.wasmVM = mock
+
+	salt := []byte("my salt")
+	initMsg := []byte(`{}`)
+	expAddr := BuildContractAddressPredictable(example.Checksum, example.CreatorAddr, salt, []byte{})
+
+	// pristine account that received funds before instantiation
+	keepers.Faucet.Fund(ctx, expAddr, sdk.NewInt64Coin("denom", 100))
+
+	gotAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "my label", nil, salt, false)
+	require.NoError(t, err)
+	assert.Equal(t, expAddr, gotAddr)
+	assert.Equal(t, sdk.NewInt64Coin("denom", 100), keepers.BankKeeper.GetBalance(ctx, gotAddr, "denom"))
+
+	// an account that was used by a signer must not be taken over
+	usedAddr := BuildContractAddressPredictable(example.Checksum, example.CreatorAddr, []byte("other salt"), []byte{})
+	acc := keepers.AccountKeeper.NewAccountWithAddress(ctx, usedAddr)
+	require.NoError(t, acc.SetSequence(1))
+	keepers.AccountKeeper.SetAccount(ctx, acc)
+
+	_, _, err = keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "my label", nil, []byte("other salt"), false)
+	assert.ErrorIs(t, err, types.ErrAccountExists)
+}
+
+func TestInstantiate2WithVestingAccountAtAddress(t *testing.T) {
+	specs := map[string]func(baseAcc *authtypes.BaseAccount, coins sdk.Coins) authtypes.AccountI{
+		"continuous vesting account": func(baseAcc *authtypes.BaseAccount, coins sdk.Coins) authtypes.AccountI {
+			return vestingtypes.NewContinuousVestingAccount(baseAcc, coins, time.Now().Unix(), time.Now().Add(time.Hour).Unix())
+		},
+		"delayed vesting account": func(baseAcc *authtypes.BaseAccount, coins sdk.Coins) authtypes.AccountI {
+			return vestingtypes.NewDelayedVestingAccount(baseAcc, coins, time.Now().Add(time.Hour).Unix())
+		},
+	}
+	for name, newAccount := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			mock := &wasmtesting.MockWasmer{}
+			wasmtesting.MakeInstantiable(mock)
+			keepers.WasmKeeper.wasmVM = mock
+			salt := []byte("my salt")
+			expAddr := BuildContractAddressPredictable(example.Checksum, example.CreatorAddr, salt, []byte{})
+			// a vesting account created at the predicted address, for example by MsgCreateVestingAccount
+			coins := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+			baseAcc := keepers.AccountKeeper.NewAccountWithAddress(ctx, expAddr).(*authtypes.BaseAccount)
+			keepers.AccountKeeper.SetAccount(ctx, newAccount(baseAcc, coins))
+			keepers.Faucet.Fund(ctx, expAddr, coins...)
+
+			// when
+			_, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{}`), "my label", nil, salt, false)
+
+			// then
+			assert.ErrorIs(t, err, types.ErrAccountExists)
+			assert.False(t, keepers.WasmKeeper.HasContractInfo(ctx, expAddr))
+		})
+	}
+}
+
 func TestExecute(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.ContractKeeper, keepers.BankKeeper
@@ -1462,6 +1946,189 @@ func TestClearContractAdmin(t *testing.T) {
 	}
 }
 
+func TestTwoStepContractAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	fred := RandomAccountAddress(t)
+
+	// when proposed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err := keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred)
+	require.NoError(t, err)
+
+	// then the admin is not changed yet
+	cInfo := k.GetContractInfo(ctx, example.Contract)
+	assert.Equal(t, example.CreatorAddr.String(), cInfo.Admin)
+	assert.Equal(t, fred.String(), cInfo.PendingAdmin)
+
+	// when accepted
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	err = keepers.ContractKeeper.AcceptContractAdmin(ctx, example.Contract, fred)
+	require.NoError(t, err)
+
+	// then
+	cInfo = k.GetContractInfo(ctx, example.Contract)
+	assert.Equal(t, fred.String(), cInfo.Admin)
+	assert.Empty(t, cInfo.PendingAdmin)
+	history := k.GetContractHistory(ctx, example.Contract)
+	require.Len(t, history, 3)
+	assert.Equal(t, types.ContractCodeHistoryOperationTypeAdminProposed, history[1].Operation)
+	assert.JSONEq(t, fmt.Sprintf(`{"pending_admin":%q}`, fred.String()), string(history[1].Msg))
+	assert.Equal(t, types.ContractCodeHistoryOperationTypeAdminAccepted, history[2].Operation)
+	assert.JSONEq(t, fmt.Sprintf(`{"admin":%q}`, fred.String()), string(history[2].Msg))
+	assert.Equal(t, example.CodeID, history[2].CodeID)
+
+	// and the new admin can migrate with the contracts-by-code index updated
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, fred, newCodeExample.CodeID, migMsgBz)
+	require.NoError(t, err)
+	history = k.GetContractHistory(ctx, example.Contract)
+	require.Len(t, history, 4)
+	assert.Equal(t, types.ContractCodeHistoryOperationTypeMigrate, history[3].Operation)
+	assert.Equal(t, history[3], k.getLastContractHistoryEntry(ctx, example.Contract))
+	var gotAddrs []sdk.AccAddress
+	k.IterateContractsByCode(ctx, example.CodeID, func(address sdk.AccAddress) bool {
+		gotAddrs = append(gotAddrs, address)
+		return false
+	})
+	assert.Empty(t, gotAddrs)
+}
+
+func TestTwoStepContractAdminFailures(t *testing.T) {
+	fred := RandomAccountAddress(t)
+	specs := map[string]struct {
+		do     func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error
+		expErr *sdkerrors.Error
+	}{
+		"propose by non admin": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				return k.ProposeContractAdmin(ctx, example.Contract, fred, fred)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"propose for unknown contract": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				return k.ProposeContractAdmin(ctx, fred, example.CreatorAddr, fred)
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		"accept without pending admin": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				return k.AcceptContractAdmin(ctx, example.Contract, fred)
+			},
+			expErr: types.ErrNotFound,
+		},
+		"accept by other than pending admin": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred))
+				return k.AcceptContractAdmin(ctx, example.Contract, example.CreatorAddr)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"accept after cancel": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred))
+				require.NoError(t, k.CancelPendingContractAdmin(ctx, example.Contract, example.CreatorAddr))
+				return k.AcceptContractAdmin(ctx, example.Contract, fred)
+			},
+			expErr: types.ErrNotFound,
+		},
+		"accept after admin updated": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred))
+				require.NoError(t, k.UpdateContractAdmin(ctx, example.Contract, example.CreatorAddr, example.VerifierAddr))
+				return k.AcceptContractAdmin(ctx, example.Contract, fred)
+			},
+			expErr: types.ErrNotFound,
+		},
+		"cancel by non admin": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				require.NoError(t, k.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred))
+				return k.CancelPendingContractAdmin(ctx, example.Contract, fred)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"cancel without pending admin": {
+			do: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, example HackatomExampleInstance) error {
+				return k.CancelPendingContractAdmin(ctx, example.Contract, example.CreatorAddr)
+			},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+			// when
+			gotErr := spec.do(t, ctx, keepers.ContractKeeper, example)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), gotErr)
+			assert.NotEqual(t, fred.String(), keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).Admin)
+		})
+	}
+}
+
+func TestCancelPendingContractAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	fred := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred))
+
+	// when
+	err := keepers.ContractKeeper.CancelPendingContractAdmin(ctx, example.Contract, example.CreatorAddr)
+
+	// then
+	require.NoError(t, err)
+	cInfo := k.GetContractInfo(ctx, example.Contract)
+	assert.Equal(t, example.CreatorAddr.String(), cInfo.Admin)
+	assert.Empty(t, cInfo.PendingAdmin)
+	history := k.GetContractHistory(ctx, example.Contract)
+	require.Len(t, history, 3)
+	assert.Equal(t, types.ContractCodeHistoryOperationTypeAdminProposalCanceled, history[2].Operation)
+	assert.JSONEq(t, fmt.Sprintf(`{"pending_admin":%q}`, fred.String()), string(history[2].Msg))
+}
+
+func TestTwoStepContractAdminOnInactiveContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	fred := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, fred))
+	require.NoError(t, k.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{}))
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"propose": func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.ProposeContractAdmin(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t))
+		},
+		"accept": func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.AcceptContractAdmin(ctx, example.Contract, fred)
+		},
+		"cancel": func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.CancelPendingContractAdmin(ctx, example.Contract, example.CreatorAddr)
+		},
+	}
+	for name, call := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			// when
+			gotErr := call(ctx)
+
+			// then
+			require.True(t, types.ErrInactiveContract.Is(gotErr), gotErr)
+			cInfo := k.GetContractInfo(ctx, example.Contract)
+			assert.Equal(t, example.CreatorAddr.String(), cInfo.Admin)
+			assert.Equal(t, fred.String(), cInfo.PendingAdmin)
+		})
+	}
+}
+
 func TestExecuteManualInactiveContractFailure(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	keeper := keepers.ContractKeeper
@@ -1551,6 +2218,83 @@ func TestUnpinCode(t *testing.T) {
 	assert.Equal(t, exp, em.Events())
 }
 
+func TestRemoveCode(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var unpinned bool
+	mock := wasmtesting.MockWasmer{
+		PinFn: func(checksum wasmvm.Checksum) error {
+			return nil
+		},
+		UnpinFn: func(checksum wasmvm.Checksum) error {
+			unpinned = true
+			return nil
+		},
+	}
+	wasmtesting.MakeInstantiable(&mock)
+	unusedCode := StoreRandomContract(t, ctx, keepers, &mock)
+	usedCode := StoreRandomContract(t, ctx, keepers, &mock)
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx, usedCode.CodeID, usedCode.CreatorAddr, nil, []byte(`{}`), "used", nil)
+	require.NoError(t, err)
+	require.NoError(t, k.pinCode(ctx, unusedCode.CodeID))
+	codeInfo := k.GetCodeInfo(ctx, unusedCode.CodeID)
+	require.NotNil(t, codeInfo)
+
+	// when
+	gotErr := k.removeCode(ctx, unusedCode.CodeID)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.True(t, unpinned)
+	assert.False(t, k.IsPinnedCode(ctx, unusedCode.CodeID))
+	assert.Nil(t, k.GetCodeInfo(ctx, unusedCode.CodeID))
+	assert.Equal(t, codeInfo, k.GetRemovedCodeInfo(ctx, unusedCode.CodeID))
+	_, found := k.GetCodeIDByChecksum(ctx, codeInfo.CodeHash)
+	assert.False(t, found)
+
+	// and codes with contract instances are kept
+	gotErr = k.removeCode(ctx, usedCode.CodeID)
+	require.True(t, types.ErrInvalid.Is(gotErr), gotErr)
+	assert.NotNil(t, k.GetCodeInfo(ctx, usedCode.CodeID))
+
+	// and unknown or removed codes are rejected
+	gotErr = k.removeCode(ctx, unusedCode.CodeID)
+	require.True(t, types.ErrNotFound.Is(gotErr), gotErr)
+	gotErr = k.removeCode(ctx, 100)
+	require.True(t, types.ErrNotFound.Is(gotErr), gotErr)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	require.Equal(t, govAuthority.String(), k.GetAuthority())
+	params := types.DefaultParams()
+	params.InstantiateDefaultPermission = types.AccessTypeNobody
+
+	// when not authority
+	err := keepers.ContractKeeper.UpdateParams(ctx, RandomAccountAddress(t), params)
+	// then
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// when invalid params
+	invalidParams := params
+	invalidParams.CompileCost = 0
+	err = keepers.ContractKeeper.UpdateParams(ctx, govAuthority, invalidParams)
+	// then
+	require.True(t, types.ErrInvalid.Is(err), err)
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// when authority
+	err = keepers.ContractKeeper.UpdateParams(ctx, govAuthority, params)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, params, k.GetParams(ctx))
+}
+
 func TestInitializePinnedCodes(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	k := keepers.WasmKeeper
@@ -1874,7 +2618,7 @@ func TestActivateContract(t *testing.T) {
 	require.Error(t, err, fmt.Sprintf("no inactivate contract %s", example.Contract))
 
 	// add to inactive contract
-	err = k.deactivateContract(ctx, example.Contract)
+	err = k.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
 
 	// try to activate an inactivated contract -> success
@@ -1892,18 +2636,192 @@ func TestDeactivateContract(t *testing.T) {
 	em := sdk.NewEventManager()
 
 	// request no contract address -> fail
-	err := k.deactivateContract(ctx, example.CreatorAddr)
+	err := k.deactivateContract(ctx, example.CreatorAddr, types.InactiveContractInfo{})
 	require.Error(t, err, fmt.Sprintf("no contract %s", example.CreatorAddr))
 
 	// success case
-	err = k.deactivateContract(ctx, example.Contract)
+	err = k.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
 
 	// already inactivate contract -> fail
-	err = k.deactivateContract(ctx.WithEventManager(em), example.Contract)
+	err = k.deactivateContract(ctx.WithEventManager(em), example.Contract, types.InactiveContractInfo{})
 	require.Error(t, err, fmt.Sprintf("already inactivate contract %s", example.Contract))
 }
 
+func TestInactiveContractEntryPoints(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeIBCInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	require.NoError(t, k.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{}))
+
+	specs := map[types.ContractEntryPoint]func(ctx sdk.Context) error{
+		types.EntryPointExecute: func(ctx sdk.Context) error {
+			_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+			return err
+		},
+		types.EntryPointMigrate: func(ctx sdk.Context) error {
+			_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, []byte(`{}`))
+			return err
+		},
+		types.EntryPointUpdateAdmin: func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr)
+		},
+		types.EntryPointSudo: func(ctx sdk.Context) error {
+			_, err := k.Sudo(ctx, example.Contract, []byte(`{}`))
+			return err
+		},
+		types.EntryPointReply: func(ctx sdk.Context) error {
+			_, err := k.reply(ctx, example.Contract, wasmvmtypes.Reply{})
+			return err
+		},
+		types.EntryPointQuery: func(ctx sdk.Context) error {
+			_, err := k.QuerySmart(ctx, example.Contract, []byte(`{}`))
+			return err
+		},
+		types.EntryPointIBCChannel: func(ctx sdk.Context) error {
+			_, err := k.OnOpenChannel(ctx, example.Contract, wasmvmtypes.IBCChannelOpenMsg{})
+			if err != nil {
+				return err
+			}
+			if err := k.OnConnectChannel(ctx, example.Contract, wasmvmtypes.IBCChannelConnectMsg{}); err != nil {
+				return err
+			}
+			return k.OnCloseChannel(ctx, example.Contract, wasmvmtypes.IBCChannelCloseMsg{})
+		},
+		types.EntryPointIBCPacket: func(ctx sdk.Context) error {
+			_, err := k.OnRecvPacket(ctx, example.Contract, wasmvmtypes.IBCPacketReceiveMsg{})
+			if err != nil {
+				return err
+			}
+			if err := k.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCPacketAckMsg{}); err != nil {
+				return err
+			}
+			return k.OnTimeoutPacket(ctx, example.Contract, wasmvmtypes.IBCPacketTimeoutMsg{})
+		},
+		types.EntryPointPurge: func(ctx sdk.Context) error {
+			return keepers.ContractKeeper.PurgeContract(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t))
+		},
+	}
+	require.Len(t, specs, len(types.AllContractEntryPoints()))
+	for entryPoint, call := range specs {
+		t.Run(entryPoint.String(), func(t *testing.T) {
+			em := sdk.NewEventManager()
+			ctx, _ := ctx.CacheContext()
+
+			// when
+			gotErr := call(ctx.WithEventManager(em))
+
+			// then
+			require.True(t, types.ErrInactiveContract.Is(gotErr), gotErr)
+			assert.Contains(t, gotErr.Error(), example.Contract.String())
+			assert.Contains(t, gotErr.Error(), entryPoint.String())
+			assert.Empty(t, em.Events())
+		})
+	}
+}
+
+func TestInactiveContractRejectsWasmMsgDispatch(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	otherContract := SeedNewContractInstance(t, ctx, keepers, &mock)
+	require.NoError(t, k.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{}))
+
+	specs := map[string]wasmvmtypes.WasmMsg{
+		"execute":      {Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: example.Contract.String(), Msg: []byte(`{}`), Funds: wasmvmtypes.Coins{}}},
+		"migrate":      {Migrate: &wasmvmtypes.MigrateMsg{ContractAddr: example.Contract.String(), NewCodeID: example.CodeID, Msg: []byte(`{}`)}},
+		"update admin": {UpdateAdmin: &wasmvmtypes.UpdateAdminMsg{ContractAddr: example.Contract.String(), Admin: RandomAccountAddress(t).String()}},
+		"clear admin":  {ClearAdmin: &wasmvmtypes.ClearAdminMsg{ContractAddr: example.Contract.String()}},
+	}
+	for name, msg := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			msg := msg
+			_, _, gotErr := k.messenger.DispatchMsg(ctx, otherContract.Contract, "", wasmvmtypes.CosmosMsg{Wasm: &msg})
+			require.True(t, types.ErrInactiveContract.Is(gotErr), gotErr)
+		})
+	}
+}
+
+func TestInactiveContractAllowedEntryPoints(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil,
+		WithInactiveContractAllowedEntryPoints(types.EntryPointQuery, types.EntryPointIBCChannel))
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	for _, e := range types.AllContractEntryPoints() {
+		require.NoError(t, k.assertContractActive(ctx, example.Contract, e))
+	}
+
+	require.NoError(t, k.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{}))
+	for _, e := range types.AllContractEntryPoints() {
+		gotErr := k.assertContractActive(ctx, example.Contract, e)
+		if e == types.EntryPointQuery || e == types.EntryPointIBCChannel {
+			assert.NoError(t, gotErr, e)
+			continue
+		}
+		assert.True(t, types.ErrInactiveContract.Is(gotErr), e)
+	}
+
+	assert.Panics(t, func() {
+		CreateTestInput(t, false, SupportedFeatures, nil, nil, WithInactiveContractAllowedEntryPoints("unknown"))
+	})
+	assert.Panics(t, func() {
+		CreateTestInput(t, false, SupportedFeatures, nil, nil, WithInactiveContractAllowedEntryPoints(types.EntryPointPurge))
+	})
+}
+
+func TestActivateExpiredContracts(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	expiring := SeedNewContractInstance(t, ctx, keepers, &mock)
+	expiringLater := SeedNewContractInstance(t, ctx, keepers, &mock)
+	permanent := SeedNewContractInstance(t, ctx, keepers, &mock)
+
+	height := ctx.BlockHeight()
+	require.NoError(t, k.deactivateContract(ctx, expiring.Contract, types.InactiveContractInfo{Reason: "a", ExpiryHeight: height + 1}))
+	require.NoError(t, k.deactivateContract(ctx, expiringLater.Contract, types.InactiveContractInfo{Reason: "b", ExpiryHeight: height + 2}))
+	require.NoError(t, k.deactivateContract(ctx, permanent.Contract, types.InactiveContractInfo{Reason: "c"}))
+	other := SeedNewContractInstance(t, ctx, keepers, &mock)
+	require.Error(t, k.deactivateContract(ctx, other.Contract, types.InactiveContractInfo{ExpiryHeight: -1}))
+
+	// not expired yet
+	require.NoError(t, k.ActivateExpiredContracts(ctx))
+	assert.True(t, k.IsInactiveContract(ctx, expiring.Contract))
+
+	// when
+	em := sdk.NewEventManager()
+	ctx = ctx.WithBlockHeight(height + 1)
+	require.NoError(t, k.ActivateExpiredContracts(ctx.WithEventManager(em)))
+
+	// then
+	assert.False(t, k.IsInactiveContract(ctx, expiring.Contract))
+	assert.Nil(t, k.GetInactiveContractInfo(ctx, expiring.Contract))
+	assert.True(t, k.IsInactiveContract(ctx, expiringLater.Contract))
+	assert.True(t, k.IsInactiveContract(ctx, permanent.Contract))
+	exp, err := sdk.TypedEventToEvent(&lbmtypes.EventInactiveContractExpired{Contract: expiring.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Events{exp}, em.Events())
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetInactiveContractExpiryKey(height+1, expiring.Contract)))
+
+	// skipped heights are handled
+	ctx = ctx.WithBlockHeight(height + 10)
+	require.NoError(t, k.ActivateExpiredContracts(ctx))
+	assert.False(t, k.IsInactiveContract(ctx, expiringLater.Contract))
+	assert.Equal(t, "c", k.GetInactiveContractInfo(ctx, permanent.Contract).Reason)
+}
+
 func TestIterateInactiveContracts(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	k := keepers.WasmKeeper
@@ -1915,9 +2833,9 @@ func TestIterateInactiveContracts(t *testing.T) {
 	example2 := SeedNewContractInstance(t, ctx, keepers, &mock)
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 
-	err := k.deactivateContract(ctx, example1.Contract)
+	err := k.deactivateContract(ctx, example1.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
-	err = k.deactivateContract(ctx, example2.Contract)
+	err = k.deactivateContract(ctx, example2.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
 
 	var inactiveContracts []sdk.AccAddress
@@ -1930,6 +2848,163 @@ func TestIterateInactiveContracts(t *testing.T) {
 	assert.ElementsMatch(t, expectList, inactiveContracts)
 }
 
+func TestPurgeContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithContractPurgeChunkSize(2))
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := StoreRandomContract(t, ctx, keepers, &mock)
+	salt := []byte("purge")
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, []byte(`{}`), "my label", nil, salt, false)
+	require.NoError(t, err)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddr))
+	for _, key := range []string{"a", "b", "c"} {
+		prefixStore.Set([]byte(key), []byte("value"))
+	}
+	balance := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	fundAccounts(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, contractAddr, balance)
+	require.NoError(t, k.deactivateContract(ctx, contractAddr, types.InactiveContractInfo{ExpiryHeight: ctx.BlockHeight() + 1}))
+	beneficiary := RandomAccountAddress(t)
+
+	// only the admin can purge
+	err = keepers.ContractKeeper.PurgeContract(ctx, contractAddr, RandomAccountAddress(t), beneficiary)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	// and the admin can not purge the contract while it is deactivated
+	err = keepers.ContractKeeper.PurgeContract(ctx, contractAddr, example.CreatorAddr, beneficiary)
+	require.True(t, types.ErrInactiveContract.Is(err), err)
+	assert.Equal(t, balance, keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+	assert.True(t, k.IsInactiveContract(ctx, contractAddr))
+
+	// when purged by governance
+	em := sdk.NewEventManager()
+	err = NewGovPermissionKeeper(k).PurgeContract(ctx.WithEventManager(em), contractAddr, example.CreatorAddr, beneficiary)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, k.GetContractInfo(ctx, contractAddr))
+	assert.Empty(t, k.GetContractHistory(ctx, contractAddr))
+	assert.Nil(t, k.GetInactiveContractInfo(ctx, contractAddr))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetInactiveContractExpiryKey(ctx.BlockHeight()+1, contractAddr)))
+	k.IterateContractsByCode(ctx, example.CodeID, func(sdk.AccAddress) bool {
+		t.Fatal("unexpected contract in code index")
+		return true
+	})
+	k.IterateContractsByCreator(ctx, example.CreatorAddr, func(sdk.AccAddress) bool {
+		t.Fatal("unexpected contract in creator index")
+		return true
+	})
+	k.IterateContractsByLabel(ctx, "my label", func(string, sdk.AccAddress) bool {
+		t.Fatal("unexpected contract in label index")
+		return true
+	})
+	assert.False(t, k.hasContractWithCreatorAndLabel(ctx, example.CreatorAddr, "my label"))
+	assert.Equal(t, balance, keepers.BankKeeper.GetAllBalances(ctx, beneficiary))
+	assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+	exp, err := sdk.TypedEventToEvent(&lbmtypes.EventPurgeContract{Contract: contractAddr.String(), Beneficiary: beneficiary.String(), Amount: balance})
+	require.NoError(t, err)
+	assert.Contains(t, em.Events(), exp)
+
+	// and the state beyond the first chunk is left for the end blocker
+	var remaining int
+	k.IterateContractState(ctx, contractAddr, func(_, _ []byte) bool {
+		remaining++
+		return false
+	})
+	assert.Equal(t, 1, remaining)
+	_, _, err = keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, []byte(`{}`), "my label", nil, salt, false)
+	require.True(t, types.ErrDuplicate.Is(err), err)
+
+	// when
+	em = sdk.NewEventManager()
+	require.NoError(t, k.DeletePurgedContractStates(ctx.WithEventManager(em)))
+
+	// then
+	k.IterateContractState(ctx, contractAddr, func(_, _ []byte) bool {
+		t.Fatal("unexpected contract state")
+		return true
+	})
+	exp, err = sdk.TypedEventToEvent(&lbmtypes.EventContractStatePurged{Contract: contractAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Events{exp}, em.Events())
+	err = keepers.ContractKeeper.PurgeContract(ctx, contractAddr, example.CreatorAddr, beneficiary)
+	require.True(t, types.ErrNotFound.Is(err), err)
+
+	// and the address can be used again
+	gotAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, []byte(`{}`), "my label", nil, salt, false)
+	require.NoError(t, err)
+	assert.Equal(t, contractAddr, gotAddr)
+}
+
+func TestDeletePurgedContractStates(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithContractPurgeChunkSize(3))
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example1 := SeedNewContractInstance(t, ctx, keepers, &mock)
+	example2 := SeedNewContractInstance(t, ctx, keepers, &mock)
+	for _, contractAddr := range []sdk.AccAddress{example1.Contract, example2.Contract} {
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddr))
+		for i := 0; i < 5; i++ {
+			prefixStore.Set([]byte{byte(i)}, []byte("value"))
+		}
+	}
+	beneficiary := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.PurgeContract(ctx, example1.Contract, example1.CreatorAddr, beneficiary))
+	require.NoError(t, keepers.ContractKeeper.PurgeContract(ctx, example2.Contract, example2.CreatorAddr, beneficiary))
+
+	countState := func(contractAddr sdk.AccAddress) int {
+		var n int
+		k.IterateContractState(ctx, contractAddr, func(_, _ []byte) bool {
+			n++
+			return false
+		})
+		return n
+	}
+	require.Equal(t, 2, countState(example1.Contract))
+	require.Equal(t, 2, countState(example2.Contract))
+
+	// no more than the chunk size is deleted per block
+	require.NoError(t, k.DeletePurgedContractStates(ctx))
+	assert.Equal(t, 1, countState(example1.Contract)+countState(example2.Contract))
+
+	require.NoError(t, k.DeletePurgedContractStates(ctx))
+	assert.Equal(t, 0, countState(example1.Contract)+countState(example2.Contract))
+	assert.False(t, k.isContractPurgePending(ctx, example1.Contract))
+	assert.False(t, k.isContractPurgePending(ctx, example2.Contract))
+}
+
+func TestPurgeContractByWasmMsg(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	// the contract destructs itself
+	require.NoError(t, k.setContractAdmin(ctx, example.Contract, nil, example.Contract, GovAuthorizationPolicy{}))
+	beneficiary := RandomAccountAddress(t)
+	msg := lbmtypes.MsgPurgeContract{
+		Sender:      example.Contract.String(),
+		Contract:    example.Contract.String(),
+		Beneficiary: beneficiary.String(),
+	}
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+
+	// when
+	_, _, err = k.messenger.DispatchMsg(ctx, example.Contract, "", wasmvmtypes.CosmosMsg{
+		Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/lbm.wasm.v1.MsgPurgeContract", Value: bz},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, k.GetContractInfo(ctx, example.Contract))
+}
+
 func TestKeeper_GetByteCode(t *testing.T) {
 
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
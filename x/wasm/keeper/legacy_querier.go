@@ -146,6 +146,7 @@ func queryCodeList(ctx sdk.Context, keeper types.ViewKeeper) ([]types.CodeInfoRe
 			Creator:               res.Creator,
 			DataHash:              res.CodeHash,
 			InstantiatePermission: res.InstantiateConfig,
+			Metadata:              res.Metadata,
 		})
 		return false
 	})
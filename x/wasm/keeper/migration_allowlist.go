@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// setMigrationAllowlist replaces the set of codes a contract can be migrated to. An empty allowlist removes the
+// restriction. An allowlist that was set by governance can only be changed by governance.
+func (k Keeper) setMigrationAllowlist(ctx sdk.Context, contractAddress, caller sdk.AccAddress, allowlist types.MigrationAllowlist, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := allowlist.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.GetMigrationAllowlist(ctx, contractAddress).Governance && !authZ.CanModifyGovernanceAllowlist() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "migration allowlist was set by governance")
+	}
+	allowlist.Governance = authZ.CanModifyGovernanceAllowlist()
+	k.storeMigrationAllowlist(ctx, contractAddress, allowlist)
+	return nil
+}
+
+// GetMigrationAllowlist returns the set of codes a contract can be migrated to. The allowlist is empty when the
+// contract can be migrated to any code.
+func (k Keeper) GetMigrationAllowlist(ctx sdk.Context, contractAddress sdk.AccAddress) types.MigrationAllowlist {
+	var allowlist types.MigrationAllowlist
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMigrationAllowlistKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &allowlist)
+	}
+	return allowlist
+}
+
+func (k Keeper) storeMigrationAllowlist(ctx sdk.Context, contractAddress sdk.AccAddress, allowlist types.MigrationAllowlist) {
+	store := ctx.KVStore(k.storeKey)
+	if allowlist.IsEmpty() {
+		store.Delete(types.GetMigrationAllowlistKey(contractAddress))
+		return
+	}
+	store.Set(types.GetMigrationAllowlistKey(contractAddress), k.cdc.MustMarshal(&allowlist))
+}
+
+// assertMigrationAllowed fails when the contract has a migration allowlist that contains neither the code ID nor the
+// checksum of the migration target.
+func (k Keeper) assertMigrationAllowed(ctx sdk.Context, contractAddress sdk.AccAddress, newCodeID uint64, newCodeInfo types.CodeInfo) error {
+	allowlist := k.GetMigrationAllowlist(ctx, contractAddress)
+	if allowlist.IsEmpty() || allowlist.Allows(newCodeID, newCodeInfo.CodeHash) {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrMigrationNotAllowed, "code id %d with checksum %X is not in the migration allowlist", newCodeID, newCodeInfo.CodeHash)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestMigrationAllowlist(t *testing.T) {
+	specs := map[string]struct {
+		allowlist func(allowedCode, otherCode ExampleContract) types.MigrationAllowlist
+		expErr    *sdkerrors.Error
+	}{
+		"no allowlist": {
+			allowlist: func(_, _ ExampleContract) types.MigrationAllowlist { return types.MigrationAllowlist{} },
+		},
+		"code id listed": {
+			allowlist: func(allowedCode, _ ExampleContract) types.MigrationAllowlist {
+				return types.MigrationAllowlist{CodeIDs: []uint64{allowedCode.CodeID}}
+			},
+		},
+		"checksum listed": {
+			allowlist: func(allowedCode, _ ExampleContract) types.MigrationAllowlist {
+				return types.MigrationAllowlist{Checksums: [][]byte{allowedCode.Checksum}}
+			},
+		},
+		"neither code id nor checksum listed": {
+			allowlist: func(_, otherCode ExampleContract) types.MigrationAllowlist {
+				return types.MigrationAllowlist{CodeIDs: []uint64{otherCode.CodeID}}
+			},
+			expErr: types.ErrMigrationNotAllowed,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			k := keepers.WasmKeeper
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+			otherCodeExample := StoreHackatomExampleContract(t, ctx, keepers)
+			allowlist := spec.allowlist(newCodeExample, otherCodeExample)
+			require.NoError(t, keepers.ContractKeeper.UpdateMigrationAllowlist(ctx, example.Contract, example.CreatorAddr, allowlist))
+
+			// when
+			migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+			_, gotErr := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+		})
+	}
+}
+
+func TestUpdateMigrationAllowlist(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	allowlist := types.MigrationAllowlist{CodeIDs: []uint64{example.CodeID}, Checksums: [][]byte{example.Checksum}}
+
+	// when updated by another account
+	err := keepers.ContractKeeper.UpdateMigrationAllowlist(ctx, example.Contract, RandomAccountAddress(t), allowlist)
+	// then
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+	assert.True(t, k.GetMigrationAllowlist(ctx, example.Contract).IsEmpty())
+
+	// when updated by the admin
+	require.NoError(t, keepers.ContractKeeper.UpdateMigrationAllowlist(ctx, example.Contract, example.CreatorAddr, allowlist))
+	// then
+	res, err := Querier(k).MigrationAllowlist(sdk.WrapSDKContext(ctx), &lbmtypes.QueryMigrationAllowlistRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, allowlist, res.Allowlist)
+
+	// when cleared by governance
+	require.NoError(t, NewGovPermissionKeeper(k).UpdateMigrationAllowlist(ctx, example.Contract, nil, types.MigrationAllowlist{}))
+	// then
+	assert.True(t, k.GetMigrationAllowlist(ctx, example.Contract).IsEmpty())
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetMigrationAllowlistKey(example.Contract)))
+}
+
+func TestGovernanceMigrationAllowlist(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	allowlist := types.MigrationAllowlist{CodeIDs: []uint64{example.CodeID}}
+
+	// when set by governance
+	require.NoError(t, NewGovPermissionKeeper(k).UpdateMigrationAllowlist(ctx, example.Contract, nil, allowlist))
+	// then it is recorded
+	exp := types.MigrationAllowlist{CodeIDs: []uint64{example.CodeID}, Governance: true}
+	assert.Equal(t, exp, k.GetMigrationAllowlist(ctx, example.Contract))
+
+	// when replaced or cleared by the admin
+	err := keepers.ContractKeeper.UpdateMigrationAllowlist(ctx, example.Contract, example.CreatorAddr, types.MigrationAllowlist{Checksums: [][]byte{example.Checksum}})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+	err = keepers.ContractKeeper.UpdateMigrationAllowlist(ctx, example.Contract, example.CreatorAddr, types.MigrationAllowlist{})
+	assert.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+	// then the governance allowlist is kept
+	assert.Equal(t, exp, k.GetMigrationAllowlist(ctx, example.Contract))
+
+	// when cleared by governance
+	require.NoError(t, NewGovPermissionKeeper(k).UpdateMigrationAllowlist(ctx, example.Contract, nil, types.MigrationAllowlist{}))
+	// then the admin can set an allowlist again
+	require.NoError(t, keepers.ContractKeeper.UpdateMigrationAllowlist(ctx, example.Contract, example.CreatorAddr, allowlist))
+	assert.Equal(t, allowlist, k.GetMigrationAllowlist(ctx, example.Contract))
+}
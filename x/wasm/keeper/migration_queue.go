@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// enqueueMigration stores a migration that is executed by the end blocker once the migration delay has passed. A
+// contract can have a single queued migration only, the admin must cancel it to queue another one.
+func (k Keeper) enqueueMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, delay uint64) error {
+	if k.GetPendingMigration(ctx, contractAddress) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "pending migration")
+	}
+	pending := types.PendingMigration{
+		Contract:      contractAddress.String(),
+		Sender:        caller.String(),
+		CodeID:        newCodeID,
+		Msg:           msg,
+		ExecuteHeight: ctx.BlockHeight() + int64(delay),
+	}
+	k.storePendingMigration(ctx, contractAddress, pending)
+
+	return ctx.EventManager().EmitTypedEvent(&lbmtypes.EventMigrationQueued{
+		Contract:      pending.Contract,
+		Sender:        pending.Sender,
+		CodeId:        pending.CodeID,
+		ExecuteHeight: pending.ExecuteHeight,
+	})
+}
+
+// setMigrationDelay sets the number of blocks a migration of the contract is queued. The delay can only be decreased
+// by an authority that migrates immediately anyway so that the admin can not bypass the notice period.
+func (k Keeper) setMigrationDelay(ctx sdk.Context, contractAddress, caller sdk.AccAddress, delay uint64, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if delay > types.MaxMigrationDelay {
+		return sdkerrors.Wrapf(types.ErrLimit, "migration delay must not exceed %d", types.MaxMigrationDelay)
+	}
+	if delay < contractInfo.MigrationDelay && !authZ.CanMigrateImmediately() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "migration delay can not be decreased from %d", contractInfo.MigrationDelay)
+	}
+	contractInfo.MigrationDelay = delay
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	return nil
+}
+
+// cancelMigration drops the queued migration of a contract.
+func (k Keeper) cancelMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	pending := k.GetPendingMigration(ctx, contractAddress)
+	if pending == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "pending migration")
+	}
+	k.deletePendingMigration(ctx, contractAddress, *pending)
+
+	return ctx.EventManager().EmitTypedEvent(&lbmtypes.EventQueuedMigrationCanceled{
+		Contract: pending.Contract,
+		CodeId:   pending.CodeID,
+	})
+}
+
+// GetPendingMigration returns the queued migration of a contract or nil when none exists.
+func (k Keeper) GetPendingMigration(ctx sdk.Context, contractAddress sdk.AccAddress) *types.PendingMigration {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingMigrationKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var pending types.PendingMigration
+	k.cdc.MustUnmarshal(bz, &pending)
+	return &pending
+}
+
+func (k Keeper) storePendingMigration(ctx sdk.Context, contractAddress sdk.AccAddress, pending types.PendingMigration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingMigrationKey(contractAddress), k.cdc.MustMarshal(&pending))
+	store.Set(types.GetPendingMigrationQueueKey(pending.ExecuteHeight, contractAddress), []byte{})
+}
+
+func (k Keeper) deletePendingMigration(ctx sdk.Context, contractAddress sdk.AccAddress, pending types.PendingMigration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingMigrationQueueKey(pending.ExecuteHeight, contractAddress))
+	store.Delete(types.GetPendingMigrationKey(contractAddress))
+}
+
+// ExecuteQueuedMigrations executes all queued migrations with an execute height lower than or equal to the current
+// block height. A failed migration is dropped without state changes and the error is reported in the event.
+func (k Keeper) ExecuteQueuedMigrations(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(types.PendingMigrationQueuePrefix)
+	end := types.GetPendingMigrationQueueKey(ctx.BlockHeight()+1, nil)
+	iter := store.Iterator(types.PendingMigrationQueuePrefix, end)
+	var due []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		due = append(due, iter.Key()[prefixLen+8:])
+	}
+	iter.Close()
+
+	for _, contractAddress := range due {
+		pending := k.GetPendingMigration(ctx, contractAddress)
+		if pending == nil {
+			continue
+		}
+		k.deletePendingMigration(ctx, contractAddress, *pending)
+
+		event := lbmtypes.EventQueuedMigrationExecuted{
+			Contract: pending.Contract,
+			CodeId:   pending.CodeID,
+		}
+		if err := k.executeQueuedMigration(ctx, contractAddress, *pending); err != nil {
+			event.Error = err.Error()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeQueuedMigration runs the migration with the queued migration gas limit in a cached context that is only
+// committed on success. The sender must still be the admin of the contract. Any panic of the migration, out of gas
+// included, is turned into an error so that the migration is dropped without halting the chain.
+func (k Keeper) executeQueuedMigration(ctx sdk.Context, contractAddress sdk.AccAddress, pending types.PendingMigration) (err error) {
+	sender, err := sdk.AccAddressFromBech32(pending.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(k.queuedMigrationGasLimit))
+
+	// catch all panics and drop the migration
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "queued migration hit gas limit")
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "queued migration panicked: %v", r)
+			}
+		}
+	}()
+	if _, err := k.migrate(cacheCtx, contractAddress, sender, pending.CodeID, pending.Msg, queuedMigrationAuthorizationPolicy{}); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestQueuedMigration(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 5))
+
+	// when
+	em := sdk.NewEventManager()
+	_, err := keepers.ContractKeeper.Migrate(ctx.WithEventManager(em), example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz)
+
+	// then the migration is queued
+	require.NoError(t, err)
+	assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	expPending := types.PendingMigration{
+		Contract:      example.Contract.String(),
+		Sender:        example.CreatorAddr.String(),
+		CodeID:        newCodeExample.CodeID,
+		Msg:           migMsgBz,
+		ExecuteHeight: ctx.BlockHeight() + 5,
+	}
+	assert.Equal(t, &expPending, k.GetPendingMigration(ctx, example.Contract))
+	expEvent, err := sdk.TypedEventToEvent(&lbmtypes.EventMigrationQueued{
+		Contract:      example.Contract.String(),
+		Sender:        example.CreatorAddr.String(),
+		CodeId:        newCodeExample.CodeID,
+		ExecuteHeight: ctx.BlockHeight() + 5,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, em.Events(), expEvent)
+	// and visible in the query
+	res, err := Querier(k).PendingMigrations(sdk.WrapSDKContext(ctx), &lbmtypes.QueryPendingMigrationsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.PendingMigration{expPending}, res.PendingMigrations)
+
+	// and not executed before the execute height
+	require.NoError(t, k.ExecuteQueuedMigrations(ctx.WithBlockHeight(expPending.ExecuteHeight-1)))
+	assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+
+	// when the execute height is reached
+	em = sdk.NewEventManager()
+	ctx = ctx.WithBlockHeight(expPending.ExecuteHeight)
+	require.NoError(t, k.ExecuteQueuedMigrations(ctx.WithEventManager(em)))
+
+	// then
+	assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetPendingMigrationQueueKey(expPending.ExecuteHeight, example.Contract)))
+	expEvent, err = sdk.TypedEventToEvent(&lbmtypes.EventQueuedMigrationExecuted{
+		Contract: example.Contract.String(),
+		CodeId:   newCodeExample.CodeID,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, em.Events(), expEvent)
+}
+
+func TestQueuedMigrationDroppedOnFailure(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 1))
+	_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, migMsgBz)
+	require.NoError(t, err)
+	// the sender is not the admin anymore when the migration is executed
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, example.Contract, example.CreatorAddr))
+
+	// when
+	em := sdk.NewEventManager()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.ExecuteQueuedMigrations(ctx.WithEventManager(em)))
+
+	// then
+	assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+	expEvent, err := sdk.TypedEventToEvent(&lbmtypes.EventQueuedMigrationExecuted{
+		Contract: example.Contract.String(),
+		CodeId:   newCodeExample.CodeID,
+		Error:    sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate").Error(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Events{expEvent}, em.Events())
+}
+
+func TestQueuedMigrationDroppedOnPanic(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	mock := &wasmtesting.MockWasmer{MigrateFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		panic("testing")
+	}}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	newCodeExample := StoreRandomContract(t, ctx, keepers, mock)
+	require.NoError(t, keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 1))
+	_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeExample.CodeID, []byte(`{}`))
+	require.NoError(t, err)
+
+	// when
+	em := sdk.NewEventManager()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NotPanics(t, func() {
+		require.NoError(t, k.ExecuteQueuedMigrations(ctx.WithEventManager(em)))
+	})
+
+	// then
+	assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+	expEvent, err := sdk.TypedEventToEvent(&lbmtypes.EventQueuedMigrationExecuted{
+		Contract: example.Contract.String(),
+		CodeId:   newCodeExample.CodeID,
+		Error:    sdkerrors.Wrapf(sdkerrors.ErrPanic, "queued migration panicked: %v", "testing").Error(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.Events{expEvent}, em.Events())
+}
+
+func TestMigrationDelay(t *testing.T) {
+	specs := map[string]struct {
+		do     func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, newCodeID uint64) error
+		expErr *sdkerrors.Error
+	}{
+		"increase by admin": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				return keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 11)
+			},
+		},
+		"decrease by admin": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				return keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 9)
+			},
+			expErr: types.ErrInvalid,
+		},
+		"decrease by gov": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				return NewGovPermissionKeeper(keepers.WasmKeeper).UpdateMigrationDelay(ctx, example.Contract, nil, 0)
+			},
+		},
+		"increase beyond max": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				return keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, types.MaxMigrationDelay+1)
+			},
+			expErr: types.ErrLimit,
+		},
+		"update by non admin": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				return keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.VerifierAddr, 11)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"migration already queued": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, newCodeID uint64) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+				require.NoError(t, err)
+				_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+				return err
+			},
+			expErr: types.ErrDuplicate,
+		},
+		"migration of unknown code": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, 100, []byte(`{}`))
+				return err
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		"migration by non admin": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, newCodeID uint64) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.VerifierAddr, newCodeID, []byte(`{}`))
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"cancel by admin": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, newCodeID uint64) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+				require.NoError(t, err)
+				require.NoError(t, keepers.ContractKeeper.CancelMigration(ctx, example.Contract, example.CreatorAddr))
+				assert.Nil(t, keepers.WasmKeeper.GetPendingMigration(ctx, example.Contract))
+				return nil
+			},
+		},
+		"cancel by non admin": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, newCodeID uint64) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+				require.NoError(t, err)
+				return keepers.ContractKeeper.CancelMigration(ctx, example.Contract, example.VerifierAddr)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"cancel without queued migration": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, _ uint64) error {
+				return keepers.ContractKeeper.CancelMigration(ctx, example.Contract, example.CreatorAddr)
+			},
+			expErr: types.ErrNotFound,
+		},
+		"purge deletes queued migration": {
+			do: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance, newCodeID uint64) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+				require.NoError(t, err)
+				require.NoError(t, keepers.ContractKeeper.PurgeContract(ctx, example.Contract, example.CreatorAddr, example.CreatorAddr))
+				assert.Nil(t, keepers.WasmKeeper.GetPendingMigration(ctx, example.Contract))
+				assert.False(t, ctx.KVStore(keepers.WasmKeeper.storeKey).Has(types.GetPendingMigrationQueueKey(ctx.BlockHeight()+10, example.Contract)))
+				return nil
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+			require.NoError(t, keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 10))
+
+			// when
+			gotErr := spec.do(t, ctx, keepers, example, newCodeExample.CodeID)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), gotErr)
+		})
+	}
+}
+
+func TestGovMigrationIgnoresMigrationDelay(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeExample := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsgBz := BurnerExampleInitMsg{Payout: example.CreatorAddr}.GetBytes(t)
+	require.NoError(t, keepers.ContractKeeper.UpdateMigrationDelay(ctx, example.Contract, example.CreatorAddr, 10))
+
+	// when
+	_, err := NewGovPermissionKeeper(k).Migrate(ctx, example.Contract, nil, newCodeExample.CodeID, migMsgBz)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, newCodeExample.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+	assert.Nil(t, k.GetPendingMigration(ctx, example.Contract))
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"github.com/line/lbm-sdk/store/prefix"
 	sdk "github.com/line/lbm-sdk/types"
+
+	"github.com/line/wasmd/x/wasm/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +18,86 @@ func NewMigrator(keeper Keeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// It builds the secondary indexes and sets the default of the params that were introduced with version 2 and
+// converts the inactive contract entries into InactiveContractInfo records.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		m.keeper.addToCodeByChecksumIndex(ctx, info.CodeHash, codeID)
+		return false
+	})
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, info types.ContractInfo) bool {
+		var creator sdk.AccAddress
+		creator, err = sdk.AccAddressFromBech32(info.Creator)
+		if err != nil {
+			return true
+		}
+		m.keeper.addToContractCreatorSecondaryIndex(ctx, creator, info.Created, contractAddr)
+		m.keeper.addToContractLabelSecondaryIndex(ctx, info.Label, contractAddr)
+		m.keeper.addToContractCreatorAndLabelIndex(ctx, creator, info.Label)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyUniqueLabelPerCreator, types.DefaultParams().UniqueLabelPerCreator)
+	m.migrateInactiveContracts(ctx)
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// It moves the params from the legacy params subspace into the wasm store. The size limits, the stargate query
+// gas and the upload session deposit that were not kept in the subspace are set to their defaults.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var params types.Params
+	m.keeper.paramSpace.GetParamSet(ctx, &params)
+	params.MaxWasmSize = types.DefaultMaxWasmSize
+	params.MaxLabelSize = types.DefaultMaxLabelSize
+	params.MaxDecompressedWasmSize = types.DefaultMaxDecompressedWasmSize
+	params.StargateQueryGasPerByte = types.DefaultStargateQueryGasPerByte
+	params.UploadSessionDeposit = types.DefaultUploadSessionDeposit
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It counts the bytes and keys of the state of every contract so that the storage quota and the storage deposit apply
+// to the state that was written before the storage usage was tracked. Locked deposits are kept.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var contracts []sdk.AccAddress
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		contracts = append(contracts, contractAddr)
+		return false
+	})
+	for _, contractAddr := range contracts {
+		storage := m.keeper.GetContractStorage(ctx, contractAddr)
+		storage.Bytes, storage.Keys = 0, 0
+		prefixStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.GetContractStorePrefix(contractAddr))
+		iter := prefixStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			storage.Bytes += uint64(len(iter.Key()) + len(iter.Value()))
+			storage.Keys++
+		}
+		if err := iter.Close(); err != nil {
+			return err
+		}
+		m.keeper.storeContractStorage(ctx, contractAddr, storage)
+	}
+	return nil
+}
+
+// migrateInactiveContracts replaces the bare contract address values of the inactive contracts with
+// InactiveContractInfo records. The details of the deactivation are not known for the existing entries.
+func (m Migrator) migrateInactiveContracts(ctx sdk.Context) {
+	var inactiveContracts []sdk.AccAddress
+	m.keeper.IterateInactiveContracts(ctx, func(contractAddress sdk.AccAddress) bool {
+		inactiveContracts = append(inactiveContracts, contractAddress)
+		return false
+	})
+	for _, contractAddress := range inactiveContracts {
+		m.keeper.addInactiveContract(ctx, contractAddress, types.InactiveContractInfo{})
+	}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+
+	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestMigrate1To2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	wasmKeeper := keepers.WasmKeeper
+
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	// drop the index entries to simulate a state of version 1
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetCodeByChecksumIndexKey(example.Checksum, example.CodeID))
+	_, found := wasmKeeper.GetCodeIDByChecksum(ctx, example.Checksum)
+	require.False(t, found)
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate1to2(ctx)
+
+	// then
+	require.NoError(t, err)
+	gotCodeID, found := wasmKeeper.GetCodeIDByChecksum(ctx, example.Checksum)
+	require.True(t, found)
+	assert.Equal(t, example.CodeID, gotCodeID)
+}
+
+func TestMigrate1To2ContractsByCreator(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	wasmKeeper := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	info := wasmKeeper.GetContractInfo(ctx, example.Contract)
+	// drop the index entries to simulate a state of version 1
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetContractByCreatorSecondaryIndexKey(example.CreatorAddr, *info.Created, example.Contract))
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate1to2(ctx)
+
+	// then
+	require.NoError(t, err)
+	var got []sdk.AccAddress
+	wasmKeeper.IterateContractsByCreator(ctx, example.CreatorAddr, func(addr sdk.AccAddress) bool {
+		got = append(got, addr)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, got)
+}
+
+func TestMigrate1To2ContractsByLabel(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	wasmKeeper := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	info := wasmKeeper.GetContractInfo(ctx, example.Contract)
+	// drop the index entries to simulate a state of version 1
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetContractByLabelSecondaryIndexKey(info.Label, example.Contract))
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetContractByCreatorAndLabelKey(example.CreatorAddr, info.Label))
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate1to2(ctx)
+
+	// then
+	require.NoError(t, err)
+	var got []sdk.AccAddress
+	wasmKeeper.IterateContractsByLabel(ctx, info.Label, func(_ string, addr sdk.AccAddress) bool {
+		got = append(got, addr)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, got)
+	assert.True(t, wasmKeeper.hasContractWithCreatorAndLabel(ctx, example.CreatorAddr, info.Label))
+	assert.Equal(t, types.DefaultParams(), wasmKeeper.GetParams(ctx))
+}
+
+func TestMigrate2To3(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	wasmKeeper := keepers.WasmKeeper
+
+	myAddr := RandomAccountAddress(t)
+	legacyParams := types.Params{
+		CodeUploadAccess:             types.AccessTypeOnlyAddress.With(myAddr),
+		InstantiateDefaultPermission: types.AccessTypeNobody,
+		GasMultiplier:                1,
+		InstanceCost:                 2,
+		CompileCost:                  3,
+		UniqueLabelPerCreator:        true,
+	}
+	// store the params in the legacy subspace only to simulate a state of version 2
+	wasmKeeper.paramSpace.SetParamSet(ctx, &legacyParams)
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.ParamsKey)
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate2to3(ctx)
+
+	// then
+	require.NoError(t, err)
+	expParams := legacyParams
+	expParams.MaxWasmSize = types.DefaultMaxWasmSize
+	expParams.MaxLabelSize = types.DefaultMaxLabelSize
+	expParams.MaxDecompressedWasmSize = types.DefaultMaxDecompressedWasmSize
+	expParams.StargateQueryGasPerByte = types.DefaultStargateQueryGasPerByte
+	expParams.UploadSessionDeposit = types.DefaultUploadSessionDeposit
+	assert.Equal(t, expParams, wasmKeeper.GetParams(ctx))
+}
+
+func TestMigrate3To4(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	wasmKeeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expStorage := wasmKeeper.GetContractStorage(ctx, example.Contract)
+	require.NotZero(t, expStorage.Bytes)
+	require.NotZero(t, expStorage.Keys)
+	// drop the storage usage to simulate a state of version 3 where only the deposit is stored
+	myDeposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	wasmKeeper.storeContractStorage(ctx, example.Contract, types.ContractStorage{Deposit: myDeposit})
+
+	// when
+	err := NewMigrator(*wasmKeeper).Migrate3to4(ctx)
+
+	// then
+	require.NoError(t, err)
+	expStorage.Deposit = myDeposit
+	assert.Equal(t, expStorage, wasmKeeper.GetContractStorage(ctx, example.Contract))
+}
@@ -26,6 +26,8 @@ type replyer interface {
 type MessageDispatcher struct {
 	messenger Messenger
 	keeper    replyer
+	// subMsgDataAsTxMsgData passes the data of all sdk messages of a submessage to the reply as protobuf TxMsgData
+	subMsgDataAsTxMsgData bool
 }
 
 // NewMessageDispatcher constructor
@@ -120,10 +122,17 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		// otherwise, we create a SubMsgResult and pass it into the calling contract
 		var result wasmvmtypes.SubMsgResult
 		if err == nil {
-			// just take the first one for now if there are multiple sub-sdk messages
-			// and safely return nothing if no data
+			// the SubMsgResponse of the linked wasmvm has no msg_responses field to pass the data of multiple sdk
+			// messages. The complete list is encoded as TxMsgData when enabled, otherwise only the first one is passed.
+			// The data of a single sdk message is always passed as is so that the reply format of the common case
+			// does not change. Safely return nothing if no data.
 			var responseData []byte
-			if len(data) > 0 {
+			switch {
+			case d.subMsgDataAsTxMsgData && len(data) > 1:
+				if responseData, err = encodeSubMsgData(data); err != nil {
+					return nil, err
+				}
+			case len(data) > 0:
 				responseData = data[0]
 			}
 			result = wasmvmtypes.SubMsgResult{
@@ -159,6 +168,19 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 	return rsp, nil
 }
 
+// encodeSubMsgData encodes the data of all sdk messages of a submessage as protobuf TxMsgData
+func encodeSubMsgData(data [][]byte) ([]byte, error) {
+	txMsgData := sdk.TxMsgData{Data: make([]*sdk.MsgData, len(data))}
+	for i, d := range data {
+		txMsgData.Data[i] = &sdk.MsgData{Data: d}
+	}
+	bz, err := txMsgData.Marshal()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "encode submessage data")
+	}
+	return bz, nil
+}
+
 // Issue #759 - we don't return error string for worries of non-determinism
 func redactError(err error) error {
 	// Do not redact system errors
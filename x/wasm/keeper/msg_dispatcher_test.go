@@ -19,14 +19,17 @@ import (
 func TestDispatchSubmessages(t *testing.T) {
 	noReplyCalled := &mockReplyer{}
 	var anyGasLimit uint64 = 1
+	allSubMsgData, err := (&sdk.TxMsgData{Data: []*sdk.MsgData{{Data: []byte("firstData")}, {Data: []byte("secondData")}}}).Marshal()
+	require.NoError(t, err)
 	specs := map[string]struct {
-		msgs       []wasmvmtypes.SubMsg
-		replyer    *mockReplyer
-		msgHandler *wasmtesting.MockMessageHandler
-		expErr     bool
-		expData    []byte
-		expCommits []bool
-		expEvents  sdk.Events
+		msgs                  []wasmvmtypes.SubMsg
+		replyer               *mockReplyer
+		msgHandler            *wasmtesting.MockMessageHandler
+		subMsgDataAsTxMsgData bool
+		expErr                bool
+		expData               []byte
+		expCommits            []bool
+		expEvents             sdk.Events
 	}{
 		"no reply on error without error": {
 			msgs:    []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyError}},
@@ -222,6 +225,68 @@ func TestDispatchSubmessages(t *testing.T) {
 			expCommits: []bool{false, false},
 			expErr:     true,
 		},
+		"multiple sdk msgs - data of the first msg passed to reply": {
+			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return reply.Result.Ok.Data, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, [][]byte{[]byte("firstData"), []byte("secondData")}, nil
+				},
+			},
+			expData:    []byte("firstData"),
+			expCommits: []bool{true},
+		},
+		"multiple sdk msgs - data of all msgs passed to reply as tx msg data": {
+			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return reply.Result.Ok.Data, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, [][]byte{[]byte("firstData"), []byte("secondData")}, nil
+				},
+			},
+			subMsgDataAsTxMsgData: true,
+			expData:               allSubMsgData,
+			expCommits:            []bool{true},
+		},
+		"single sdk msg - data passed to reply unchanged with tx msg data enabled": {
+			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return reply.Result.Ok.Data, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, [][]byte{[]byte("firstData")}, nil
+				},
+			},
+			subMsgDataAsTxMsgData: true,
+			expData:               []byte("firstData"),
+			expCommits:            []bool{true},
+		},
+		"no sdk msg data - nothing passed to reply as tx msg data": {
+			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplySuccess}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return reply.Result.Ok.Data, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, nil, nil
+				},
+			},
+			subMsgDataAsTxMsgData: true,
+			expCommits:            []bool{true},
+		},
 		"multiple msg - last reply returned": {
 			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyError}, {ID: 2, ReplyOn: wasmvmtypes.ReplyError}},
 			replyer: &mockReplyer{
@@ -351,6 +416,7 @@ func TestDispatchSubmessages(t *testing.T) {
 				WithGasMeter(sdk.NewGasMeter(100)).
 				WithEventManager(em).WithLogger(log.TestingLogger())
 			d := NewMessageDispatcher(spec.msgHandler, spec.replyer)
+			d.subMsgDataAsTxMsgData = spec.subMsgDataAsTxMsgData
 			gotData, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", spec.msgs)
 			if spec.expErr {
 				require.Error(t, gotErr)
@@ -20,6 +20,13 @@ func NewMsgServerImpl(k types.ContractOpsKeeper) types.MsgServer {
 	return &msgServer{keeper: k}
 }
 
+var _ lbmtypes.MsgServer = msgServer{}
+
+// NewLbmMsgServerImpl returns an implementation of the lbm wasm MsgServer interface
+func NewLbmMsgServerImpl(k types.ContractOpsKeeper) lbmtypes.MsgServer {
+	return &msgServer{keeper: k}
+}
+
 func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -33,7 +40,7 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 	))
 
-	codeID, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
+	codeID, err := m.keeper.CreateWithMetadata(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission, msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +81,37 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	contractAddr, data, err := m.keeper.Instantiate2(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInstantiateContract2Response{
+		Address: contractAddr.String(),
+		Data:    data,
+	}, nil
+}
+
 func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 	msg *lbmtypes.MsgStoreCodeAndInstantiateContract) (*lbmtypes.MsgStoreCodeAndInstantiateContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -218,3 +256,415 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 
 	return &types.MsgClearAdminResponse{}, nil
 }
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authorityAddr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "authority")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+	))
+
+	if err := m.keeper.UpdateParams(ctx, authorityAddr, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (m msgServer) PurgeContract(goCtx context.Context, msg *lbmtypes.MsgPurgeContract) (*lbmtypes.MsgPurgeContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	beneficiaryAddr, err := sdk.AccAddressFromBech32(msg.Beneficiary)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "beneficiary")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.PurgeContract(ctx, contractAddr, senderAddr, beneficiaryAddr); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgPurgeContractResponse{}, nil
+}
+
+func (m msgServer) StoreCodeBegin(goCtx context.Context, msg *lbmtypes.MsgStoreCodeBegin) (*lbmtypes.MsgStoreCodeBeginResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	sessionID, expiryHeight, err := m.keeper.BeginStoreCode(ctx, senderAddr, msg.Checksum, msg.TotalSize, msg.InstantiatePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgStoreCodeBeginResponse{
+		SessionID:    sessionID,
+		ExpiryHeight: expiryHeight,
+	}, nil
+}
+
+func (m msgServer) StoreCodeChunk(goCtx context.Context, msg *lbmtypes.MsgStoreCodeChunk) (*lbmtypes.MsgStoreCodeChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	receivedSize, err := m.keeper.StoreCodeChunk(ctx, senderAddr, msg.SessionID, msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgStoreCodeChunkResponse{
+		ReceivedSize: receivedSize,
+	}, nil
+}
+
+func (m msgServer) StoreCodeCommit(goCtx context.Context, msg *lbmtypes.MsgStoreCodeCommit) (*lbmtypes.MsgStoreCodeCommitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	codeID, err := m.keeper.CommitStoreCode(ctx, senderAddr, msg.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgStoreCodeCommitResponse{
+		CodeID: codeID,
+	}, nil
+}
+
+func (m msgServer) ProposeAdmin(goCtx context.Context, msg *lbmtypes.MsgProposeAdmin) (*lbmtypes.MsgProposeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "new admin")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.ProposeContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgProposeAdminResponse{}, nil
+}
+
+func (m msgServer) AcceptAdmin(goCtx context.Context, msg *lbmtypes.MsgAcceptAdmin) (*lbmtypes.MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.AcceptContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgAcceptAdminResponse{}, nil
+}
+
+func (m msgServer) CancelPendingAdmin(goCtx context.Context, msg *lbmtypes.MsgCancelPendingAdmin) (*lbmtypes.MsgCancelPendingAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.CancelPendingContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgCancelPendingAdminResponse{}, nil
+}
+
+func (m msgServer) UpdateMigrationDelay(goCtx context.Context, msg *lbmtypes.MsgUpdateMigrationDelay) (*lbmtypes.MsgUpdateMigrationDelayResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateMigrationDelay(ctx, contractAddr, senderAddr, msg.MigrationDelay); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgUpdateMigrationDelayResponse{}, nil
+}
+
+func (m msgServer) CancelMigration(goCtx context.Context, msg *lbmtypes.MsgCancelMigration) (*lbmtypes.MsgCancelMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.CancelMigration(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgCancelMigrationResponse{}, nil
+}
+
+func (m msgServer) UpdateMigrationAllowlist(goCtx context.Context, msg *lbmtypes.MsgUpdateMigrationAllowlist) (*lbmtypes.MsgUpdateMigrationAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateMigrationAllowlist(ctx, contractAddr, senderAddr, msg.Allowlist()); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgUpdateMigrationAllowlistResponse{}, nil
+}
+
+func (m msgServer) SetCodeMetadata(goCtx context.Context, msg *lbmtypes.MsgSetCodeMetadata) (*lbmtypes.MsgSetCodeMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.SetCodeMetadata(ctx, msg.CodeID, senderAddr, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgSetCodeMetadataResponse{}, nil
+}
+
+func (m msgServer) UpdateContractMetadata(goCtx context.Context, msg *lbmtypes.MsgUpdateContractMetadata) (*lbmtypes.MsgUpdateContractMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateContractMetadata(ctx, contractAddr, senderAddr, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgUpdateContractMetadataResponse{}, nil
+}
+
+func (m msgServer) UpdateFeeAllowance(goCtx context.Context, msg *lbmtypes.MsgUpdateFeeAllowance) (*lbmtypes.MsgUpdateFeeAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateFeeAllowance(ctx, contractAddr, senderAddr, msg.Allowance()); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgUpdateFeeAllowanceResponse{}, nil
+}
+
+func (m msgServer) ScheduleCallback(goCtx context.Context, msg *lbmtypes.MsgScheduleCallback) (*lbmtypes.MsgScheduleCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	scheduleID, err := m.keeper.ScheduleCallback(ctx, senderAddr, msg.ExecuteHeight, msg.Interval, msg.Msg, msg.GasLimit, msg.GasDeposit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgScheduleCallbackResponse{
+		ScheduleID: scheduleID,
+	}, nil
+}
+
+func (m msgServer) CancelSchedule(goCtx context.Context, msg *lbmtypes.MsgCancelSchedule) (*lbmtypes.MsgCancelScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.CancelSchedule(ctx, contractAddr, senderAddr, msg.ScheduleID); err != nil {
+		return nil, err
+	}
+
+	return &lbmtypes.MsgCancelScheduleResponse{}, nil
+}
+
+func (m msgServer) ExecuteContracts(goCtx context.Context, msg *lbmtypes.MsgExecuteContracts) (*lbmtypes.MsgExecuteContractsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	// the calls share the context of the message, a failed call fails the message and reverts all calls
+	data := make([][]byte, len(msg.Calls))
+	for i, call := range msg.Calls {
+		contractAddr, err := sdk.AccAddressFromBech32(call.Contract)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "contract of call %d", i)
+		}
+		data[i], err = m.keeper.Execute(ctx, contractAddr, senderAddr, call.Msg, call.Funds)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "call %d", i)
+		}
+	}
+
+	return &lbmtypes.MsgExecuteContractsResponse{
+		Data: data,
+	}, nil
+}
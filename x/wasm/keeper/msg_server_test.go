@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestExecuteContracts(t *testing.T) {
+	specs := map[string]struct {
+		failSecond bool
+		expErr     bool
+	}{
+		"all calls succeed": {},
+		"second call fails": {
+			failSecond: true,
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			mock := &wasmtesting.MockWasmer{}
+			wasmtesting.MakeInstantiable(mock)
+			first := SeedNewContractInstance(t, ctx, keepers, mock).Contract
+			second := SeedNewContractInstance(t, ctx, keepers, mock).Contract
+			funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+			sender := keepers.Faucet.NewFundedAccount(ctx, funds...)
+
+			var called []string
+			mock.ExecuteFn = func(_ wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				assert.Equal(t, sender.String(), info.Sender)
+				called = append(called, env.Contract.Address)
+				if spec.failSecond && env.Contract.Address == second.String() {
+					return nil, 0, errors.New("failed")
+				}
+				return &wasmvmtypes.Response{Data: executeMsg}, 0, nil
+			}
+			msg := &lbmtypes.MsgExecuteContracts{
+				Sender: sender.String(),
+				Calls: []lbmtypes.ContractCall{
+					{Contract: first.String(), Msg: []byte(`{"first":{}}`), Funds: funds},
+					{Contract: second.String(), Msg: []byte(`{"second":{}}`)},
+				},
+			}
+			em := sdk.NewEventManager()
+
+			// when
+			msgServer := NewMsgServerImpl(keepers.ContractKeeper).(lbmtypes.MsgServer)
+			res, err := msgServer.ExecuteContracts(sdk.WrapSDKContext(ctx.WithEventManager(em)), msg)
+
+			// then
+			assert.Equal(t, []string{first.String(), second.String()}, called)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "call 1")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, [][]byte{[]byte(`{"first":{}}`), []byte(`{"second":{}}`)}, res.Data)
+			assert.Equal(t, funds, keepers.BankKeeper.GetAllBalances(ctx, first))
+			var executed []string
+			for _, e := range em.Events() {
+				if e.Type != types.EventTypeExecute {
+					continue
+				}
+				for _, a := range e.Attributes {
+					if string(a.Key) == types.AttributeKeyContractAddr {
+						executed = append(executed, string(a.Value))
+					}
+				}
+			}
+			assert.Equal(t, []string{first.String(), second.String()}, executed)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	sdk "github.com/line/lbm-sdk/types"
+
 	"github.com/line/wasmd/x/wasm/types"
 )
 
@@ -121,3 +123,121 @@ func WithMaxQueryStackSize(m uint32) Option {
 		k.maxQueryStackSize = m
 	})
 }
+
+// WithCodeReuseByChecksum lets uploads of a wasm code that was stored before with the same instantiate permission
+// and code metadata return the existing code id instead of storing a new one. A code without metadata is only reused
+// for uploads of its creator, so that other uploaders can still set the metadata of their own code id.
+func WithCodeReuseByChecksum() Option {
+	return optsFn(func(k *Keeper) {
+		k.reuseCodeByChecksum = true
+	})
+}
+
+// WithContractPurgeChunkSize overwrites the default max number of contract state entries that are deleted at once
+// when a contract is purged and in each end blocker afterwards.
+func WithContractPurgeChunkSize(n uint32) Option {
+	return optsFn(func(k *Keeper) {
+		if n == 0 {
+			panic("contract purge chunk size must not be 0")
+		}
+		k.contractPurgeChunkSize = n
+	})
+}
+
+// WithUploadSessionExpiry overwrites the default number of blocks after which an uncommitted chunked upload session
+// is deleted and its deposit is burned.
+func WithUploadSessionExpiry(blocks int64) Option {
+	return optsFn(func(k *Keeper) {
+		if blocks <= 0 {
+			panic("upload session expiry must be positive")
+		}
+		k.uploadSessionExpiry = blocks
+	})
+}
+
+// WithQueuedMigrationGasLimit overwrites the default gas limit of each queued migration that is executed in the end
+// blocker once the migration delay of the contract has passed.
+func WithQueuedMigrationGasLimit(gasLimit sdk.Gas) Option {
+	return optsFn(func(k *Keeper) {
+		if gasLimit == 0 {
+			panic("queued migration gas limit must not be 0")
+		}
+		k.queuedMigrationGasLimit = gasLimit
+	})
+}
+
+// WithScheduleBlockGasLimit overwrites the default gas that all scheduled callbacks of a block can consume together.
+// Due callbacks beyond the limit are executed in the following blocks.
+func WithScheduleBlockGasLimit(gasLimit sdk.Gas) Option {
+	return optsFn(func(k *Keeper) {
+		if gasLimit == 0 {
+			panic("schedule block gas limit must not be 0")
+		}
+		k.scheduleBlockGasLimit = gasLimit
+	})
+}
+
+// WithScheduleGasPrice overwrites the default price per gas of scheduled callbacks that is paid from their gas deposit
+// to the fee collector. The default price of 0.001 is in the default bond denom, chains with another fee denom must
+// set their own price.
+func WithScheduleGasPrice(price sdk.DecCoins) Option {
+	return optsFn(func(k *Keeper) {
+		if price.Empty() || !price.IsValid() {
+			panic(fmt.Sprintf("invalid schedule gas price: %s", price))
+		}
+		k.scheduleGasPrice = price
+	})
+}
+
+// WithSubMsgDataAsTxMsgData passes the data of all sdk messages to the reply when a submessage expands into more than
+// one. The reply data is then the protobuf encoded `cosmos.base.abci.v1beta1.TxMsgData` with one entry per sdk message
+// in dispatch order. The msg type of the entries is not set. The data of a submessage with a single sdk message is
+// passed unchanged. Only the data of the first sdk message is passed by default.
+func WithSubMsgDataAsTxMsgData() Option {
+	return optsFn(func(k *Keeper) {
+		k.subMsgDataAsTxMsgData = true
+	})
+}
+
+// WithMaxSchedulesPerContract overwrites the default max number of scheduled callbacks that a contract can register.
+func WithMaxSchedulesPerContract(max uint32) Option {
+	return optsFn(func(k *Keeper) {
+		if max == 0 {
+			panic("max schedules per contract must not be 0")
+		}
+		k.maxSchedulesPerContract = max
+	})
+}
+
+// WithInactiveContractAllowedEntryPoints lets calls to the given entry points of an inactive contract pass. All
+// entry points of an inactive contract are blocked by default. The purge is never allowed so that the admin can not
+// remove a contract that was deactivated by governance.
+func WithInactiveContractAllowedEntryPoints(entryPoints ...types.ContractEntryPoint) Option {
+	return optsFn(func(k *Keeper) {
+		known := make(map[types.ContractEntryPoint]struct{})
+		for _, e := range types.AllContractEntryPoints() {
+			known[e] = struct{}{}
+		}
+		k.inactiveContractAllowedEntryPoints = make(map[types.ContractEntryPoint]struct{}, len(entryPoints))
+		for _, e := range entryPoints {
+			if _, ok := known[e]; !ok {
+				panic(fmt.Sprintf("Unsupported contract entry point: %s", e))
+			}
+			if e == types.EntryPointPurge {
+				panic("purge can not be allowed for inactive contracts")
+			}
+			k.inactiveContractAllowedEntryPoints[e] = struct{}{}
+		}
+	})
+}
+
+// WithAuthority is an optional constructor parameter to set the address that is allowed to update the params.
+// The gov module account is used by default.
+func WithAuthority(authority string) Option {
+	return optsFn(func(k *Keeper) {
+		if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+			panic(fmt.Sprintf("invalid authority: %s", err))
+		}
+		k.authority = authority
+	})
+}
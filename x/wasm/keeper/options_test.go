@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/line/lbm-sdk/types"
 	authkeeper "github.com/line/lbm-sdk/x/auth/keeper"
 	bankpluskeeper "github.com/line/lbm-sdk/x/bankplus/keeper"
 	distributionkeeper "github.com/line/lbm-sdk/x/distribution/keeper"
@@ -17,6 +18,7 @@ import (
 )
 
 func TestConstructorOptions(t *testing.T) {
+	myAuthority := RandomBech32AccountAddress(t)
 	specs := map[string]struct {
 		srcOpt Option
 		verify func(*testing.T, Keeper)
@@ -69,6 +71,31 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, uint32(1), k.maxQueryStackSize)
 			},
 		},
+		"authority": {
+			srcOpt: WithAuthority(myAuthority),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, myAuthority, k.GetAuthority())
+			},
+		},
+		"upload session expiry": {
+			srcOpt: WithUploadSessionExpiry(10),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, int64(10), k.uploadSessionExpiry)
+			},
+		},
+		"submessage data as tx msg data": {
+			srcOpt: WithSubMsgDataAsTxMsgData(),
+			verify: func(t *testing.T, k Keeper) {
+				assert.True(t, k.subMsgDataAsTxMsgData)
+				assert.True(t, k.wasmVMResponseHandler.(*DefaultWasmVMContractResponseHandler).md.(*MessageDispatcher).subMsgDataAsTxMsgData)
+			},
+		},
+		"queued migration gas limit": {
+			srcOpt: WithQueuedMigrationGasLimit(1),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, sdk.Gas(1), k.queuedMigrationGasLimit)
+			},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+const (
+	privilegedCallbackBeginBlock = "begin_block"
+	privilegedCallbackEndBlock   = "end_block"
+)
+
+// registerPrivilegedContract registers a contract to receive a sudo call on every begin and/or end block. A previous
+// registration of the contract is replaced.
+func (k Keeper) registerPrivilegedContract(ctx sdk.Context, privileged types.PrivilegedContract) error {
+	if err := privileged.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddress := sdk.MustAccAddressFromBech32(privileged.Contract)
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	k.storePrivilegedContract(ctx, contractAddress, privileged)
+	return nil
+}
+
+// unregisterPrivilegedContract stops the begin and end block calls of a contract
+func (k Keeper) unregisterPrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	if k.GetPrivilegedContract(ctx, contractAddress) == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "privileged contract")
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetPrivilegedContractKey(contractAddress))
+	return nil
+}
+
+// GetPrivilegedContract returns the registration of a privileged contract or nil when the contract is not registered
+func (k Keeper) GetPrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress) *types.PrivilegedContract {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPrivilegedContractKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var privileged types.PrivilegedContract
+	k.cdc.MustUnmarshal(bz, &privileged)
+	return &privileged
+}
+
+// IteratePrivilegedContracts iterates over the registered privileged contracts ordered by contract address.
+// When the callback returns true, the loop is aborted early.
+func (k Keeper) IteratePrivilegedContracts(ctx sdk.Context, cb func(types.PrivilegedContract) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrivilegedContractPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var privileged types.PrivilegedContract
+		k.cdc.MustUnmarshal(iter.Value(), &privileged)
+		if cb(privileged) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storePrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress, privileged types.PrivilegedContract) {
+	ctx.KVStore(k.storeKey).Set(types.GetPrivilegedContractKey(contractAddress), k.cdc.MustMarshal(&privileged))
+}
+
+// BeginBlockPrivilegedContracts passes the begin_block sudo msg to the privileged contracts that are registered for
+// begin block.
+func (k Keeper) BeginBlockPrivilegedContracts(ctx sdk.Context) error {
+	return k.callPrivilegedContracts(ctx, privilegedCallbackBeginBlock, func(p types.PrivilegedContract) bool {
+		return p.BeginBlock
+	})
+}
+
+// EndBlockPrivilegedContracts passes the end_block sudo msg to the privileged contracts that are registered for end
+// block.
+func (k Keeper) EndBlockPrivilegedContracts(ctx sdk.Context) error {
+	return k.callPrivilegedContracts(ctx, privilegedCallbackEndBlock, func(p types.PrivilegedContract) bool {
+		return p.EndBlock
+	})
+}
+
+// callPrivilegedContracts calls the selected privileged contracts one after another. Inactive contracts are skipped.
+// A failed call drops its own state changes and emits an event but never aborts the block.
+func (k Keeper) callPrivilegedContracts(ctx sdk.Context, callback string, selected func(types.PrivilegedContract) bool) error {
+	var contracts []types.PrivilegedContract
+	k.IteratePrivilegedContracts(ctx, func(privileged types.PrivilegedContract) bool {
+		if selected(privileged) {
+			contracts = append(contracts, privileged)
+		}
+		return false
+	})
+
+	msg, err := json.Marshal(map[string]struct{}{callback: {}})
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	for _, privileged := range contracts {
+		contractAddress := sdk.MustAccAddressFromBech32(privileged.Contract)
+		if k.IsInactiveContract(ctx, contractAddress) {
+			continue
+		}
+		if err := k.callPrivilegedContract(ctx, contractAddress, msg, privileged.GasLimit); err != nil {
+			k.Logger(ctx).Error("privileged contract call failed", "contract", privileged.Contract, "callback", callback, "error", err)
+			event := lbmtypes.EventPrivilegedContractFailed{
+				Contract: privileged.Contract,
+				Callback: callback,
+				Error:    err.Error(),
+			}
+			if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// callPrivilegedContract passes the sudo msg to the contract in an isolated context with the gas limit of the
+// registration. Any panic of the call, out of gas included, is turned into an error and drops the state changes.
+func (k Keeper) callPrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (err error) {
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "privileged contract hit gas limit")
+				return
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "privileged contract panicked: %v", r)
+		}
+	}()
+	if _, err := k.Sudo(cacheCtx, contractAddress, msg); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
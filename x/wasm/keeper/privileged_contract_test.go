@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestPrivilegedContractProposals(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	submit := func(content govtypes.Content) error {
+		// the submission runs the handler on a cached context already
+		storedProposal, err := govKeeper.SubmitProposal(ctx, content)
+		if err != nil {
+			return err
+		}
+		handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+		return handler(ctx, storedProposal.GetContent())
+	}
+
+	// when registered by governance
+	err := submit(&lbmtypes.RegisterPrivilegedContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+		EndBlock:    true,
+		GasLimit:    100_000,
+	})
+	require.NoError(t, err)
+
+	// then
+	exp := types.PrivilegedContract{Contract: example.Contract.String(), EndBlock: true, GasLimit: 100_000}
+	assert.Equal(t, &exp, wasmKeeper.GetPrivilegedContract(ctx, example.Contract))
+
+	// and when registered again, the registration is replaced
+	err = submit(&lbmtypes.RegisterPrivilegedContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+		BeginBlock:  true,
+		GasLimit:    200_000,
+	})
+	require.NoError(t, err)
+	exp = types.PrivilegedContract{Contract: example.Contract.String(), BeginBlock: true, GasLimit: 200_000}
+	assert.Equal(t, &exp, wasmKeeper.GetPrivilegedContract(ctx, example.Contract))
+
+	// and when unregistered
+	err = submit(&lbmtypes.UnregisterPrivilegedContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+	})
+	require.NoError(t, err)
+	assert.Nil(t, wasmKeeper.GetPrivilegedContract(ctx, example.Contract))
+
+	// and when unregistered again
+	err = submit(&lbmtypes.UnregisterPrivilegedContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrNotFound.Error())
+
+	// and when the contract does not exist
+	err = submit(&lbmtypes.RegisterPrivilegedContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    RandomBech32AccountAddress(t),
+		EndBlock:    true,
+		GasLimit:    100_000,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrNotFound.Error())
+}
+
+func TestCallPrivilegedContracts(t *testing.T) {
+	stateKey := []byte("called")
+	specs := map[string]struct {
+		sudo         func(store wasmvm.KVStore) (*wasmvmtypes.Response, uint64, error)
+		expCommitted bool
+		expErr       *sdkerrors.Error
+	}{
+		"success": {
+			sudo: func(store wasmvm.KVStore) (*wasmvmtypes.Response, uint64, error) {
+				store.Set(stateKey, []byte{1})
+				return &wasmvmtypes.Response{}, 0, nil
+			},
+			expCommitted: true,
+		},
+		"contract error": {
+			sudo: func(store wasmvm.KVStore) (*wasmvmtypes.Response, uint64, error) {
+				store.Set(stateKey, []byte{1})
+				return nil, 0, errors.New("failed")
+			},
+			expErr: types.ErrExecuteFailed,
+		},
+		"out of gas": {
+			sudo: func(store wasmvm.KVStore) (*wasmvmtypes.Response, uint64, error) {
+				store.Set(stateKey, []byte{1})
+				return &wasmvmtypes.Response{}, 100_000 * types.DefaultGasMultiplier, nil
+			},
+			expErr: sdkerrors.ErrOutOfGas,
+		},
+		"panic": {
+			sudo: func(store wasmvm.KVStore) (*wasmvmtypes.Response, uint64, error) {
+				store.Set(stateKey, []byte{1})
+				panic("boom")
+			},
+			expErr: sdkerrors.ErrPanic,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			mock := &wasmtesting.MockWasmer{}
+			wasmtesting.MakeInstantiable(mock)
+			faulty := SeedNewContractInstance(t, ctx, keepers, mock).Contract
+			healthy := SeedNewContractInstance(t, ctx, keepers, mock).Contract
+			var called []string
+			mock.SudoFn = func(_ wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				var msg map[string]json.RawMessage
+				require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+				require.Contains(t, msg, "end_block")
+				called = append(called, env.Contract.Address)
+				if env.Contract.Address == faulty.String() {
+					return spec.sudo(store)
+				}
+				return &wasmvmtypes.Response{}, 0, nil
+			}
+			for _, contract := range []sdk.AccAddress{faulty, healthy} {
+				require.NoError(t, keepers.ContractKeeper.RegisterPrivilegedContract(ctx, types.PrivilegedContract{
+					Contract: contract.String(),
+					EndBlock: true,
+					GasLimit: 100_000,
+				}))
+			}
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+
+			// when
+			require.NoError(t, keepers.WasmKeeper.BeginBlockPrivilegedContracts(ctx))
+			require.Empty(t, called)
+			require.NoError(t, keepers.WasmKeeper.EndBlockPrivilegedContracts(ctx))
+
+			// then
+			assert.ElementsMatch(t, []string{faulty.String(), healthy.String()}, called)
+			assert.Equal(t, spec.expCommitted, keepers.WasmKeeper.QueryRaw(ctx, faulty, stateKey) != nil)
+			var failed []lbmtypes.EventPrivilegedContractFailed
+			for _, e := range em.ABCIEvents() {
+				if e.Type != "lbm.wasm.v1.EventPrivilegedContractFailed" {
+					continue
+				}
+				event, err := sdk.ParseTypedEvent(e)
+				require.NoError(t, err)
+				failed = append(failed, *event.(*lbmtypes.EventPrivilegedContractFailed))
+			}
+			if spec.expErr == nil {
+				assert.Empty(t, failed)
+				return
+			}
+			require.Len(t, failed, 1)
+			assert.Equal(t, faulty.String(), failed[0].Contract)
+			assert.Equal(t, "end_block", failed[0].Callback)
+			assert.Contains(t, failed[0].Error, spec.expErr.Error())
+		})
+	}
+}
+
+func TestPrivilegedContractsSkipInactive(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	var called int
+	mock.SudoFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		called++
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+	require.NoError(t, keepers.ContractKeeper.RegisterPrivilegedContract(ctx, types.PrivilegedContract{
+		Contract:   example.Contract.String(),
+		BeginBlock: true,
+		GasLimit:   100_000,
+	}))
+	require.NoError(t, keepers.WasmKeeper.BeginBlockPrivilegedContracts(ctx))
+	require.Equal(t, 1, called)
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.DeactivateContract(ctx, example.Contract, types.InactiveContractInfo{}))
+	require.NoError(t, keepers.WasmKeeper.BeginBlockPrivilegedContracts(ctx))
+
+	// then
+	assert.Equal(t, 1, called)
+}
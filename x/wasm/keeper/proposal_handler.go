@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	govtypes "github.com/line/lbm-sdk/x/gov/types"
 
 	"github.com/line/wasmd/x/wasm/lbmtypes"
@@ -54,6 +55,22 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleDeactivateContractProposal(ctx, k, *c)
 		case *lbmtypes.ActivateContractProposal:
 			return handleActivateContractProposal(ctx, k, *c)
+		case *lbmtypes.PurgeContractProposal:
+			return handlePurgeContractProposal(ctx, k, *c)
+		case *lbmtypes.RemoveCodesProposal:
+			return handleRemoveCodesProposal(ctx, k, *c)
+		case *lbmtypes.UpdateParamsProposal:
+			return handleUpdateParamsProposal(ctx, k, *c)
+		case *lbmtypes.UpdateMigrationAllowlistProposal:
+			return handleUpdateMigrationAllowlistProposal(ctx, k, *c)
+		case *lbmtypes.UpdateContractStorageQuotaProposal:
+			return handleUpdateContractStorageQuotaProposal(ctx, k, *c)
+		case *lbmtypes.RegisterPrivilegedContractProposal:
+			return handleRegisterPrivilegedContractProposal(ctx, k, *c)
+		case *lbmtypes.UnregisterPrivilegedContractProposal:
+			return handleUnregisterPrivilegedContractProposal(ctx, k, *c)
+		case *lbmtypes.UpdateAcceptedStargateQueriesProposal:
+			return handleUpdateAcceptedStargateQueriesProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -69,7 +86,7 @@ func handleStoreCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types
 	if err != nil {
 		return sdkerrors.Wrap(err, "run as address")
 	}
-	codeID, err := k.Create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
+	codeID, err := k.CreateWithMetadata(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission, p.Metadata)
 	if err != nil {
 		return err
 	}
@@ -249,10 +266,17 @@ func handleDeactivateContractProposal(ctx sdk.Context, k types.ContractOpsKeeper
 	//nolint:errcheck
 	contractAddr, _ := sdk.AccAddressFromBech32(p.Contract)
 
-	err := k.DeactivateContract(ctx, contractAddr)
+	if p.ExpiryHeight != 0 && p.ExpiryHeight <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "expiry height %d must be after the current height", p.ExpiryHeight)
+	}
+	err := k.DeactivateContract(ctx, contractAddr, types.InactiveContractInfo{
+		Reason:       p.Description,
+		ExpiryHeight: p.ExpiryHeight,
+	})
 	if err != nil {
 		return err
 	}
+	k.SetPendingDeactivationProposal(ctx, contractAddr)
 
 	event := lbmtypes.EventDeactivateContractProposal{
 		Contract: contractAddr.String(),
@@ -285,3 +309,109 @@ func handleActivateContractProposal(ctx sdk.Context, k types.ContractOpsKeeper,
 
 	return nil
 }
+
+func handlePurgeContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.PurgeContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// The errors are already checked in ValidateBasic.
+	//nolint:errcheck
+	contractAddr, _ := sdk.AccAddressFromBech32(p.Contract)
+	//nolint:errcheck
+	beneficiaryAddr, _ := sdk.AccAddressFromBech32(p.Beneficiary)
+
+	return k.PurgeContract(ctx, contractAddr, nil, beneficiaryAddr)
+}
+
+func handleRemoveCodesProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.RemoveCodesProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, v := range p.CodeIDs {
+		if err := k.RemoveCode(ctx, v); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", v)
+		}
+	}
+
+	event := lbmtypes.EventRemoveCodesProposal{CodeIds: p.CodeIDs}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
+
+// handleUpdateParamsProposal updates the params with the gov module account as authority
+func handleUpdateParamsProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.UpdateParamsProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.UpdateParams(ctx, authtypes.NewModuleAddress(govtypes.ModuleName), p.Params)
+}
+
+func handleUpdateMigrationAllowlistProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.UpdateMigrationAllowlistProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// The error is already checked in ValidateBasic.
+	//nolint:errcheck
+	contractAddr, _ := sdk.AccAddressFromBech32(p.Contract)
+
+	allowlist := types.MigrationAllowlist{CodeIDs: p.CodeIDs, Checksums: p.Checksums}
+	return k.UpdateMigrationAllowlist(ctx, contractAddr, nil, allowlist)
+}
+
+func handleUpdateContractStorageQuotaProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.UpdateContractStorageQuotaProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// The error is already checked in ValidateBasic.
+	//nolint:errcheck
+	contractAddr, _ := sdk.AccAddressFromBech32(p.Contract)
+
+	return k.SetContractStorageQuota(ctx, contractAddr, p.Quota)
+}
+
+func handleRegisterPrivilegedContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.RegisterPrivilegedContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.RegisterPrivilegedContract(ctx, types.PrivilegedContract{
+		Contract:   p.Contract,
+		BeginBlock: p.BeginBlock,
+		EndBlock:   p.EndBlock,
+		GasLimit:   p.GasLimit,
+	})
+}
+
+func handleUnregisterPrivilegedContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.UnregisterPrivilegedContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// The error is already checked in ValidateBasic.
+	//nolint:errcheck
+	contractAddr, _ := sdk.AccAddressFromBech32(p.Contract)
+
+	return k.UnregisterPrivilegedContract(ctx, contractAddr)
+}
+
+func handleUpdateAcceptedStargateQueriesProposal(ctx sdk.Context, k types.ContractOpsKeeper, p lbmtypes.UpdateAcceptedStargateQueriesProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, path := range p.RemovePaths {
+		if err := k.RemoveAcceptedStargateQuery(ctx, path); err != nil {
+			return sdkerrors.Wrapf(err, "remove path: %s", path)
+		}
+	}
+	for _, query := range p.Accept {
+		if err := k.SetAcceptedStargateQuery(ctx, query); err != nil {
+			return sdkerrors.Wrapf(err, "accept path: %s", query.Path)
+		}
+	}
+	return nil
+}
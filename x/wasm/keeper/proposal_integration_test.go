@@ -13,8 +13,6 @@ import (
 
 	sdk "github.com/line/lbm-sdk/types"
 	govtypes "github.com/line/lbm-sdk/x/gov/types"
-	"github.com/line/lbm-sdk/x/params/client/utils"
-	"github.com/line/lbm-sdk/x/params/types/proposal"
 	wasmvm "github.com/line/wasmvm"
 
 	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
@@ -31,6 +29,9 @@ func TestStoreCodeProposal(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		MaxWasmSize:                  types.DefaultMaxWasmSize,
+		MaxLabelSize:                 types.DefaultMaxLabelSize,
+		MaxDecompressedWasmSize:      types.DefaultMaxDecompressedWasmSize,
 	})
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -71,6 +72,9 @@ func TestInstantiateProposal(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		MaxWasmSize:                  types.DefaultMaxWasmSize,
+		MaxLabelSize:                 types.DefaultMaxLabelSize,
+		MaxDecompressedWasmSize:      types.DefaultMaxDecompressedWasmSize,
 	})
 
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
@@ -139,6 +143,9 @@ func TestInstantiateProposal_NoAdmin(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		MaxWasmSize:                  types.DefaultMaxWasmSize,
+		MaxLabelSize:                 types.DefaultMaxLabelSize,
+		MaxDecompressedWasmSize:      types.DefaultMaxDecompressedWasmSize,
 	})
 
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
@@ -214,6 +221,9 @@ func TestMigrateProposal(t *testing.T) {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		MaxWasmSize:                  types.DefaultMaxWasmSize,
+		MaxLabelSize:                 types.DefaultMaxLabelSize,
+		MaxDecompressedWasmSize:      types.DefaultMaxDecompressedWasmSize,
 	})
 
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
@@ -468,6 +478,9 @@ func TestAdminProposals(t *testing.T) {
 				GasMultiplier:                types.DefaultGasMultiplier,
 				InstanceCost:                 types.DefaultInstanceCost,
 				CompileCost:                  types.DefaultCompileCost,
+				MaxWasmSize:                  types.DefaultMaxWasmSize,
+				MaxLabelSize:                 types.DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      types.DefaultMaxDecompressedWasmSize,
 			})
 
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
@@ -496,83 +509,58 @@ func TestUpdateParamsProposal(t *testing.T) {
 	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
 
 	var (
-		legacyAmino                           = keepers.EncodingConfig.Amino
 		myAddress              sdk.AccAddress = make([]byte, types.ContractAddrLen)
 		oneAddressAccessConfig                = types.AccessTypeOnlyAddress.With(myAddress)
 	)
+	paramsWith := func(mutator func(p *types.Params)) types.Params {
+		p := types.DefaultParams()
+		mutator(&p)
+		return p
+	}
 
 	specs := map[string]struct {
-		src                proposal.ParamChange
-		expUploadConfig    types.AccessConfig
-		expInstantiateType types.AccessType
+		src    types.Params
+		expErr bool
 	}{
 		"update upload permission param": {
-			src: proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.ParamStoreKeyUploadAccess),
-				Value:    string(legacyAmino.MustMarshalJSON(&types.AllowNobody)),
-			},
-			expUploadConfig:    types.AllowNobody,
-			expInstantiateType: types.AccessTypeEverybody,
+			src: paramsWith(func(p *types.Params) { p.CodeUploadAccess = types.AllowNobody }),
 		},
 		"update upload permission with same as current value": {
-			src: proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.ParamStoreKeyUploadAccess),
-				Value:    string(legacyAmino.MustMarshalJSON(&types.AllowEverybody)),
-			},
-			expUploadConfig:    types.AllowEverybody,
-			expInstantiateType: types.AccessTypeEverybody,
+			src: types.DefaultParams(),
 		},
 		"update upload permission param with address": {
-			src: proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.ParamStoreKeyUploadAccess),
-				Value:    string(legacyAmino.MustMarshalJSON(&oneAddressAccessConfig)),
-			},
-			expUploadConfig:    oneAddressAccessConfig,
-			expInstantiateType: types.AccessTypeEverybody,
+			src: paramsWith(func(p *types.Params) { p.CodeUploadAccess = oneAddressAccessConfig }),
 		},
 		"update instantiate param": {
-			src: proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.ParamStoreKeyInstantiateAccess),
-				Value:    string(legacyAmino.MustMarshalJSON(types.AccessTypeNobody)),
-			},
-			expUploadConfig:    types.AllowEverybody,
-			expInstantiateType: types.AccessTypeNobody,
-		},
-		"update instantiate param as default": {
-			src: proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.ParamStoreKeyInstantiateAccess),
-				Value:    string(legacyAmino.MustMarshalJSON(types.AccessTypeEverybody)),
-			},
-			expUploadConfig:    types.AllowEverybody,
-			expInstantiateType: types.AccessTypeEverybody,
+			src: paramsWith(func(p *types.Params) { p.InstantiateDefaultPermission = types.AccessTypeNobody }),
+		},
+		"update gas params": {
+			src: paramsWith(func(p *types.Params) {
+				p.GasMultiplier = 1
+				p.InstanceCost = 2
+				p.CompileCost = 3
+			}),
+		},
+		"reject invalid params": {
+			src:    paramsWith(func(p *types.Params) { p.GasMultiplier = 0 }),
+			expErr: true,
 		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			wasmKeeper.SetParams(ctx, types.DefaultParams())
-
-			// encode + decode as CLI to play nice with amino
-			bz := legacyAmino.MustMarshalJSON(&utils.ParamChangeProposalJSON{
+			proposal := lbmtypes.UpdateParamsProposal{
 				Title:       "Foo",
 				Description: "Bar",
-				Changes:     []utils.ParamChangeJSON{{Subspace: spec.src.Subspace, Key: spec.src.Key, Value: json.RawMessage(spec.src.Value)}},
-			})
-			t.Log(string(bz))
-
-			var jsonProposal utils.ParamChangeProposalJSON
-			require.NoError(t, legacyAmino.UnmarshalJSON(bz, &jsonProposal))
-			proposal := proposal.ParameterChangeProposal{
-				Title:       jsonProposal.Title,
-				Description: jsonProposal.Description,
-				Changes:     jsonProposal.Changes.ToParamChanges(),
+				Params:      spec.src,
 			}
+
 			// when stored
 			storedProposal, err := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
 			require.NoError(t, err)
 
 			// and proposal execute
@@ -581,13 +569,31 @@ func TestUpdateParamsProposal(t *testing.T) {
 			require.NoError(t, err)
 
 			// then
-			assert.True(t, spec.expUploadConfig.Equals(wasmKeeper.getUploadAccessConfig(ctx)),
-				"got %#v not %#v", wasmKeeper.getUploadAccessConfig(ctx), spec.expUploadConfig)
-			assert.Equal(t, spec.expInstantiateType, wasmKeeper.getInstantiateAccessConfig(ctx))
+			assert.Equal(t, spec.src, wasmKeeper.GetParams(ctx))
 		})
 	}
 }
 
+func TestUpdateParamsProposalWithOtherAuthority(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil, WithAuthority(RandomBech32AccountAddress(t)))
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	params := types.DefaultParams()
+	params.CodeUploadAccess = types.AllowNobody
+	proposal := lbmtypes.UpdateParamsProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Params:      params,
+	}
+
+	// when
+	_, err := govKeeper.SubmitProposal(ctx, &proposal)
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, types.DefaultParams(), wasmKeeper.GetParams(ctx))
+}
+
 func TestPinCodesProposal(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
 	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
@@ -776,6 +782,7 @@ func TestUpdateInstantiateConfigProposal(t *testing.T) {
 	require.NoError(t, err)
 
 	withAddressAccessConfig := types.AccessTypeOnlyAddress.With(anyAddress)
+	anyOfAddressesAccessConfig := types.AccessTypeAnyOfAddresses.With(anyAddress, RandomAccountAddress(t))
 	var (
 		nobody      = StoreRandomContractWithAccessConfig(t, ctx, keepers, &mock, &types.AllowNobody)
 		everybody   = StoreRandomContractWithAccessConfig(t, ctx, keepers, &mock, &types.AllowEverybody)
@@ -798,6 +805,12 @@ func TestUpdateInstantiateConfigProposal(t *testing.T) {
 				{CodeID: withAddress.CodeID, InstantiatePermission: types.AllowEverybody},
 			},
 		},
+		"update with any of addresses": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: everybody.CodeID, InstantiatePermission: anyOfAddressesAccessConfig},
+				{CodeID: withAddress.CodeID, InstantiatePermission: anyOfAddressesAccessConfig},
+			},
+		},
 		"update same code id": {
 			accessConfigUpdates: []types.AccessConfigUpdate{
 				{CodeID: everybody.CodeID, InstantiatePermission: types.AllowNobody},
@@ -867,9 +880,10 @@ func TestValidateDeactivateContractProposal(t *testing.T) {
 	example := SeedNewContractInstance(t, ctx, keepers, &mock)
 
 	src := lbmtypes.DeactivateContractProposal{
-		Title:       "Foo",
-		Description: "Bar",
-		Contract:    example.Contract.String(),
+		Title:        "Foo",
+		Description:  "Bar",
+		Contract:     example.Contract.String(),
+		ExpiryHeight: ctx.BlockHeight() + 100,
 	}
 
 	em := sdk.NewEventManager()
@@ -882,10 +896,67 @@ func TestValidateDeactivateContractProposal(t *testing.T) {
 	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
 	err = handler(ctx.WithEventManager(em), storedProposal.GetContent())
 	require.NoError(t, err)
+	govKeeper.AfterProposalVotingPeriodEnded(ctx, storedProposal.ProposalId)
 
 	// then
 	isInactive := wasmKeeper.IsInactiveContract(ctx, example.Contract)
 	require.True(t, isInactive)
+	exp := types.InactiveContractInfo{
+		Reason:       "Bar",
+		ProposalID:   storedProposal.ProposalId,
+		Deactivated:  types.NewAbsoluteTxPosition(ctx),
+		ExpiryHeight: ctx.BlockHeight() + 100,
+	}
+	assert.Equal(t, &exp, wasmKeeper.GetInactiveContractInfo(ctx, example.Contract))
+
+	// proposal id is assigned only once
+	govKeeper.AfterProposalVotingPeriodEnded(ctx, storedProposal.ProposalId+1)
+	assert.Equal(t, &exp, wasmKeeper.GetInactiveContractInfo(ctx, example.Contract))
+}
+
+func TestDeactivateContractOutsideOfProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+
+	// when deactivated by another user of the keeper
+	err := NewGovPermissionKeeper(wasmKeeper).DeactivateContract(ctx, example.Contract, types.InactiveContractInfo{Reason: "Bar"})
+	require.NoError(t, err)
+	// and an unrelated proposal ends
+	govKeeper.AfterProposalVotingPeriodEnded(ctx, 1)
+
+	// then no proposal id is assigned
+	exp := types.InactiveContractInfo{
+		Reason:      "Bar",
+		Deactivated: types.NewAbsoluteTxPosition(ctx),
+	}
+	assert.Equal(t, &exp, wasmKeeper.GetInactiveContractInfo(ctx, example.Contract))
+}
+
+func TestDeactivateContractProposalWithPastExpiry(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+
+	src := lbmtypes.DeactivateContractProposal{
+		Title:        "Foo",
+		Description:  "Bar",
+		Contract:     example.Contract.String(),
+		ExpiryHeight: ctx.BlockHeight(),
+	}
+
+	// when
+	_, err := govKeeper.SubmitProposal(ctx, &src)
+
+	// then
+	require.ErrorContains(t, err, "must be after the current height")
+	assert.False(t, wasmKeeper.IsInactiveContract(ctx, example.Contract))
 }
 
 func TestActivateContractProposal(t *testing.T) {
@@ -896,7 +967,7 @@ func TestActivateContractProposal(t *testing.T) {
 	wasmtesting.MakeInstantiable(&mock)
 	example := SeedNewContractInstance(t, ctx, keepers, &mock)
 	// set deactivate
-	err := wasmKeeper.deactivateContract(ctx, example.Contract)
+	err := wasmKeeper.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
 
 	src := lbmtypes.ActivateContractProposal{
@@ -920,3 +991,103 @@ func TestActivateContractProposal(t *testing.T) {
 	isInactive := wasmKeeper.IsInactiveContract(ctx, example.Contract)
 	require.False(t, isInactive)
 }
+
+func TestPurgeContractProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	// contracts without admin can be purged by governance
+	require.NoError(t, wasmKeeper.setContractAdmin(ctx, example.Contract, example.CreatorAddr, nil, DefaultAuthorizationPolicy{}))
+	balance := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	fundAccounts(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, example.Contract, balance)
+	beneficiary := RandomAccountAddress(t)
+
+	src := lbmtypes.PurgeContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+		Beneficiary: beneficiary.String(),
+	}
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &src)
+	require.NoError(t, err)
+
+	// proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	assert.Nil(t, wasmKeeper.GetContractInfo(ctx, example.Contract))
+	assert.Equal(t, balance, keepers.BankKeeper.GetAllBalances(ctx, beneficiary))
+}
+
+func TestRemoveCodesProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	var (
+		unused      = StoreRandomContract(t, ctx, keepers, &mock)
+		otherUnused = StoreRandomContract(t, ctx, keepers, &mock)
+		used        = SeedNewContractInstance(t, ctx, keepers, &mock)
+	)
+	specs := map[string]struct {
+		srcCodeIDs []uint64
+		expErr     bool
+	}{
+		"remove one": {
+			srcCodeIDs: []uint64{unused.CodeID},
+		},
+		"remove multiple": {
+			srcCodeIDs: []uint64{unused.CodeID, otherUnused.CodeID},
+		},
+		"remove code with contract": {
+			srcCodeIDs: []uint64{unused.CodeID, used.CodeID},
+			expErr:     true,
+		},
+		"remove non existing code id": {
+			srcCodeIDs: []uint64{999},
+			expErr:     true,
+		},
+		"remove empty code id list": {
+			srcCodeIDs: []uint64{},
+			expErr:     true,
+		},
+	}
+	parentCtx := ctx
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			proposal := lbmtypes.RemoveCodesProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				CodeIDs:     spec.srcCodeIDs,
+			}
+
+			// when stored
+			storedProposal, gotErr := govKeeper.SubmitProposal(ctx, &proposal)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and proposal execute
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			gotErr = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, gotErr)
+
+			// then
+			for _, codeID := range spec.srcCodeIDs {
+				assert.Nil(t, wasmKeeper.GetCodeInfo(ctx, codeID))
+				assert.NotNil(t, wasmKeeper.GetRemovedCodeInfo(ctx, codeID))
+			}
+		})
+	}
+}
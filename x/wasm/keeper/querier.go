@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"runtime/debug"
 
 	"google.golang.org/grpc/codes"
@@ -111,6 +112,62 @@ func (q GrpcQuerier) ContractsByCode(c context.Context, req *types.QueryContract
 	}, nil
 }
 
+// ContractsByCreator lists all smart contracts instantiated by a creator
+func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByCreatorPrefix(creatorAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var contractAddr sdk.AccAddress = key[types.AbsoluteTxPositionLen:]
+			contracts = append(contracts, contractAddr.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByCreatorResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractByLabel(c context.Context, req *types.QueryContractByLabelRequest) (*types.QueryContractByLabelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]types.QueryContractInfoResponse, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractByLabelPrefix(req.Label))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			rsp, err := queryContractInfo(ctx, value, q.keeper)
+			if err != nil {
+				return false, err
+			}
+			contracts = append(contracts, *rsp)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractByLabelResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q GrpcQuerier) AllContractState(c context.Context, req *types.QueryAllContractStateRequest) (*types.QueryAllContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -231,8 +288,12 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 	r := make([]types.CodeInfoResponse, 0)
-	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeKeyPrefix)
-	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+	removedStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.RemovedCodeKeyPrefix)
+	codeStore := codeInfoStore{
+		KVStore: prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeKeyPrefix),
+		removed: removedStore,
+	}
+	pageRes, err := query.FilteredPaginate(codeStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		if accumulate {
 			var c types.CodeInfo
 			if err := q.cdc.Unmarshal(value, &c); err != nil {
@@ -243,6 +304,8 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Metadata:              c.Metadata,
+				Removed:               removedStore.Has(key),
 			})
 		}
 		return true, nil
@@ -272,6 +335,17 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 	}
 	res := keeper.GetCodeInfo(ctx, codeID)
 	if res == nil {
+		if removed := keeper.GetRemovedCodeInfo(ctx, codeID); removed != nil {
+			// the wasm code of removed codes is not served
+			return &types.QueryCodeResponse{CodeInfoResponse: &types.CodeInfoResponse{
+				CodeID:                codeID,
+				Creator:               removed.Creator,
+				DataHash:              removed.CodeHash,
+				InstantiatePermission: removed.InstantiateConfig,
+				Metadata:              removed.Metadata,
+				Removed:               true,
+			}}, nil
+		}
 		// nil, nil leads to 404 in rest handler
 		return nil, nil
 	}
@@ -280,6 +354,7 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Metadata:              res.Metadata,
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -313,6 +388,72 @@ func (q GrpcQuerier) PinnedCodes(c context.Context, req *types.QueryPinnedCodesR
 	}, nil
 }
 
+// BuildAddress predicts the address of a contract instantiated with the salted instantiate message
+func (q GrpcQuerier) BuildAddress(c context.Context, req *types.QueryBuildAddressRequest) (*types.QueryBuildAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid code hash: %s", err)
+	}
+	if len(codeHash) != 32 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid code hash length")
+	}
+	creator, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+	salt, err := hex.DecodeString(req.Salt)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid salt: %s", err)
+	}
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt: %s", err)
+	}
+	initArgs := types.RawContractMessage(req.InitArgs)
+	if len(initArgs) != 0 {
+		if err := initArgs.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "init args: %s", err)
+		}
+	}
+	return &types.QueryBuildAddressResponse{
+		Address: BuildContractAddressPredictable(codeHash, creator, salt, initArgs).String(),
+	}, nil
+}
+
+// CodeByChecksum returns the code info of the lowest code id that was stored with the given checksum
+func (q GrpcQuerier) CodeByChecksum(c context.Context, req *types.QueryCodeByChecksumRequest) (*types.QueryCodeByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid checksum: %s", err)
+	}
+	if len(checksum) != 32 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid checksum length")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	codeID, found := q.keeper.GetCodeIDByChecksum(ctx, checksum)
+	if !found {
+		return nil, types.ErrNotFound
+	}
+	res := q.keeper.GetCodeInfo(ctx, codeID)
+	if res == nil {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryCodeByChecksumResponse{
+		CodeInfoResponse: &types.CodeInfoResponse{
+			CodeID:                codeID,
+			Creator:               res.Creator,
+			DataHash:              res.CodeHash,
+			InstantiatePermission: res.InstantiateConfig,
+			Metadata:              res.Metadata,
+		},
+	}, nil
+}
+
 func (q GrpcQuerier) InactiveContracts(c context.Context, req *lbmtypes.QueryInactiveContractsRequest) (*lbmtypes.QueryInactiveContractsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -321,9 +462,9 @@ func (q GrpcQuerier) InactiveContracts(c context.Context, req *lbmtypes.QueryIna
 
 	addresses := make([]string, 0)
 	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.InactiveContractPrefix)
-	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
 		if accumulate {
-			contractAddress := sdk.AccAddress(value)
+			contractAddress := sdk.AccAddress(key)
 			addresses = append(addresses, contractAddress.String())
 		}
 		return true, nil
@@ -352,8 +493,203 @@ func (q GrpcQuerier) InactiveContract(c context.Context, req *lbmtypes.QueryInac
 		return nil, types.ErrNotFound
 	}
 
-	inactivated := q.keeper.IsInactiveContract(ctx, contractAddr)
+	info := q.keeper.GetInactiveContractInfo(ctx, contractAddr)
 	return &lbmtypes.QueryInactiveContractResponse{
-		Inactivated: inactivated,
+		Inactivated: info != nil,
+		Info:        info,
+	}, nil
+}
+
+func (q GrpcQuerier) PendingMigrations(c context.Context, req *lbmtypes.QueryPendingMigrationsRequest) (*lbmtypes.QueryPendingMigrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingMigrations := make([]types.PendingMigration, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.PendingMigrationPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var pending types.PendingMigration
+			if err := q.cdc.Unmarshal(value, &pending); err != nil {
+				return false, err
+			}
+			pendingMigrations = append(pendingMigrations, pending)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lbmtypes.QueryPendingMigrationsResponse{
+		PendingMigrations: pendingMigrations,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) MigrationAllowlist(c context.Context, req *lbmtypes.QueryMigrationAllowlistRequest) (*lbmtypes.QueryMigrationAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+
+	return &lbmtypes.QueryMigrationAllowlistResponse{
+		Allowlist: q.keeper.GetMigrationAllowlist(ctx, contractAddr),
+	}, nil
+}
+
+func (q GrpcQuerier) ContractStorage(c context.Context, req *lbmtypes.QueryContractStorageRequest) (*lbmtypes.QueryContractStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+
+	return &lbmtypes.QueryContractStorageResponse{
+		Storage: q.keeper.GetContractStorage(ctx, contractAddr),
+		Quota:   q.keeper.GetContractStorageQuota(ctx, contractAddr),
+	}, nil
+}
+
+func (q GrpcQuerier) FeeAllowances(c context.Context, req *lbmtypes.QueryFeeAllowancesRequest) (*lbmtypes.QueryFeeAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+
+	allowances := make([]types.FeeAllowance, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetFeeAllowancePrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var allowance types.FeeAllowance
+			if err := q.cdc.Unmarshal(value, &allowance); err != nil {
+				return false, err
+			}
+			allowances = append(allowances, allowance)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lbmtypes.QueryFeeAllowancesResponse{
+		Allowances: allowances,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) Schedules(c context.Context, req *lbmtypes.QuerySchedulesRequest) (*lbmtypes.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+
+	schedules := make([]types.Schedule, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetSchedulePrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var schedule types.Schedule
+			if err := q.cdc.Unmarshal(value, &schedule); err != nil {
+				return false, err
+			}
+			schedules = append(schedules, schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lbmtypes.QuerySchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) PrivilegedContracts(c context.Context, req *lbmtypes.QueryPrivilegedContractsRequest) (*lbmtypes.QueryPrivilegedContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contracts := make([]types.PrivilegedContract, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.PrivilegedContractPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var privileged types.PrivilegedContract
+			if err := q.cdc.Unmarshal(value, &privileged); err != nil {
+				return false, err
+			}
+			contracts = append(contracts, privileged)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lbmtypes.QueryPrivilegedContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) AcceptedStargateQueries(c context.Context, req *lbmtypes.QueryAcceptedStargateQueriesRequest) (*lbmtypes.QueryAcceptedStargateQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queries := make([]types.AcceptedStargateQuery, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.AcceptedStargateQueryPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var accepted types.AcceptedStargateQuery
+			if err := q.cdc.Unmarshal(value, &accepted); err != nil {
+				return false, err
+			}
+			queries = append(queries, accepted)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lbmtypes.QueryAcceptedStargateQueriesResponse{
+		Queries:    queries,
+		Pagination: pageRes,
 	}, nil
 }
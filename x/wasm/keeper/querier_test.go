@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -486,6 +487,16 @@ func TestQueryCode(t *testing.T) {
 			expErr: nil,
 		},
 	}
+	t.Run("removed codeID", func(t *testing.T) {
+		xCtx, _ := ctx.CacheContext()
+		require.NoError(t, keeper.removeCode(xCtx, codeID))
+
+		got, err := Querier(keeper).Code(sdk.WrapSDKContext(xCtx), &types.QueryCodeRequest{CodeId: codeID})
+		require.NoError(t, err)
+		assert.EqualValues(t, codeID, got.CodeID)
+		assert.True(t, got.Removed)
+		assert.Empty(t, got.Data)
+	})
 
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -515,9 +526,10 @@ func TestQueryCodeList(t *testing.T) {
 	keeper := keepers.WasmKeeper
 
 	specs := map[string]struct {
-		storedCodeIDs []uint64
-		req           types.QueryCodesRequest
-		expCodeIDs    []uint64
+		storedCodeIDs  []uint64
+		removedCodeIDs []uint64
+		req            types.QueryCodesRequest
+		expCodeIDs     []uint64
 	}{
 		"none": {},
 		"no gaps": {
@@ -555,6 +567,31 @@ func TestQueryCodeList(t *testing.T) {
 			},
 			expCodeIDs: []uint64{2, 3},
 		},
+		"with removed codes": {
+			storedCodeIDs:  []uint64{1, 2, 3},
+			removedCodeIDs: []uint64{2},
+			expCodeIDs:     []uint64{1, 2, 3},
+		},
+		"with removed codes and pagination limit": {
+			storedCodeIDs:  []uint64{1, 2, 3, 4},
+			removedCodeIDs: []uint64{1, 3},
+			req: types.QueryCodesRequest{
+				Pagination: &query.PageRequest{
+					Limit: 3,
+				},
+			},
+			expCodeIDs: []uint64{1, 2, 3},
+		},
+		"with removed codes and pagination next key": {
+			storedCodeIDs:  []uint64{1, 2, 3, 4},
+			removedCodeIDs: []uint64{1, 3},
+			req: types.QueryCodesRequest{
+				Pagination: &query.PageRequest{
+					Key: fromBase64("AAAAAAAAAAI="),
+				},
+			},
+			expCodeIDs: []uint64{2, 3, 4},
+		},
 	}
 
 	for msg, spec := range specs {
@@ -567,6 +604,11 @@ func TestQueryCodeList(t *testing.T) {
 					wasmCode),
 				)
 			}
+			removed := make(map[uint64]bool, len(spec.removedCodeIDs))
+			for _, codeID := range spec.removedCodeIDs {
+				require.NoError(t, keeper.removeCode(xCtx, codeID))
+				removed[codeID] = true
+			}
 			// when
 			q := Querier(keeper)
 			got, err := q.Codes(sdk.WrapSDKContext(xCtx), &spec.req)
@@ -577,6 +619,7 @@ func TestQueryCodeList(t *testing.T) {
 			require.Len(t, got.CodeInfos, len(spec.expCodeIDs))
 			for i, exp := range spec.expCodeIDs {
 				assert.EqualValues(t, exp, got.CodeInfos[i].CodeID)
+				assert.Equal(t, removed[exp], got.CodeInfos[i].Removed)
 			}
 		})
 	}
@@ -864,9 +907,9 @@ func TestQueryInactiveContracts(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 
 	// set inactive
-	err := keeper.deactivateContract(ctx, example1.Contract)
+	err := keeper.deactivateContract(ctx, example1.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
-	err = keeper.deactivateContract(ctx, example2.Contract)
+	err = keeper.deactivateContract(ctx, example2.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
 
 	q := Querier(keeper)
@@ -892,13 +935,346 @@ func TestQueryIsInactiveContract(t *testing.T) {
 	res, err := q.InactiveContract(sdk.WrapSDKContext(ctx), &rq)
 	require.NoError(t, err)
 	require.False(t, res.Inactivated)
+	require.Nil(t, res.Info)
 
 	// set inactive
-	err = keeper.deactivateContract(ctx, example.Contract)
+	err = keeper.deactivateContract(ctx, example.Contract, types.InactiveContractInfo{})
 	require.NoError(t, err)
 
 	rq = lbmtypes.QueryInactiveContractRequest{Address: example.Contract.String()}
 	res, err = q.InactiveContract(sdk.WrapSDKContext(ctx), &rq)
 	require.NoError(t, err)
 	require.True(t, res.Inactivated)
+	assert.Equal(t, &types.InactiveContractInfo{Deactivated: types.NewAbsoluteTxPosition(ctx)}, res.Info)
+}
+
+func TestQueryBuildAddress(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	keepers.WasmKeeper.wasmVM = mock
+
+	salt := []byte("my salt")
+	initMsg := []byte(`{"foo":"bar"}`)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil, salt, true)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src    *types.QueryBuildAddressRequest
+		exp    string
+		expErr bool
+	}{
+		"fixed init msg": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(example.Checksum),
+				CreatorAddress: example.CreatorAddr.String(),
+				Salt:           hex.EncodeToString(salt),
+				InitArgs:       initMsg,
+			},
+			exp: contractAddr.String(),
+		},
+		"without init msg": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(example.Checksum),
+				CreatorAddress: example.CreatorAddr.String(),
+				Salt:           hex.EncodeToString(salt),
+			},
+			exp: BuildContractAddressPredictable(example.Checksum, example.CreatorAddr, salt, []byte{}).String(),
+		},
+		"empty request": {
+			expErr: true,
+		},
+		"invalid code hash": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "invalid",
+				CreatorAddress: example.CreatorAddr.String(),
+				Salt:           hex.EncodeToString(salt),
+			},
+			expErr: true,
+		},
+		"invalid creator address": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(example.Checksum),
+				CreatorAddress: "invalid",
+				Salt:           hex.EncodeToString(salt),
+			},
+			expErr: true,
+		},
+		"empty salt": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(example.Checksum),
+				CreatorAddress: example.CreatorAddr.String(),
+			},
+			expErr: true,
+		},
+		"invalid init args": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(example.Checksum),
+				CreatorAddress: example.CreatorAddr.String(),
+				Salt:           hex.EncodeToString(salt),
+				InitArgs:       []byte("invalid json"),
+			},
+			expErr: true,
+		},
+	}
+	q := Querier(keepers.WasmKeeper)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.BuildAddress(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got.Address)
+		})
+	}
+}
+
+func TestQueryCodeByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	// store a duplicate to ensure the lowest code id is returned
+	StoreHackatomExampleContract(t, ctx, keepers)
+
+	specs := map[string]struct {
+		src    *types.QueryCodeByChecksumRequest
+		expErr error
+	}{
+		"found": {
+			src: &types.QueryCodeByChecksumRequest{Checksum: hex.EncodeToString(example.Checksum)},
+		},
+		"not found": {
+			src:    &types.QueryCodeByChecksumRequest{Checksum: hex.EncodeToString(make([]byte, 32))},
+			expErr: types.ErrNotFound,
+		},
+		"invalid checksum": {
+			src:    &types.QueryCodeByChecksumRequest{Checksum: "invalid"},
+			expErr: sdkErrors.ErrInvalidRequest,
+		},
+		"invalid checksum length": {
+			src:    &types.QueryCodeByChecksumRequest{Checksum: "0123"},
+			expErr: sdkErrors.ErrInvalidRequest,
+		},
+	}
+	q := Querier(keepers.WasmKeeper)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := q.CodeByChecksum(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, example.CodeID, got.CodeID)
+			assert.Equal(t, example.CreatorAddr.String(), got.Creator)
+			assert.Equal(t, example.Checksum, []byte(got.DataHash))
+		})
+	}
+}
+
+func TestQueryContractsByCreatorList(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 500))
+	creator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+	otherCreator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+	anyAddr := keepers.Faucet.NewFundedAccount(ctx, topUp...)
+
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    anyAddr,
+		Beneficiary: bob,
+	}
+	initMsgBz, err := json.Marshal(initMsg)
+	require.NoError(t, err)
+
+	// manage some realistic block settings
+	var h int64 = 10
+	setBlock := func(ctx sdk.Context, height int64) sdk.Context {
+		ctx = ctx.WithBlockHeight(height)
+		meter := sdk.NewGasMeter(1000000)
+		ctx = ctx.WithGasMeter(meter)
+		ctx = ctx.WithBlockGasMeter(meter)
+		return ctx
+	}
+
+	var allExpecedContracts []string
+	// create 10 contracts with real block/gas setup
+	for i := 0; i < 10; i++ {
+		ctx = setBlock(ctx, h)
+		h++
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, fmt.Sprintf("contract %d", i), topUp)
+		allExpecedContracts = append(allExpecedContracts, contract.String())
+		require.NoError(t, err)
+	}
+	// contracts of another creator must not be listed
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, otherCreator, nil, initMsgBz, "other", topUp)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcQuery        *types.QueryContractsByCreatorRequest
+		expContractAddr []string
+		expErr          error
+	}{
+		"query all": {
+			srcQuery: &types.QueryContractsByCreatorRequest{
+				CreatorAddress: creator.String(),
+			},
+			expContractAddr: allExpecedContracts,
+			expErr:          nil,
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractsByCreatorRequest{
+				CreatorAddress: creator.String(),
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expContractAddr: allExpecedContracts[1:],
+			expErr:          nil,
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryContractsByCreatorRequest{
+				CreatorAddress: creator.String(),
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expContractAddr: allExpecedContracts[0:1],
+			expErr:          nil,
+		},
+		"nil creator": {
+			srcQuery: &types.QueryContractsByCreatorRequest{
+				Pagination: &query.PageRequest{},
+			},
+			expContractAddr: allExpecedContracts,
+			expErr:          errors.New("empty address string is not allowed"),
+		},
+		"nil req": {
+			srcQuery:        nil,
+			expContractAddr: allExpecedContracts,
+			expErr:          status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), spec.srcQuery)
+
+			if spec.expErr != nil {
+				require.Equal(t, spec.expErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			assert.Equal(t, spec.expContractAddr, got.ContractAddresses)
+		})
+	}
+}
+
+func TestQueryContractByLabel(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 500))
+	creator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+	anyAddr := keepers.Faucet.NewFundedAccount(ctx, topUp...)
+
+	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsg := HackatomExampleInitMsg{
+		Verifier:    anyAddr,
+		Beneficiary: bob,
+	}
+	initMsgBz, err := json.Marshal(initMsg)
+	require.NoError(t, err)
+
+	// instantiate in reverse order to ensure results are sorted by label
+	contracts := make(map[string]string)
+	for _, label := range []string{"other", "app-c", "app-b", "app-a", "app"} {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, initMsgBz, label, topUp)
+		require.NoError(t, err)
+		contracts[label] = contract.String()
+	}
+
+	specs := map[string]struct {
+		srcQuery  *types.QueryContractByLabelRequest
+		expLabels []string
+		expErr    error
+	}{
+		"query by prefix": {
+			srcQuery:  &types.QueryContractByLabelRequest{Label: "app-"},
+			expLabels: []string{"app-a", "app-b", "app-c"},
+		},
+		"query by exact label": {
+			srcQuery:  &types.QueryContractByLabelRequest{Label: "other"},
+			expLabels: []string{"other"},
+		},
+		"query all": {
+			srcQuery:  &types.QueryContractByLabelRequest{},
+			expLabels: []string{"app", "app-a", "app-b", "app-c", "other"},
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractByLabelRequest{
+				Label: "app-",
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expLabels: []string{"app-b", "app-c"},
+		},
+		"with pagination limit": {
+			srcQuery: &types.QueryContractByLabelRequest{
+				Label: "app-",
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			expLabels: []string{"app-a"},
+		},
+		"unknown label": {
+			srcQuery:  &types.QueryContractByLabelRequest{Label: "unknown"},
+			expLabels: []string{},
+		},
+		"nil req": {
+			srcQuery: nil,
+			expErr:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractByLabel(sdk.WrapSDKContext(ctx), spec.srcQuery)
+
+			if spec.expErr != nil {
+				require.Equal(t, spec.expErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, got)
+			require.Len(t, got.Contracts, len(spec.expLabels))
+			for i, label := range spec.expLabels {
+				assert.Equal(t, contracts[label], got.Contracts[i].Address)
+				assert.Equal(t, label, got.Contracts[i].Label)
+				assert.Equal(t, creator.String(), got.Contracts[i].Creator)
+				assert.Nil(t, got.Contracts[i].Created)
+			}
+		})
+	}
 }
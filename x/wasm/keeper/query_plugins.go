@@ -3,8 +3,10 @@ package keeper
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	baseapp "github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	distributiontypes "github.com/line/lbm-sdk/x/distribution/types"
@@ -86,8 +88,14 @@ type contractMetaDataSource interface {
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
 }
 
+type acceptedStargateQuerySource interface {
+	GetAcceptedStargateQuery(ctx sdk.Context, path string) *types.AcceptedStargateQuery
+	GetParams(ctx sdk.Context) types.Params
+}
+
 type wasmQueryKeeper interface {
 	contractMetaDataSource
+	acceptedStargateQuerySource
 	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
@@ -99,6 +107,7 @@ func DefaultQueryPlugins(
 	distKeeper types.DistributionKeeper,
 	channelKeeper types.ChannelKeeper,
 	queryRouter GRPCQueryRouter,
+	cdc codec.Codec,
 	wasm wasmQueryKeeper,
 ) QueryPlugins {
 	return QueryPlugins{
@@ -106,7 +115,7 @@ func DefaultQueryPlugins(
 		Custom:   CustomQuerierImpl(queryRouter),
 		IBC:      IBCQuerier(wasm, channelKeeper),
 		Staking:  StakingQuerier(staking, distKeeper),
-		Stargate: StargateQuerier(queryRouter),
+		Stargate: StargateQuerier(queryRouter, cdc, wasm),
 		Wasm:     WasmQuerier(wasm),
 	}
 }
@@ -286,9 +295,48 @@ func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper)
 	}
 }
 
-func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+// StargateQuerier serves the stargate queries of the paths that were accepted by governance. The response of the
+// query router is decoded into the accepted response type and encoded again, so that the result is deterministic.
+// Gas is charged per byte of the request and the response.
+func StargateQuerier(queryRouter GRPCQueryRouter, cdc codec.Codec, wasm acceptedStargateQuerySource) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, msg *wasmvmtypes.StargateQuery) ([]byte, error) {
-		return nil, wasmvmtypes.UnsupportedRequest{Kind: "Stargate queries are disabled."}
+		accepted := wasm.GetAcceptedStargateQuery(ctx, msg.Path)
+		if accepted == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("Stargate queries are disabled for path %s", msg.Path)}
+		}
+		route := queryRouter.Route(msg.Path)
+		if route == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", msg.Path)}
+		}
+		gasPerByte := wasm.GetParams(ctx).StargateQueryGasPerByte
+		ctx.GasMeter().ConsumeGas(gasPerByte*uint64(len(msg.Data)), "stargate query request")
+
+		res, err := route(ctx, abci.RequestQuery{
+			Data: msg.Data,
+			Path: msg.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+		response, err := newStargateQueryResponse(accepted.ResponseType)
+		if err != nil {
+			return nil, err
+		}
+		if err := cdc.Unmarshal(res.Value, response); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+		}
+		var bz []byte
+		switch accepted.Encoding {
+		case types.StargateQueryEncodingJSON:
+			bz, err = cdc.MarshalJSON(response)
+		default:
+			bz, err = cdc.Marshal(response)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ctx.GasMeter().ConsumeGas(gasPerByte*uint64(len(bz)), "stargate query response")
+		return bz, nil
 	}
 }
 
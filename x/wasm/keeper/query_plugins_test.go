@@ -478,6 +478,9 @@ type mockWasmQueryKeeper struct {
 	QueryRawFn        func(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	QuerySmartFn      func(ctx sdk.Context, contractAddr sdk.AccAddress, req types.RawContractMessage) ([]byte, error)
 	IsPinnedCodeFn    func(ctx sdk.Context, codeID uint64) bool
+
+	GetAcceptedStargateQueryFn func(ctx sdk.Context, path string) *types.AcceptedStargateQuery
+	GetParamsFn                func(ctx sdk.Context) types.Params
 }
 
 func (m mockWasmQueryKeeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
@@ -508,6 +511,20 @@ func (m mockWasmQueryKeeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
 	return m.IsPinnedCodeFn(ctx, codeID)
 }
 
+func (m mockWasmQueryKeeper) GetAcceptedStargateQuery(ctx sdk.Context, path string) *types.AcceptedStargateQuery {
+	if m.GetAcceptedStargateQueryFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetAcceptedStargateQueryFn(ctx, path)
+}
+
+func (m mockWasmQueryKeeper) GetParams(ctx sdk.Context) types.Params {
+	if m.GetParamsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetParamsFn(ctx)
+}
+
 type bankKeeperMock struct {
 	GetBalanceFn     func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalancesFn func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
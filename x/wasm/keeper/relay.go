@@ -26,20 +26,26 @@ func (k Keeper) OnOpenChannel(
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
 	version := ""
 
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	_, codeInfo, _, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return "", err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointIBCChannel); err != nil {
+		return "", err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddr, contractAddr)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
-		return "", sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return "", wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, contractAddr, counter); err != nil {
+		return "", err
 	}
 
 	if res != nil {
@@ -62,21 +68,27 @@ func (k Keeper) OnConnectChannel(
 	msg wasmvmtypes.IBCChannelConnectMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointIBCChannel); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddr, contractAddr)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
-		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, contractAddr, counter); err != nil {
+		return err
 	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
@@ -95,21 +107,27 @@ func (k Keeper) OnCloseChannel(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointIBCChannel); err != nil {
+		return err
+	}
 
 	params := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddr, contractAddr)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
-		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, contractAddr, counter); err != nil {
+		return err
 	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
@@ -127,25 +145,31 @@ func (k Keeper) OnRecvPacket(
 	msg wasmvmtypes.IBCPacketReceiveMsg,
 ) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointIBCPacket); err != nil {
+		return nil, err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddr, contractAddr)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
-		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return nil, wasmVMError(counter, execErr, types.ErrExecuteFailed)
 	}
 	if res.Err != "" { // handle error case as before https://github.com/CosmWasm/wasmvm/commit/c300106fe5c9426a495f8e10821e00a9330c56c6
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, res.Err)
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, contractAddr, counter); err != nil {
+		return nil, err
+	}
 	// note submessage reply results can overwrite the `Acknowledgement` data
 	return k.handleContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Acknowledgement, res.Ok.Events)
 }
@@ -163,21 +187,27 @@ func (k Keeper) OnAckPacket(
 	msg wasmvmtypes.IBCPacketAckMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointIBCPacket); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddr, contractAddr)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
-		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, contractAddr, counter); err != nil {
+		return err
 	}
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -192,21 +222,27 @@ func (k Keeper) OnTimeoutPacket(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, _, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
+	if err := k.assertContractActive(ctx, contractAddr, types.EntryPointIBCPacket); err != nil {
+		return err
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
 	gas := k.runtimeGasForContract(ctx)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore, counter := k.contractStore(ctx, contractAddr, contractAddr)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 
 	if execErr != nil {
-		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
+		return wasmVMError(counter, execErr, types.ErrExecuteFailed)
+	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, contractAddr, counter); err != nil {
+		return err
 	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// scheduledCallbackSudoMsg is the sudo msg that the end blocker passes to a contract for a scheduled callback
+type scheduledCallbackSudoMsg struct {
+	ScheduledCallback scheduledCallbackMsg `json:"scheduled_callback"`
+}
+
+type scheduledCallbackMsg struct {
+	ScheduleID uint64          `json:"schedule_id"`
+	Msg        json.RawMessage `json:"msg"`
+}
+
+// scheduleCallback registers a callback of the contract that the end blocker executes at the execute height and
+// repeats in the interval. The gas deposit is held in escrow of the module account and must cover at least one
+// execution with the gas limit. A contract can only register a limited number of callbacks.
+func (k Keeper) scheduleCallback(
+	ctx sdk.Context,
+	contractAddress sdk.AccAddress,
+	executeHeight int64,
+	interval uint64,
+	msg types.RawContractMessage,
+	gasLimit uint64,
+	gasDeposit sdk.Coins,
+) (uint64, error) {
+	if k.GetContractInfo(ctx, contractAddress) == nil {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if executeHeight == 0 {
+		if interval == 0 {
+			return 0, sdkerrors.Wrap(types.ErrEmpty, "execute height or interval")
+		}
+		executeHeight = ctx.BlockHeight() + int64(interval)
+	}
+	if executeHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "execute height must be greater than %d", ctx.BlockHeight())
+	}
+	var count uint32
+	k.IterateSchedules(ctx, contractAddress, func(types.Schedule) bool {
+		count++
+		return false
+	})
+	if count >= k.maxSchedulesPerContract {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "max %d schedules per contract", k.maxSchedulesPerContract)
+	}
+	if gasLimit > k.scheduleBlockGasLimit {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "gas limit must not exceed %d", k.scheduleBlockGasLimit)
+	}
+	if fee := k.scheduleFee(gasLimit); !gasDeposit.IsAllGTE(fee) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "gas deposit must cover an execution fee of %s", fee)
+	}
+	if !gasDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddress, types.ModuleName, gasDeposit); err != nil {
+			return 0, sdkerrors.Wrap(err, "gas deposit")
+		}
+	}
+
+	schedule := types.Schedule{
+		ID:         k.autoIncrementID(ctx, types.KeyLastScheduleID),
+		Contract:   contractAddress.String(),
+		NextHeight: executeHeight,
+		Interval:   interval,
+		Msg:        msg,
+		GasLimit:   gasLimit,
+		GasDeposit: gasDeposit,
+	}
+	k.storeSchedule(ctx, contractAddress, schedule)
+	return schedule.ID, nil
+}
+
+// cancelSchedule drops a schedule of the contract and refunds its gas deposit to the contract. The schedule is
+// canceled by the contract itself or its admin.
+func (k Keeper) cancelSchedule(ctx sdk.Context, contractAddress, caller sdk.AccAddress, scheduleID uint64, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !caller.Equals(contractAddress) && !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	schedule := k.GetSchedule(ctx, contractAddress, scheduleID)
+	if schedule == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "schedule")
+	}
+	return k.removeSchedule(ctx, contractAddress, *schedule)
+}
+
+// GetSchedule returns a schedule of a contract or nil when it does not exist.
+func (k Keeper) GetSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, scheduleID uint64) *types.Schedule {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetScheduleKey(contractAddress, scheduleID))
+	if bz == nil {
+		return nil
+	}
+	var schedule types.Schedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return &schedule
+}
+
+// IterateSchedules iterates over the schedules of a contract ordered by schedule id. The callback returns true to
+// stop the iteration.
+func (k Keeper) IterateSchedules(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(types.Schedule) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSchedulePrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+		if cb(schedule) {
+			return
+		}
+	}
+}
+
+// storeSchedule stores the schedule and adds it to the execution queue at its next height
+func (k Keeper) storeSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduleKey(contractAddress, schedule.ID), k.cdc.MustMarshal(&schedule))
+	store.Set(types.GetScheduleQueueKey(schedule.NextHeight, schedule.ID), contractAddress)
+}
+
+// removeSchedule deletes the schedule with its queue entry and refunds the remaining gas deposit to the contract
+func (k Keeper) removeSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, schedule types.Schedule) error {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduleQueueKey(schedule.NextHeight, schedule.ID))
+	store.Delete(types.GetScheduleKey(contractAddress, schedule.ID))
+	if !schedule.GasDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, schedule.GasDeposit); err != nil {
+			return sdkerrors.Wrap(err, "refund gas deposit")
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(&lbmtypes.EventScheduleRemoved{
+		Contract:   schedule.Contract,
+		ScheduleId: schedule.ID,
+		Refund:     schedule.GasDeposit,
+	})
+}
+
+// deleteSchedules removes all schedules of a contract and refunds their gas deposits to the contract
+func (k Keeper) deleteSchedules(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	var schedules []types.Schedule
+	k.IterateSchedules(ctx, contractAddress, func(schedule types.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	for _, schedule := range schedules {
+		if err := k.removeSchedule(ctx, contractAddress, schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleFee returns the fee for the given gas of scheduled callbacks. Fractions are rounded up.
+func (k Keeper) scheduleFee(gas sdk.Gas) sdk.Coins {
+	var fee sdk.Coins
+	for _, c := range k.scheduleGasPrice {
+		amount := c.Amount.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+		fee = fee.Add(sdk.NewCoin(c.Denom, amount))
+	}
+	return fee
+}
+
+// ExecuteSchedules executes the scheduled callbacks with a next height lower than or equal to the current block
+// height in the order of their height and id until the schedule block gas limit is reached. Callbacks that do not fit
+// into the remaining gas stay in the queue for the following blocks, schedules with a gas limit above the whole
+// schedule block gas limit can never run and are removed. The fee for the consumed gas is paid from the gas deposit,
+// schedules without a deposit for another execution are removed.
+func (k Keeper) ExecuteSchedules(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	gasLeft := k.scheduleBlockGasLimit
+	for {
+		queueKey, contractAddress, ok := k.nextDueSchedule(ctx)
+		if !ok {
+			return nil
+		}
+		schedule := k.GetSchedule(ctx, contractAddress, sdk.BigEndianToUint64(queueKey[len(queueKey)-8:]))
+		if schedule == nil {
+			store.Delete(queueKey)
+			continue
+		}
+		if schedule.GasLimit > k.scheduleBlockGasLimit {
+			store.Delete(queueKey)
+			if err := k.removeSchedule(ctx, contractAddress, *schedule); err != nil {
+				return err
+			}
+			continue
+		}
+		if schedule.GasLimit > gasLeft {
+			return nil
+		}
+		// the queue entry is dropped before anything else so that the loop always makes progress
+		store.Delete(queueKey)
+		maxFee := k.scheduleFee(schedule.GasLimit)
+		if !schedule.GasDeposit.IsAllGTE(maxFee) {
+			if err := k.removeSchedule(ctx, contractAddress, *schedule); err != nil {
+				return err
+			}
+			continue
+		}
+		// the max fee is taken from the deposit upfront so that the contract can cancel the schedule in the callback
+		schedule.GasDeposit = schedule.GasDeposit.Sub(maxFee)
+		store.Set(types.GetScheduleKey(contractAddress, schedule.ID), k.cdc.MustMarshal(schedule))
+
+		gasUsed, err := k.executeSchedule(ctx, contractAddress, *schedule)
+		gasLeft -= gasUsed
+		event := lbmtypes.EventScheduledCallbackExecuted{
+			Contract:   schedule.Contract,
+			ScheduleId: schedule.ID,
+			GasUsed:    gasUsed,
+		}
+		if err != nil {
+			event.Error = err.Error()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			return err
+		}
+
+		fee := k.scheduleFee(gasUsed)
+		if !fee.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fee); err != nil {
+				return sdkerrors.Wrap(err, "schedule fee")
+			}
+		}
+		unused := maxFee.Sub(fee)
+		current := k.GetSchedule(ctx, contractAddress, schedule.ID)
+		if current == nil {
+			// canceled by the callback
+			if !unused.IsZero() {
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, unused); err != nil {
+					return sdkerrors.Wrap(err, "refund gas deposit")
+				}
+			}
+			continue
+		}
+		current.GasDeposit = current.GasDeposit.Add(unused...)
+		if current.Interval == 0 {
+			if err := k.removeSchedule(ctx, contractAddress, *current); err != nil {
+				return err
+			}
+			continue
+		}
+		current.NextHeight = ctx.BlockHeight() + int64(current.Interval)
+		k.storeSchedule(ctx, contractAddress, *current)
+	}
+}
+
+// nextDueSchedule returns the first entry of the execution queue when it is due at the current block height. Only
+// one entry is read at a time so that the due callbacks beyond the block gas limit are never loaded.
+func (k Keeper) nextDueSchedule(ctx sdk.Context) (queueKey []byte, contractAddress sdk.AccAddress, ok bool) {
+	end := types.GetScheduleQueueKey(ctx.BlockHeight()+1, 0)
+	iter := ctx.KVStore(k.storeKey).Iterator(types.ScheduleQueuePrefix, end)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil, false
+	}
+	return iter.Key(), iter.Value(), true
+}
+
+// executeSchedule calls the sudo entry point of the contract with the gas limit of the schedule in a cached context
+// that is only committed on success. Any panic of the call, out of gas included, is turned into an error so that it
+// never halts the chain. It returns the consumed gas.
+func (k Keeper) executeSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, schedule types.Schedule) (gasUsed sdk.Gas, err error) {
+	msg, err := json.Marshal(scheduledCallbackSudoMsg{
+		ScheduledCallback: scheduledCallbackMsg{ScheduleID: schedule.ID, Msg: json.RawMessage(schedule.Msg)},
+	})
+	if err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	cacheCtx, commit := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(schedule.GasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	// catch all panics and drop the state changes of the callback
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "scheduled callback hit gas limit")
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "scheduled callback panicked: %v", r)
+			}
+		}
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}()
+	if _, err := k.Sudo(cacheCtx, contractAddress, msg); err != nil {
+		return 0, err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return 0, nil
+}
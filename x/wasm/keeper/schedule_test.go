@@ -0,0 +1,400 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	"github.com/line/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestScheduleCallback(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+	specs := map[string]struct {
+		executeHeight int64
+		interval      uint64
+		gasLimit      uint64
+		gasDeposit    sdk.Coins
+		notAContract  bool
+		expErr        *sdkerrors.Error
+		expNextHeight int64
+	}{
+		"execute height": {
+			executeHeight: 105,
+			gasLimit:      100_000,
+			gasDeposit:    deposit,
+			expNextHeight: 105,
+		},
+		"interval from now": {
+			interval:      10,
+			gasLimit:      100_000,
+			gasDeposit:    deposit,
+			expNextHeight: 110,
+		},
+		"execute height in the past": {
+			executeHeight: 100,
+			gasLimit:      100_000,
+			gasDeposit:    deposit,
+			expErr:        types.ErrInvalid,
+		},
+		"neither execute height nor interval": {
+			gasLimit:   100_000,
+			gasDeposit: deposit,
+			expErr:     types.ErrEmpty,
+		},
+		"gas limit exceeds block limit": {
+			interval:   10,
+			gasLimit:   defaultScheduleBlockGasLimit + 1,
+			gasDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 100_000)),
+			expErr:     types.ErrLimit,
+		},
+		"gas deposit does not cover an execution": {
+			interval:   10,
+			gasLimit:   2_000_000,
+			gasDeposit: deposit,
+			expErr:     sdkerrors.ErrInsufficientFunds,
+		},
+		"gas deposit exceeds balance": {
+			interval:   10,
+			gasLimit:   100_000,
+			gasDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_000)),
+			expErr:     sdkerrors.ErrInsufficientFunds,
+		},
+		"not a contract": {
+			interval:     10,
+			gasLimit:     100_000,
+			gasDeposit:   deposit,
+			notAContract: true,
+			expErr:       types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleGasPrice(gasPrice))
+			ctx = ctx.WithBlockHeight(100)
+			mock := &wasmtesting.MockWasmer{}
+			wasmtesting.MakeInstantiable(mock)
+			example := SeedNewContractInstance(t, ctx, keepers, mock)
+			keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 10_000))
+			contractAddr := example.Contract
+			if spec.notAContract {
+				contractAddr = RandomAccountAddress(t)
+			}
+
+			// when
+			scheduleID, gotErr := keepers.ContractKeeper.ScheduleCallback(ctx, contractAddr, spec.executeHeight, spec.interval, []byte(`{}`), spec.gasLimit, spec.gasDeposit)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			schedule := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, scheduleID)
+			require.NotNil(t, schedule)
+			assert.Equal(t, spec.expNextHeight, schedule.NextHeight)
+			assert.Equal(t, spec.gasDeposit, schedule.GasDeposit)
+			// held in escrow of the module account
+			assert.Equal(t, spec.gasDeposit, keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)))
+			// and returned by the query
+			res, err := Querier(keepers.WasmKeeper).Schedules(sdk.WrapSDKContext(ctx), &lbmtypes.QuerySchedulesRequest{Address: example.Contract.String()})
+			require.NoError(t, err)
+			assert.Equal(t, []types.Schedule{*schedule}, res.Schedules)
+		})
+	}
+}
+
+func TestExecuteSchedules(t *testing.T) {
+	gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleGasPrice(gasPrice))
+	ctx = ctx.WithBlockHeight(100)
+	var sudoMsgs []string
+	var sudoErr error
+	mock := &wasmtesting.MockWasmer{SudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		sudoMsgs = append(sudoMsgs, string(sudoMsg))
+		return &wasmvmtypes.Response{}, 0, sudoErr
+	}}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	initialBalance := sdk.NewCoins(sdk.NewInt64Coin("denom", 10_000))
+	keepers.Faucet.Fund(ctx, example.Contract, initialBalance...)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+
+	onceID, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 102, 0, []byte(`{"foo":"bar"}`), 100_000, deposit)
+	require.NoError(t, err)
+	recurringID, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 0, 2, []byte(`{"bar":"baz"}`), 100_000, deposit)
+	require.NoError(t, err)
+
+	// nothing is due at the next height
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(101)))
+	assert.Empty(t, sudoMsgs)
+
+	// when both schedules are due
+	ctx = ctx.WithBlockHeight(102)
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx))
+
+	// then
+	assert.Equal(t, []string{
+		fmt.Sprintf(`{"scheduled_callback":{"schedule_id":%d,"msg":{"foo":"bar"}}}`, onceID),
+		fmt.Sprintf(`{"scheduled_callback":{"schedule_id":%d,"msg":{"bar":"baz"}}}`, recurringID),
+	}, sudoMsgs)
+	// the executed once schedule is removed and its remaining deposit refunded
+	assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, onceID))
+	recurring := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, recurringID)
+	require.NotNil(t, recurring)
+	assert.Equal(t, int64(104), recurring.NextHeight)
+	fees := keepers.BankKeeper.GetAllBalances(ctx, feeCollector)
+	require.False(t, fees.IsZero())
+	assert.Equal(t, recurring.GasDeposit, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	assert.Equal(t, initialBalance.Sub(fees).Sub(recurring.GasDeposit), keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+
+	// a failed execution is charged as well and the schedule is kept
+	sudoErr = types.ErrInvalid
+	ctx = ctx.WithBlockHeight(104)
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx))
+	assert.Len(t, sudoMsgs, 3)
+	recurring = keepers.WasmKeeper.GetSchedule(ctx, example.Contract, recurringID)
+	require.NotNil(t, recurring)
+	assert.Equal(t, int64(106), recurring.NextHeight)
+	assert.True(t, fees.IsAllLT(keepers.BankKeeper.GetAllBalances(ctx, feeCollector)))
+
+	// and the deposit is refunded on cancel by the admin
+	require.NoError(t, keepers.ContractKeeper.CancelSchedule(ctx, example.Contract, example.CreatorAddr, recurringID))
+	assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, recurringID))
+	assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	assert.Equal(t, initialBalance, keepers.BankKeeper.GetAllBalances(ctx, example.Contract).Add(keepers.BankKeeper.GetAllBalances(ctx, feeCollector)...))
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(106)))
+	assert.Len(t, sudoMsgs, 3)
+}
+
+func TestExecuteSchedulesBlockGasLimit(t *testing.T) {
+	gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleBlockGasLimit(1_000_000), WithScheduleGasPrice(gasPrice))
+	ctx = ctx.WithBlockHeight(100)
+	var executed []uint64
+	mock := &wasmtesting.MockWasmer{SudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var msg scheduledCallbackSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		executed = append(executed, msg.ScheduledCallback.ScheduleID)
+		return &wasmvmtypes.Response{}, 0, nil
+	}}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 10_000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+	first, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 101, 0, []byte(`{}`), 1_000_000, deposit)
+	require.NoError(t, err)
+	second, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 101, 0, []byte(`{}`), 1_000_000, deposit)
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(101)))
+
+	// then the second callback does not fit into the remaining gas
+	assert.Equal(t, []uint64{first}, executed)
+	require.NotNil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, second))
+
+	// and is executed in the following block
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx.WithBlockHeight(102)))
+	assert.Equal(t, []uint64{first, second}, executed)
+	assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, second))
+}
+
+func TestExecuteSchedulesRemovesOversizedSchedule(t *testing.T) {
+	gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleBlockGasLimit(1_000_000), WithScheduleGasPrice(gasPrice))
+	ctx = ctx.WithBlockHeight(100)
+	var executed []uint64
+	mock := &wasmtesting.MockWasmer{SudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var msg scheduledCallbackSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		executed = append(executed, msg.ScheduledCallback.ScheduleID)
+		return &wasmvmtypes.Response{}, 0, nil
+	}}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	initialBalance := sdk.NewCoins(sdk.NewInt64Coin("denom", 10_000))
+	keepers.Faucet.Fund(ctx, example.Contract, initialBalance...)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+	oversized, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 101, 0, []byte(`{}`), 1_000_000, deposit)
+	require.NoError(t, err)
+	other, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 101, 0, []byte(`{}`), 100_000, deposit)
+	require.NoError(t, err)
+	// the schedule block gas limit is lowered after the schedules were registered
+	k := *keepers.WasmKeeper
+	k.scheduleBlockGasLimit = 500_000
+
+	// when
+	require.NoError(t, k.ExecuteSchedules(ctx.WithBlockHeight(101)))
+
+	// then the schedule that can never run is removed without execution
+	assert.Equal(t, []uint64{other}, executed)
+	assert.Nil(t, k.GetSchedule(ctx, example.Contract, oversized))
+	assert.Nil(t, k.GetSchedule(ctx, example.Contract, other))
+	// and the deposits are refunded except for the fee of the executed callback
+	fees := keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	assert.Equal(t, initialBalance.Sub(fees), keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+	assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestExecuteSchedulesRecoversPanic(t *testing.T) {
+	gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleGasPrice(gasPrice))
+	ctx = ctx.WithBlockHeight(100)
+	mock := &wasmtesting.MockWasmer{SudoFn: func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		panic("testing")
+	}}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 10_000))
+	scheduleID, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 0, 2, []byte(`{}`), 100_000, sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)))
+	require.NoError(t, err)
+
+	// when
+	ctx = ctx.WithBlockHeight(102).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() {
+		require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx))
+	})
+
+	// then the failed execution is charged and the schedule is kept
+	schedule := keepers.WasmKeeper.GetSchedule(ctx, example.Contract, scheduleID)
+	require.NotNil(t, schedule)
+	assert.Equal(t, int64(104), schedule.NextHeight)
+	assert.False(t, keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName)).IsZero())
+	// and the panic is reported in the event
+	var executed *lbmtypes.EventScheduledCallbackExecuted
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if e.Type != proto.MessageName(&lbmtypes.EventScheduledCallbackExecuted{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(e)
+		require.NoError(t, err)
+		executed = msg.(*lbmtypes.EventScheduledCallbackExecuted)
+	}
+	require.NotNil(t, executed)
+	assert.Contains(t, executed.Error, "panicked: testing")
+}
+
+func TestScheduleCallbackMaxPerContract(t *testing.T) {
+	gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleGasPrice(gasPrice), WithMaxSchedulesPerContract(2))
+	ctx = ctx.WithBlockHeight(100)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 10_000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	for i := 0; i < 2; i++ {
+		_, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 0, 10, []byte(`{}`), 100_000, deposit)
+		require.NoError(t, err)
+	}
+
+	// when
+	_, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 0, 10, []byte(`{}`), 100_000, deposit)
+
+	// then
+	assert.True(t, types.ErrLimit.Is(err), err)
+
+	// and other contracts are not affected
+	other := SeedNewContractInstance(t, ctx, keepers, mock)
+	keepers.Faucet.Fund(ctx, other.Contract, sdk.NewInt64Coin("denom", 1000))
+	_, err = keepers.ContractKeeper.ScheduleCallback(ctx, other.Contract, 0, 10, []byte(`{}`), 100_000, deposit)
+	require.NoError(t, err)
+}
+
+func TestCancelScheduleInCallback(t *testing.T) {
+	gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleGasPrice(gasPrice))
+	ctx = ctx.WithBlockHeight(100)
+	mock := &wasmtesting.MockWasmer{}
+	wasmtesting.MakeInstantiable(mock)
+	example := SeedNewContractInstance(t, ctx, keepers, mock)
+	initialBalance := sdk.NewCoins(sdk.NewInt64Coin("denom", 10_000))
+	keepers.Faucet.Fund(ctx, example.Contract, initialBalance...)
+	mock.SudoFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var msg scheduledCallbackSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		cancel, err := proto.Marshal(&lbmtypes.MsgCancelSchedule{
+			Sender:     example.Contract.String(),
+			Contract:   example.Contract.String(),
+			ScheduleID: msg.ScheduledCallback.ScheduleID,
+		})
+		require.NoError(t, err)
+		return &wasmvmtypes.Response{
+			Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{
+				Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/lbm.wasm.v1.MsgCancelSchedule", Value: cancel},
+			}}},
+		}, 0, nil
+	}
+	scheduleID, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 0, 1, []byte(`{}`), 1_000_000, sdk.NewCoins(sdk.NewInt64Coin("denom", 5000)))
+	require.NoError(t, err)
+
+	// when
+	ctx = ctx.WithBlockHeight(101)
+	require.NoError(t, keepers.WasmKeeper.ExecuteSchedules(ctx))
+
+	// then
+	assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, scheduleID))
+	fees := keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	require.False(t, fees.IsZero())
+	assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+	assert.Equal(t, initialBalance.Sub(fees), keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+}
+
+func TestCancelScheduleAuthorization(t *testing.T) {
+	specs := map[string]struct {
+		caller func(example ExampleContractInstance) sdk.AccAddress
+		expErr *sdkerrors.Error
+	}{
+		"contract itself": {
+			caller: func(example ExampleContractInstance) sdk.AccAddress { return example.Contract },
+		},
+		"admin": {
+			caller: func(example ExampleContractInstance) sdk.AccAddress { return example.CreatorAddr },
+		},
+		"other": {
+			caller: func(example ExampleContractInstance) sdk.AccAddress { return RandomAccountAddress(t) },
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(1, 3)))
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithScheduleGasPrice(gasPrice))
+			mock := &wasmtesting.MockWasmer{}
+			wasmtesting.MakeInstantiable(mock)
+			example := SeedNewContractInstance(t, ctx, keepers, mock)
+			keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 1000))
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+			scheduleID, err := keepers.ContractKeeper.ScheduleCallback(ctx, example.Contract, 0, 10, []byte(`{}`), 100_000, deposit)
+			require.NoError(t, err)
+
+			// when
+			gotErr := keepers.ContractKeeper.CancelSchedule(ctx, example.Contract, spec.caller(example), scheduleID)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.NotNil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, scheduleID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, example.Contract, scheduleID))
+		})
+	}
+}
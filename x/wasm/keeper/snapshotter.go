@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
 
@@ -56,6 +57,7 @@ func (ws *WasmSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) e
 	seenBefore := make(map[string]bool)
 	var rerr error
 
+	// removed codes have no code info anymore so that their wasm code is not exported
 	ws.wasm.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
 		// Many code ids may point to the same code hash... only sync it once
 		hexHash := hex.EncodeToString(info.CodeHash)
@@ -100,12 +102,16 @@ func (ws *WasmSnapshotter) Restore(
 }
 
 func restoreV1(ctx sdk.Context, k *Keeper, compressedCode []byte) error {
-	wasmCode, err := ioutils.Uncompress(compressedCode, uint64(types.MaxWasmSize))
+	wasmCode, err := ioutils.Uncompress(compressedCode, types.MaxImportedWasmSize)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
 
-	// FIXME: check which codeIDs the checksum matches??
+	// skip codes that are not used by any code id anymore, like the removed ones in snapshots of older nodes
+	checksum := sha256.Sum256(wasmCode)
+	if _, ok := k.GetCodeIDByChecksum(ctx, checksum[:]); !ok {
+		return nil
+	}
 	_, err = k.wasmVM.Create(wasmCode)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
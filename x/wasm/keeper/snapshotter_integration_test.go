@@ -24,7 +24,8 @@ import (
 
 func TestSnapshotter(t *testing.T) {
 	specs := map[string]struct {
-		wasmFiles []string
+		wasmFiles     []string
+		removeCodeIDs []uint64
 	}{
 		"single contract": {
 			wasmFiles: []string{"./testdata/reflect.wasm"},
@@ -35,6 +36,10 @@ func TestSnapshotter(t *testing.T) {
 		"duplicate contracts": {
 			wasmFiles: []string{"./testdata/reflect.wasm", "./testdata/reflect.wasm"},
 		},
+		"removed contract": {
+			wasmFiles:     []string{"./testdata/reflect.wasm", "./testdata/burner.wasm"},
+			removeCodeIDs: []uint64{2},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -60,6 +65,10 @@ func TestSnapshotter(t *testing.T) {
 				hash := sha256.Sum256(wasmCode)
 				srcCodeIDToChecksum[codeID] = hash[:]
 			}
+			for _, codeID := range spec.removeCodeIDs {
+				require.NoError(t, contractKeeper.RemoveCode(ctx, codeID))
+				delete(srcCodeIDToChecksum, codeID)
+			}
 			// create snapshot
 			srcWasmApp.Commit()
 			snapshotHeight := uint64(srcWasmApp.LastBlockHeight())
@@ -98,6 +107,9 @@ func TestSnapshotter(t *testing.T) {
 				return false
 			})
 			assert.Equal(t, srcCodeIDToChecksum, destCodeIDToChecksum)
+			for _, codeID := range spec.removeCodeIDs {
+				assert.NotNil(t, wasmKeeper.GetRemovedCodeInfo(ctx, codeID))
+			}
 		})
 	}
 }
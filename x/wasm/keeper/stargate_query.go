@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// setAcceptedStargateQuery accepts a gRPC query path for stargate queries of contracts. The path must be served by
+// the query router and the response type must be a registered protobuf message. A previous entry of the path is
+// replaced.
+func (k Keeper) setAcceptedStargateQuery(ctx sdk.Context, query types.AcceptedStargateQuery) error {
+	if err := query.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.queryRouter == nil || k.queryRouter.Route(query.Path) == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "unknown query path: %s", query.Path)
+	}
+	if _, err := newStargateQueryResponse(query.ResponseType); err != nil {
+		return err
+	}
+	k.storeAcceptedStargateQuery(ctx, query)
+	return nil
+}
+
+// removeAcceptedStargateQuery disables stargate queries of contracts for the given path
+func (k Keeper) removeAcceptedStargateQuery(ctx sdk.Context, path string) error {
+	if k.GetAcceptedStargateQuery(ctx, path) == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "accepted stargate query")
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetAcceptedStargateQueryKey(path))
+	return nil
+}
+
+// GetAcceptedStargateQuery returns the accepted stargate query of the path or nil when the path is not accepted
+func (k Keeper) GetAcceptedStargateQuery(ctx sdk.Context, path string) *types.AcceptedStargateQuery {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAcceptedStargateQueryKey(path))
+	if bz == nil {
+		return nil
+	}
+	var query types.AcceptedStargateQuery
+	k.cdc.MustUnmarshal(bz, &query)
+	return &query
+}
+
+// IterateAcceptedStargateQueries iterates over the accepted stargate queries ordered by path.
+// When the callback returns true, the loop is aborted early.
+func (k Keeper) IterateAcceptedStargateQueries(ctx sdk.Context, cb func(types.AcceptedStargateQuery) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AcceptedStargateQueryPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var query types.AcceptedStargateQuery
+		k.cdc.MustUnmarshal(iter.Value(), &query)
+		if cb(query) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storeAcceptedStargateQuery(ctx sdk.Context, query types.AcceptedStargateQuery) {
+	ctx.KVStore(k.storeKey).Set(types.GetAcceptedStargateQueryKey(query.Path), k.cdc.MustMarshal(&query))
+}
+
+// newStargateQueryResponse returns a new instance of the registered protobuf message with the given name
+func newStargateQueryResponse(responseType string) (codec.ProtoMarshaler, error) {
+	t := proto.MessageType(responseType)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unknown response type: %s", responseType)
+	}
+	msg, ok := reflect.New(t.Elem()).Interface().(codec.ProtoMarshaler)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unsupported response type: %s", responseType)
+	}
+	return msg, nil
+}
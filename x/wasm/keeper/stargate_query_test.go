@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+const (
+	balanceQueryPath     = "/cosmos.bank.v1beta1.Query/Balance"
+	balanceQueryResponse = "cosmos.bank.v1beta1.QueryBalanceResponse"
+)
+
+func TestAcceptedStargateQueryProposals(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	submit := func(content govtypes.Content) error {
+		// the submission runs the handler on a cached context already
+		storedProposal, err := govKeeper.SubmitProposal(ctx, content)
+		if err != nil {
+			return err
+		}
+		handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+		return handler(ctx, storedProposal.GetContent())
+	}
+	balanceQuery := types.AcceptedStargateQuery{Path: balanceQueryPath, ResponseType: balanceQueryResponse}
+
+	// when accepted by governance
+	err := submit(&lbmtypes.UpdateAcceptedStargateQueriesProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Accept:      []types.AcceptedStargateQuery{balanceQuery},
+	})
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, &balanceQuery, wasmKeeper.GetAcceptedStargateQuery(ctx, balanceQueryPath))
+
+	// and when accepted again, the entry is replaced
+	balanceQuery.Encoding = types.StargateQueryEncodingJSON
+	err = submit(&lbmtypes.UpdateAcceptedStargateQueriesProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Accept:      []types.AcceptedStargateQuery{balanceQuery},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &balanceQuery, wasmKeeper.GetAcceptedStargateQuery(ctx, balanceQueryPath))
+
+	// and when removed
+	err = submit(&lbmtypes.UpdateAcceptedStargateQueriesProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		RemovePaths: []string{balanceQueryPath},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, wasmKeeper.GetAcceptedStargateQuery(ctx, balanceQueryPath))
+
+	// and when removed again
+	err = submit(&lbmtypes.UpdateAcceptedStargateQueriesProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		RemovePaths: []string{balanceQueryPath},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrNotFound.Error())
+
+	// and when the path is not served by the query router
+	err = submit(&lbmtypes.UpdateAcceptedStargateQueriesProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Accept:      []types.AcceptedStargateQuery{{Path: "/cosmos.bank.v1beta1.Query/Unknown", ResponseType: balanceQueryResponse}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown query path")
+
+	// and when the response type is not registered
+	err = submit(&lbmtypes.UpdateAcceptedStargateQueriesProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Accept:      []types.AcceptedStargateQuery{{Path: balanceQueryPath, ResponseType: "cosmos.bank.v1beta1.Unknown"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown response type")
+	assert.Nil(t, wasmKeeper.GetAcceptedStargateQuery(ctx, balanceQueryPath))
+}
+
+func TestStargateQuerier(t *testing.T) {
+	specs := map[string]struct {
+		accepted *types.AcceptedStargateQuery
+		expErr   bool
+		assert   func(t *testing.T, bz []byte)
+	}{
+		"not accepted": {
+			expErr: true,
+		},
+		"protobuf response": {
+			accepted: &types.AcceptedStargateQuery{Path: balanceQueryPath, ResponseType: balanceQueryResponse},
+			assert: func(t *testing.T, bz []byte) {
+				var res banktypes.QueryBalanceResponse
+				require.NoError(t, res.Unmarshal(bz))
+				assert.Equal(t, sdk.NewInt64Coin("denom", 100), *res.Balance)
+			},
+		},
+		"json response": {
+			accepted: &types.AcceptedStargateQuery{Path: balanceQueryPath, ResponseType: balanceQueryResponse, Encoding: types.StargateQueryEncodingJSON},
+			assert: func(t *testing.T, bz []byte) {
+				var res struct {
+					Balance wasmvmtypes.Coin `json:"balance"`
+				}
+				require.NoError(t, json.Unmarshal(bz, &res))
+				assert.Equal(t, wasmvmtypes.NewCoin(100, "denom"), res.Balance)
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			k := keepers.WasmKeeper
+			account := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 100))
+			if spec.accepted != nil {
+				require.NoError(t, keepers.ContractKeeper.SetAcceptedStargateQuery(ctx, *spec.accepted))
+			}
+			reqBz, err := k.cdc.Marshal(&banktypes.QueryBalanceRequest{Address: account.String(), Denom: "denom"})
+			require.NoError(t, err)
+			request := &wasmvmtypes.StargateQuery{Path: balanceQueryPath, Data: reqBz}
+			querier := StargateQuerier(k.queryRouter, k.cdc, k)
+
+			// when
+			gasBefore := ctx.GasMeter().GasConsumed()
+			bz, err := querier(ctx, request)
+			gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.IsType(t, wasmvmtypes.UnsupportedRequest{}, err)
+				return
+			}
+			require.NoError(t, err)
+			spec.assert(t, bz)
+
+			// and the request and response bytes are charged on top of the query itself
+			params := k.GetParams(ctx)
+			params.StargateQueryGasPerByte = 0
+			k.SetParams(ctx, params)
+			gasBefore = ctx.GasMeter().GasConsumed()
+			_, err = querier(ctx, request)
+			require.NoError(t, err)
+			gasUsedWithoutBytes := ctx.GasMeter().GasConsumed() - gasBefore
+			assert.Equal(t, types.DefaultStargateQueryGasPerByte*uint64(len(reqBz)+len(bz)), gasUsed-gasUsedWithoutBytes)
+		})
+	}
+}
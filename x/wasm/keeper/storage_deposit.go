@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// contractStore returns the store of the contract state that counts the storage usage of the contract. Writes that
+// grow the usage beyond the storage quota of the contract fail with ErrLimit. When the params require a storage
+// deposit, they also fail unless the payer can cover the missing deposit.
+func (k Keeper) contractStore(ctx sdk.Context, contractAddress, payer sdk.AccAddress) (types.WasmStore, *types.StorageCounter) {
+	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
+	storage := k.GetContractStorage(ctx, contractAddress)
+	params := k.GetParams(ctx)
+	quota := k.getContractStorageQuotaOverride(ctx, contractAddress).WithDefaults(params.StorageQuota())
+
+	var check func(bytes, keys uint64) error
+	if !quota.IsEmpty() || !params.StorageDepositPerByte.IsZero() {
+		check = func(bytes, keys uint64) error {
+			if err := quota.Check(bytes, keys); err != nil {
+				return err
+			}
+			missing := coinsExceeding(params.StorageDeposit(bytes), storage.Deposit)
+			for _, c := range missing {
+				if balance := k.bankViewKeeper.GetBalance(ctx, payer, c.Denom); balance.Amount.LT(c.Amount) {
+					return sdkerrors.Wrapf(types.ErrInsufficientStorageDeposit, "%s missing for %d bytes", missing, bytes)
+				}
+			}
+			return nil
+		}
+	}
+	unmetered := prefix.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), prefixStoreKey)
+	counter := types.NewStorageCounter(unmetered, storage.Bytes, storage.Keys, check)
+	return types.NewCountingWasmStore(prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey), counter), counter
+}
+
+// settleStorageDeposit stores the counted storage usage of a contract. When the usage has grown the missing deposit is
+// locked from the payer, any excess deposit is refunded to the contract.
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddress, payer sdk.AccAddress, counter *types.StorageCounter) error {
+	storage := k.GetContractStorage(ctx, contractAddress)
+	required := k.GetParams(ctx).StorageDeposit(counter.Bytes)
+	var lock sdk.Coins
+	if counter.Bytes > storage.Bytes {
+		lock = coinsExceeding(required, storage.Deposit)
+	}
+	refund := coinsExceeding(storage.Deposit, required)
+	if counter.Bytes == storage.Bytes && counter.Keys == storage.Keys && refund.IsZero() {
+		return nil
+	}
+
+	if !lock.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, lock); err != nil {
+			return sdkerrors.Wrap(err, "lock storage deposit")
+		}
+	}
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, refund); err != nil {
+			return sdkerrors.Wrap(err, "refund storage deposit")
+		}
+	}
+	storage.Bytes, storage.Keys = counter.Bytes, counter.Keys
+	storage.Deposit = storage.Deposit.Add(lock...).Sub(refund)
+	k.storeContractStorage(ctx, contractAddress, storage)
+
+	if lock.IsZero() && refund.IsZero() {
+		return nil
+	}
+	return ctx.EventManager().EmitTypedEvent(&lbmtypes.EventStorageDepositUpdated{
+		Contract: contractAddress.String(),
+		Bytes:    storage.Bytes,
+		Deposit:  storage.Deposit,
+	})
+}
+
+// refundStorageDeposit refunds the whole storage deposit to the contract and deletes the storage usage. It is called
+// when the contract state is deleted.
+func (k Keeper) refundStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	storage := k.GetContractStorage(ctx, contractAddress)
+	if !storage.Deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, storage.Deposit); err != nil {
+			return sdkerrors.Wrap(err, "refund storage deposit")
+		}
+	}
+	k.storeContractStorage(ctx, contractAddress, types.ContractStorage{})
+	return nil
+}
+
+// GetContractStorage returns the storage usage of a contract and the deposit that is locked for it. The bookkeeping
+// is not charged as the writes to the contract state are charged already.
+func (k Keeper) GetContractStorage(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorage {
+	var storage types.ContractStorage
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.GetContractStorageKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &storage)
+	}
+	return storage
+}
+
+func (k Keeper) storeContractStorage(ctx sdk.Context, contractAddress sdk.AccAddress, storage types.ContractStorage) {
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	if storage.Bytes == 0 && storage.Keys == 0 && storage.Deposit.IsZero() {
+		store.Delete(types.GetContractStorageKey(contractAddress))
+		return
+	}
+	store.Set(types.GetContractStorageKey(contractAddress), k.cdc.MustMarshal(&storage))
+}
+
+// wasmVMError returns the error of the check that aborted a write to the contract store or else the wrapped error of
+// the wasm vm, which only reports a panic for the aborted write.
+func wasmVMError(counter *types.StorageCounter, vmErr error, errType *sdkerrors.Error) error {
+	if counter.Err != nil {
+		return counter.Err
+	}
+	return sdkerrors.Wrap(errType, vmErr.Error())
+}
+
+// coinsExceeding returns the amounts of a that exceed the amounts of the same denoms in b
+func coinsExceeding(a, b sdk.Coins) sdk.Coins {
+	var r sdk.Coins
+	for _, c := range a {
+		if other := b.AmountOf(c.Denom); c.Amount.GT(other) {
+			r = r.Add(sdk.NewCoin(c.Denom, c.Amount.Sub(other)))
+		}
+	}
+	return r
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestStorageUsage(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	var expBytes, expKeys uint64
+	keepers.WasmKeeper.IterateContractState(ctx, example.Contract, func(key, value []byte) bool {
+		expBytes += uint64(len(key) + len(value))
+		expKeys++
+		return false
+	})
+	require.NotZero(t, expBytes)
+	assert.Equal(t, types.ContractStorage{Bytes: expBytes, Keys: expKeys}, keepers.WasmKeeper.GetContractStorage(ctx, example.Contract))
+
+	// and returned by the query
+	res, err := Querier(keepers.WasmKeeper).ContractStorage(sdk.WrapSDKContext(ctx), &lbmtypes.QueryContractStorageRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, expBytes, res.Storage.Bytes)
+	assert.True(t, res.Storage.Deposit.IsZero())
+}
+
+func TestStorageDepositOnInstantiate(t *testing.T) {
+	specs := map[string]struct {
+		perByte sdk.DecCoins
+		expErr  *sdkerrors.Error
+	}{
+		"no deposit required": {},
+		"deposit covered by instantiator": {
+			perByte: sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom", sdk.NewDecWithPrec(15, 1))),
+		},
+		"deposit not covered by instantiator": {
+			perByte: sdk.NewDecCoins(sdk.NewInt64DecCoin("denom", 1_000_000)),
+			expErr:  types.ErrInsufficientStorageDeposit,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			params := types.DefaultParams()
+			params.StorageDepositPerByte = spec.perByte
+			keepers.WasmKeeper.SetParams(ctx, params)
+
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+			moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+			creatorBalance := keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr)
+
+			// when
+			contractAddr, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "demo contract", nil)
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Equal(t, creatorBalance, keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			storage := keepers.WasmKeeper.GetContractStorage(ctx, contractAddr)
+			require.NotZero(t, storage.Bytes)
+			expDeposit := params.StorageDeposit(storage.Bytes)
+			assert.Equal(t, expDeposit.String(), storage.Deposit.String())
+			// locked from the instantiator in the module account
+			assert.Equal(t, creatorBalance.Sub(expDeposit).String(), keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr).String())
+			assert.Equal(t, expDeposit.String(), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).String())
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+		})
+	}
+}
+
+func TestStorageDepositRefund(t *testing.T) {
+	perByte := sdk.NewDecCoins(sdk.NewInt64DecCoin("denom", 1))
+	specs := map[string]struct {
+		deleteState func(ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error
+		expHolder   func(example HackatomExampleInstance) sdk.AccAddress
+		// expSwept is true when the holder receives the contract balance besides the deposit
+		expSwept bool
+	}{
+		"state deleted by migration": {
+			deleteState: func(ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				burner := StoreBurnerExampleContract(t, ctx, keepers)
+				migrateMsg := BurnerExampleInitMsg{Payout: example.BeneficiaryAddr}.GetBytes(t)
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, burner.CodeID, migrateMsg)
+				return err
+			},
+			// the burner sends the contract balance to the payout, the deposit is refunded to the contract
+			expHolder: func(example HackatomExampleInstance) sdk.AccAddress { return example.Contract },
+		},
+		"contract purged": {
+			deleteState: func(ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				return keepers.ContractKeeper.PurgeContract(ctx, example.Contract, example.CreatorAddr, example.BeneficiaryAddr)
+			},
+			expHolder: func(example HackatomExampleInstance) sdk.AccAddress { return example.BeneficiaryAddr },
+			expSwept:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			params := types.DefaultParams()
+			params.StorageDepositPerByte = perByte
+			keepers.WasmKeeper.SetParams(ctx, params)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			deposit := keepers.WasmKeeper.GetContractStorage(ctx, example.Contract).Deposit
+			require.False(t, deposit.IsZero())
+			holder := spec.expHolder(example)
+			expBalance := deposit
+			if spec.expSwept {
+				expBalance = expBalance.Add(keepers.BankKeeper.GetAllBalances(ctx, example.Contract)...)
+			}
+
+			// when
+			require.NoError(t, spec.deleteState(ctx, keepers, example))
+
+			// then
+			assert.Equal(t, types.ContractStorage{}, keepers.WasmKeeper.GetContractStorage(ctx, example.Contract))
+			assert.Equal(t, expBalance, keepers.BankKeeper.GetAllBalances(ctx, holder))
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+		})
+	}
+}
+
+func TestStorageCounter(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	store := ctx.KVStore(keepers.WasmKeeper.storeKey)
+	var checked [][2]uint64
+	quota := types.StorageQuota{MaxBytes: 10}
+	counter := types.NewStorageCounter(store, 0, 0, func(bytes, keys uint64) error {
+		checked = append(checked, [2]uint64{bytes, keys})
+		return quota.Check(bytes, keys)
+	})
+	wasmStore := types.NewCountingWasmStore(store, counter)
+
+	wasmStore.Set([]byte("key"), []byte("value"))
+	assert.Equal(t, uint64(8), counter.Bytes)
+	assert.Equal(t, uint64(1), counter.Keys)
+	// replacing with a shorter value is not checked
+	wasmStore.Set([]byte("key"), []byte("v"))
+	assert.Equal(t, uint64(4), counter.Bytes)
+	assert.Equal(t, uint64(1), counter.Keys)
+	wasmStore.Set([]byte("key"), []byte("value"))
+	assert.Equal(t, uint64(8), counter.Bytes)
+	// a write beyond the limit is aborted
+	assert.Panics(t, func() { wasmStore.Set([]byte("other"), []byte("value")) })
+	assert.Equal(t, uint64(8), counter.Bytes)
+	assert.Equal(t, uint64(1), counter.Keys)
+	assert.True(t, types.ErrLimit.Is(counter.Err))
+	assert.False(t, store.Has([]byte("other")))
+	wasmStore.Delete([]byte("key"))
+	assert.Equal(t, uint64(0), counter.Bytes)
+	assert.Equal(t, uint64(0), counter.Keys)
+	// deleting unknown keys does not change the usage
+	wasmStore.Delete([]byte("key"))
+	assert.Equal(t, uint64(0), counter.Bytes)
+	assert.Equal(t, uint64(0), counter.Keys)
+	assert.Equal(t, [][2]uint64{{8, 1}, {8, 1}, {18, 2}}, checked)
+}
+
+func TestStorageDepositGenesis(t *testing.T) {
+	specs := map[string]struct {
+		fundModule bool
+		expErr     *sdkerrors.Error
+	}{
+		"deposit held by module account": {
+			fundModule: true,
+		},
+		"deposit not held by module account": {
+			expErr: types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			srcCtx, srcKeepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			params := types.DefaultParams()
+			params.StorageDepositPerByte = sdk.NewDecCoins(sdk.NewInt64DecCoin("denom", 1))
+			srcKeepers.WasmKeeper.SetParams(srcCtx, params)
+			example := InstantiateHackatomExampleContract(t, srcCtx, srcKeepers)
+			deposit := srcKeepers.WasmKeeper.GetContractStorage(srcCtx, example.Contract).Deposit
+			require.False(t, deposit.IsZero())
+			genesisState := ExportGenesis(srcCtx, srcKeepers.WasmKeeper)
+
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			if spec.fundModule {
+				keepers.Faucet.Fund(ctx, authtypes.NewModuleAddress(types.ModuleName), deposit...)
+			}
+
+			// when
+			_, gotErr := InitGenesis(ctx, keepers.WasmKeeper, *genesisState, &StakingKeeperMock{}, TestHandler(keepers.ContractKeeper))
+
+			// then
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, deposit, keepers.WasmKeeper.GetContractStorage(ctx, example.Contract).Deposit)
+		})
+	}
+}
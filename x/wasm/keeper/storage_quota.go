@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// setContractStorageQuota overrides the default storage quota of the params for a contract. The limits of the quota
+// apply to following writes only, an unlimited quota exempts the contract from all limits and an empty quota removes
+// the override.
+func (k Keeper) setContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) error {
+	if err := quota.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	k.storeContractStorageQuota(ctx, contractAddress, quota)
+	return nil
+}
+
+// GetContractStorageQuota returns the storage quota that applies to a contract, the override of the contract with the
+// default limits of the params where it has none.
+func (k Keeper) GetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress) types.StorageQuota {
+	return k.getContractStorageQuotaOverride(ctx, contractAddress).WithDefaults(k.GetParams(ctx).StorageQuota())
+}
+
+// getContractStorageQuotaOverride returns the storage quota override of a contract. The override is read without
+// charge as it is read on every contract call.
+func (k Keeper) getContractStorageQuotaOverride(ctx sdk.Context, contractAddress sdk.AccAddress) types.StorageQuota {
+	var quota types.StorageQuota
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.GetContractStorageQuotaKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &quota)
+	}
+	return quota
+}
+
+func (k Keeper) storeContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota types.StorageQuota) {
+	store := ctx.KVStore(k.storeKey)
+	if quota.IsEmpty() {
+		store.Delete(types.GetContractStorageQuotaKey(contractAddress))
+		return
+	}
+	store.Set(types.GetContractStorageQuotaKey(contractAddress), k.cdc.MustMarshal(&quota))
+}
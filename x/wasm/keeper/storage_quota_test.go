@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestStorageQuotaOnInstantiate(t *testing.T) {
+	specs := map[string]struct {
+		maxBytes uint64
+		maxKeys  uint64
+		expErr   bool
+	}{
+		"no quota": {},
+		"within quota": {
+			maxBytes: 1000,
+			maxKeys:  10,
+		},
+		"bytes exceed quota": {
+			maxBytes: 1,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			params := types.DefaultParams()
+			params.MaxContractStorageBytes = spec.maxBytes
+			params.MaxContractStorageKeys = spec.maxKeys
+			keepers.WasmKeeper.SetParams(ctx, params)
+
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+			// when
+			contractAddr, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "demo contract", nil)
+
+			// then
+			if spec.expErr {
+				assert.True(t, types.ErrLimit.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			storage := keepers.WasmKeeper.GetContractStorage(ctx, contractAddr)
+			assert.NoError(t, types.StorageQuota{MaxBytes: spec.maxBytes, MaxKeys: spec.maxKeys}.Check(storage.Bytes, storage.Keys))
+		})
+	}
+}
+
+func TestUpdateContractStorageQuotaProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	keys := wasmKeeper.GetContractStorage(ctx, example.Contract).Keys
+	require.NotZero(t, keys)
+
+	// the default quota of the params allows no further key
+	params := types.DefaultParams()
+	params.MaxContractStorageKeys = keys
+	wasmKeeper.SetParams(ctx, params)
+	storageLoop := []byte(`{"storage_loop":{}}`)
+	_, err := keepers.ContractKeeper.Execute(ctx.WithGasMeter(sdk.NewGasMeter(400_000)), example.Contract, example.VerifierAddr, storageLoop, nil)
+	assert.True(t, types.ErrLimit.Is(err), err)
+
+	// when the quota of the contract is raised by governance
+	proposal := lbmtypes.UpdateContractStorageQuotaProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    example.Contract.String(),
+		Quota:       types.StorageQuota{MaxKeys: keys + 1},
+	}
+	storedProposal, err := govKeeper.SubmitProposal(ctx, &proposal)
+	require.NoError(t, err)
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	require.NoError(t, handler(ctx, storedProposal.GetContent()))
+
+	// then
+	assert.Equal(t, types.StorageQuota{MaxKeys: keys + 1}, wasmKeeper.GetContractStorageQuota(ctx, example.Contract))
+	res, err := Querier(wasmKeeper).ContractStorage(sdk.WrapSDKContext(ctx), &lbmtypes.QueryContractStorageRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, types.StorageQuota{MaxKeys: keys + 1}, res.Quota)
+	// and the loop writes until it runs out of gas
+	func() {
+		defer func() {
+			_, ok := recover().(sdk.ErrorOutOfGas)
+			assert.True(t, ok)
+		}()
+		_, _ = keepers.ContractKeeper.Execute(ctx.WithGasMeter(sdk.NewGasMeter(400_000)), example.Contract, example.VerifierAddr, storageLoop, nil)
+	}()
+
+	// and the default of the params applies when the override is removed
+	proposal.Quota = types.StorageQuota{}
+	storedProposal, err = govKeeper.SubmitProposal(ctx, &proposal)
+	require.NoError(t, err)
+	require.NoError(t, handler(ctx, storedProposal.GetContent()))
+	assert.Equal(t, types.StorageQuota{MaxKeys: keys}, wasmKeeper.GetContractStorageQuota(ctx, example.Contract))
+}
+
+func TestUnlimitedContractStorageQuota(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	wasmKeeper := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	keys := wasmKeeper.GetContractStorage(ctx, example.Contract).Keys
+	// the default quota of the params allows no further key
+	params := types.DefaultParams()
+	params.MaxContractStorageKeys = keys
+	wasmKeeper.SetParams(ctx, params)
+	govKeeper := NewGovPermissionKeeper(wasmKeeper)
+
+	// an unlimited quota with limits is rejected
+	err := govKeeper.SetContractStorageQuota(ctx, example.Contract, types.StorageQuota{MaxKeys: keys + 1, Unlimited: true})
+	assert.True(t, types.ErrInvalid.Is(err), err)
+
+	// when
+	unlimited := types.StorageQuota{Unlimited: true}
+	require.NoError(t, govKeeper.SetContractStorageQuota(ctx, example.Contract, unlimited))
+
+	// then the default of the params does not apply
+	assert.Equal(t, unlimited, wasmKeeper.GetContractStorageQuota(ctx, example.Contract))
+	func() {
+		defer func() {
+			_, ok := recover().(sdk.ErrorOutOfGas)
+			assert.True(t, ok)
+		}()
+		storageLoop := []byte(`{"storage_loop":{}}`)
+		_, _ = keepers.ContractKeeper.Execute(ctx.WithGasMeter(sdk.NewGasMeter(400_000)), example.Contract, example.VerifierAddr, storageLoop, nil)
+	}()
+	// and the override is exported
+	var exported *types.StorageQuota
+	for _, c := range ExportGenesis(ctx, wasmKeeper).Contracts {
+		if c.ContractAddress == example.Contract.String() {
+			exported = c.StorageQuota
+		}
+	}
+	assert.Equal(t, &unlimited, exported)
+}
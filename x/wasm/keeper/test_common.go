@@ -21,6 +21,7 @@ import (
 	"github.com/line/lbm-sdk/x/auth"
 	authkeeper "github.com/line/lbm-sdk/x/auth/keeper"
 	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/auth/vesting"
 	authzkeeper "github.com/line/lbm-sdk/x/authz/keeper"
 	"github.com/line/lbm-sdk/x/bank"
 	bankkeeper "github.com/line/lbm-sdk/x/bank/keeper"
@@ -77,6 +78,7 @@ import (
 
 var ModuleBasics = module.NewBasicManager(
 	auth.AppModuleBasic{},
+	vesting.AppModuleBasic{},
 	bank.AppModuleBasic{},
 	capability.AppModuleBasic{},
 	staking.AppModuleBasic{},
@@ -406,6 +408,7 @@ func createTestInput(
 	)
 	am.RegisterServices(module.NewConfigurator(appCodec, msgRouter, querier))
 	types.RegisterMsgServer(msgRouter, NewMsgServerImpl(NewDefaultPermissionKeeper(keeper)))
+	lbmtypes.RegisterMsgServer(msgRouter, NewLbmMsgServerImpl(NewDefaultPermissionKeeper(keeper)))
 	types.RegisterQueryServer(querier, NewGrpcQuerier(appCodec, keys[types.ModuleName], keeper, keeper.queryGasLimit))
 
 	govRouter := govtypes.NewRouter().
@@ -423,6 +426,7 @@ func createTestInput(
 		stakingKeeper,
 		govRouter,
 	)
+	govKeeper.SetHooks(govtypes.NewMultiGovHooks(NewGovHooks(&keeper)))
 
 	govKeeper.SetProposalID(ctx, govtypes.DefaultStartingProposalID)
 	govKeeper.SetDepositParams(ctx, govtypes.DefaultDepositParams())
@@ -537,6 +541,7 @@ type ExampleContract struct {
 	Creator       crypto.PrivKey
 	CreatorAddr   sdk.AccAddress
 	CodeID        uint64
+	Checksum      []byte
 }
 
 func StoreHackatomExampleContract(t testing.TB, ctx sdk.Context, keepers TestKeepers) ExampleContract {
@@ -571,7 +576,8 @@ func StoreExampleContract(t testing.TB, ctx sdk.Context, keepers TestKeepers, wa
 
 	codeID, err := keepers.ContractKeeper.Create(ctx, creatorAddr, wasmCode, nil)
 	require.NoError(t, err)
-	return ExampleContract{anyAmount, creator, creatorAddr, codeID}
+	hash := keepers.WasmKeeper.GetCodeInfo(ctx, codeID).CodeHash
+	return ExampleContract{anyAmount, creator, creatorAddr, codeID, hash}
 }
 
 var wasmIdent = []byte("\x00\x61\x73\x6D")
@@ -612,7 +618,8 @@ func StoreRandomContractWithAccessConfig(
 	wasmCode := append(wasmIdent, rand.Bytes(10)...) //nolint:gocritic
 	codeID, err := keepers.ContractKeeper.Create(ctx, creatorAddr, wasmCode, cfg)
 	require.NoError(t, err)
-	exampleContract := ExampleContract{InitialAmount: anyAmount, Creator: creator, CreatorAddr: creatorAddr, CodeID: codeID}
+	hash := keepers.WasmKeeper.GetCodeInfo(ctx, codeID).CodeHash
+	exampleContract := ExampleContract{InitialAmount: anyAmount, Creator: creator, CreatorAddr: creatorAddr, CodeID: codeID, Checksum: hash}
 	return exampleContract
 }
 
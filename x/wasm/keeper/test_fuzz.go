@@ -11,7 +11,7 @@ import (
 	"github.com/line/wasmd/x/wasm/types"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzDecCoins}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -33,6 +33,8 @@ func FuzzContractInfo(m *types.ContractInfo, c fuzz.Continue) {
 	m.CodeID = c.RandUint64()
 	FuzzAddrString(&m.Creator, c)
 	FuzzAddrString(&m.Admin, c)
+	FuzzAddrString(&m.PendingAdmin, c)
+	m.MigrationDelay = c.RandUint64() % (types.MaxMigrationDelay + 1)
 	m.Label = c.RandString()
 	c.Fuzz(&m.Created)
 }
@@ -75,3 +77,11 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzDecCoins(m *sdk.DecCoins, c fuzz.Continue) {
+	if c.RandBool() {
+		*m = nil
+		return
+	}
+	*m = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(int64(c.Intn(1000)), 3)))
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+// beginStoreCode starts a session to upload a code in multiple chunks. The upload permission is checked upfront so
+// that only accounts which can store the code fill the store with chunks. The deposit is held in escrow of the
+// module account until the session is committed.
+func (k Keeper) beginStoreCode(
+	ctx sdk.Context,
+	uploader sdk.AccAddress,
+	checksum []byte,
+	totalSize uint64,
+	instantiateAccess *types.AccessConfig,
+	authZ AuthorizationPolicy,
+) (uint64, int64, error) {
+	if uploader == nil {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot be empty")
+	}
+	if !authZ.CanCreateCode(k.getUploadAccessConfig(ctx), uploader) {
+		return 0, 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
+	}
+	if maxSize := k.getMaxWasmSize(ctx); totalSize > maxSize {
+		return 0, 0, sdkerrors.Wrapf(types.ErrLimit, "code cannot be longer than %d bytes", maxSize)
+	}
+	deposit := k.GetParams(ctx).UploadSessionDeposit
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, uploader, types.ModuleName, deposit); err != nil {
+			return 0, 0, sdkerrors.Wrap(err, "deposit")
+		}
+	}
+
+	sessionID := k.autoIncrementID(ctx, types.KeyLastUploadID)
+	session := types.UploadSession{
+		Checksum:              checksum,
+		TotalSize:             totalSize,
+		InstantiatePermission: instantiateAccess,
+		Deposit:               deposit,
+		ExpiryHeight:          ctx.BlockHeight() + k.uploadSessionExpiry,
+	}
+	k.storeUploadSession(ctx, uploader, sessionID, session)
+	ctx.KVStore(k.storeKey).Set(types.GetUploadSessionExpiryKey(session.ExpiryHeight, uploader, sessionID), []byte{})
+	return sessionID, session.ExpiryHeight, nil
+}
+
+// appendCodeChunk stores the next chunk of an upload session and returns the size of all chunks received so far.
+func (k Keeper) appendCodeChunk(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, data []byte) (uint64, error) {
+	session := k.GetUploadSession(ctx, uploader, sessionID)
+	if session == nil {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "upload session")
+	}
+	if session.ReceivedSize+uint64(len(data)) > session.TotalSize {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "chunk exceeds the total size of %d bytes", session.TotalSize)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetUploadChunkKey(uploader, sessionID, session.ChunkCount), data)
+	session.ChunkCount++
+	session.ReceivedSize += uint64(len(data))
+	k.storeUploadSession(ctx, uploader, sessionID, *session)
+	return session.ReceivedSize, nil
+}
+
+// commitStoreCode verifies the declared checksum of a completely uploaded code before it is stored like any other
+// code. The session is deleted and the deposit is returned to the uploader.
+func (k Keeper) commitStoreCode(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, authZ AuthorizationPolicy) (uint64, error) {
+	session := k.GetUploadSession(ctx, uploader, sessionID)
+	if session == nil {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "upload session")
+	}
+	if session.ReceivedSize != session.TotalSize {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "incomplete upload: %d of %d bytes received", session.ReceivedSize, session.TotalSize)
+	}
+
+	wasmCode := make([]byte, 0, session.TotalSize)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUploadChunkPrefix(uploader, sessionID))
+	iter := prefixStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		wasmCode = append(wasmCode, iter.Value()...)
+	}
+	iter.Close()
+	if checksum := sha256.Sum256(wasmCode); !bytes.Equal(checksum[:], session.Checksum) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "checksum does not match the uploaded code")
+	}
+
+	k.deleteUploadSession(ctx, uploader, sessionID, *session)
+	if !session.Deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, uploader, session.Deposit); err != nil {
+			return 0, sdkerrors.Wrap(err, "refund deposit")
+		}
+	}
+	return k.create(ctx, uploader, wasmCode, session.InstantiatePermission, nil, authZ)
+}
+
+// GetUploadSession returns the state of a chunked upload session or nil when it does not exist.
+func (k Keeper) GetUploadSession(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64) *types.UploadSession {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetUploadSessionKey(uploader, sessionID))
+	if bz == nil {
+		return nil
+	}
+	var session types.UploadSession
+	k.cdc.MustUnmarshal(bz, &session)
+	return &session
+}
+
+func (k Keeper) storeUploadSession(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, session types.UploadSession) {
+	ctx.KVStore(k.storeKey).Set(types.GetUploadSessionKey(uploader, sessionID), k.cdc.MustMarshal(&session))
+}
+
+// deleteUploadSession deletes the session with its chunks and expiry index entry
+func (k Keeper) deleteUploadSession(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, session types.UploadSession) {
+	store := ctx.KVStore(k.storeKey)
+	for i := uint32(0); i < session.ChunkCount; i++ {
+		store.Delete(types.GetUploadChunkKey(uploader, sessionID, i))
+	}
+	store.Delete(types.GetUploadSessionExpiryKey(session.ExpiryHeight, uploader, sessionID))
+	store.Delete(types.GetUploadSessionKey(uploader, sessionID))
+}
+
+// DeleteExpiredUploadSessions deletes all uncommitted upload sessions with an expiry height lower than or equal to
+// the current block height and burns their deposits.
+func (k Keeper) DeleteExpiredUploadSessions(ctx sdk.Context) error {
+	type sessionRef struct {
+		uploader  sdk.AccAddress
+		sessionID uint64
+	}
+	store := ctx.KVStore(k.storeKey)
+	prefixLen := len(types.UploadSessionExpiryPrefix)
+	end := sdk.PrefixEndBytes(append(sdk.CopyBytes(types.UploadSessionExpiryPrefix), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...))
+	iter := store.Iterator(types.UploadSessionExpiryPrefix, end)
+	var expired []sessionRef
+	for ; iter.Valid(); iter.Next() {
+		uploader, sessionID := types.ParseUploadSessionExpiryKey(iter.Key()[prefixLen+8:])
+		expired = append(expired, sessionRef{uploader: uploader, sessionID: sessionID})
+	}
+	iter.Close()
+
+	for _, ref := range expired {
+		session := k.GetUploadSession(ctx, ref.uploader, ref.sessionID)
+		if session == nil {
+			continue
+		}
+		k.deleteUploadSession(ctx, ref.uploader, ref.sessionID, *session)
+		if !session.Deposit.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, session.Deposit); err != nil {
+				return err
+			}
+		}
+		event := lbmtypes.EventUploadSessionExpired{
+			Uploader:      ref.uploader.String(),
+			SessionId:     ref.sessionID,
+			BurnedDeposit: session.Deposit,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
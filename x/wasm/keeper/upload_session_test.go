@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+
+	"github.com/line/wasmd/x/wasm/lbmtypes"
+	"github.com/line/wasmd/x/wasm/types"
+)
+
+func TestChunkedUpload(t *testing.T) {
+	gzippedWasm, err := os.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.UploadSessionDeposit = deposit
+	k.SetParams(ctx, params)
+	uploader := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 1000))
+	moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	checksum := sha256.Sum256(gzippedWasm)
+	sessionID, expiryHeight, err := keepers.ContractKeeper.BeginStoreCode(ctx, uploader, checksum[:], uint64(len(gzippedWasm)), &types.AllowNobody)
+	require.NoError(t, err)
+	assert.Equal(t, ctx.BlockHeight()+defaultUploadSessionExpiry, expiryHeight)
+	assert.Equal(t, deposit, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 900)), keepers.BankKeeper.GetAllBalances(ctx, uploader))
+
+	// when
+	const chunkSize = 10_000
+	for start := 0; start < len(gzippedWasm); start += chunkSize {
+		end := start + chunkSize
+		if end > len(gzippedWasm) {
+			end = len(gzippedWasm)
+		}
+		received, err := keepers.ContractKeeper.StoreCodeChunk(ctx, uploader, sessionID, gzippedWasm[start:end])
+		require.NoError(t, err)
+		assert.Equal(t, uint64(end), received)
+	}
+	codeID, err := keepers.ContractKeeper.CommitStoreCode(ctx, uploader, sessionID)
+
+	// then
+	require.NoError(t, err)
+	storedCode, err := k.GetByteCode(ctx, codeID)
+	require.NoError(t, err)
+	assert.Equal(t, hackatomWasm, storedCode)
+	assert.Equal(t, types.AllowNobody, k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+	assert.Equal(t, uploader.String(), k.GetCodeInfo(ctx, codeID).Creator)
+	// and the session is deleted with the deposit returned
+	assert.Nil(t, k.GetUploadSession(ctx, uploader, sessionID))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetUploadChunkKey(uploader, sessionID, 0)))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetUploadSessionExpiryKey(expiryHeight, uploader, sessionID)))
+	assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)), keepers.BankKeeper.GetAllBalances(ctx, uploader))
+}
+
+func TestChunkedUploadFailures(t *testing.T) {
+	myChecksum := sha256.Sum256(hackatomWasm)
+	specs := map[string]struct {
+		setParams func(p *types.Params)
+		upload    func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error
+		expErr    *sdkerrors.Error
+	}{
+		"upload not allowed": {
+			setParams: func(p *types.Params) {
+				p.CodeUploadAccess = types.AllowNobody
+			},
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				_, _, err := k.BeginStoreCode(ctx, uploader, myChecksum[:], uint64(len(hackatomWasm)), nil)
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"total size exceeds max wasm size": {
+			setParams: func(p *types.Params) {
+				p.MaxWasmSize = uint64(len(hackatomWasm) - 1)
+			},
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				_, _, err := k.BeginStoreCode(ctx, uploader, myChecksum[:], uint64(len(hackatomWasm)), nil)
+				return err
+			},
+			expErr: types.ErrLimit,
+		},
+		"chunk exceeds total size": {
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				sessionID, _, err := k.BeginStoreCode(ctx, uploader, myChecksum[:], 10, nil)
+				require.NoError(t, err)
+				_, err = k.StoreCodeChunk(ctx, uploader, sessionID, make([]byte, 11))
+				return err
+			},
+			expErr: types.ErrLimit,
+		},
+		"chunk of other uploader": {
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				sessionID, _, err := k.BeginStoreCode(ctx, uploader, myChecksum[:], uint64(len(hackatomWasm)), nil)
+				require.NoError(t, err)
+				_, err = k.StoreCodeChunk(ctx, RandomAccountAddress(t), sessionID, hackatomWasm)
+				return err
+			},
+			expErr: types.ErrNotFound,
+		},
+		"commit incomplete upload": {
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				sessionID, _, err := k.BeginStoreCode(ctx, uploader, myChecksum[:], uint64(len(hackatomWasm)), nil)
+				require.NoError(t, err)
+				_, err = k.StoreCodeChunk(ctx, uploader, sessionID, hackatomWasm[:100])
+				require.NoError(t, err)
+				_, err = k.CommitStoreCode(ctx, uploader, sessionID)
+				return err
+			},
+			expErr: types.ErrInvalid,
+		},
+		"commit with checksum mismatch": {
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				otherChecksum := sha256.Sum256([]byte("other"))
+				sessionID, _, err := k.BeginStoreCode(ctx, uploader, otherChecksum[:], uint64(len(hackatomWasm)), nil)
+				require.NoError(t, err)
+				_, err = k.StoreCodeChunk(ctx, uploader, sessionID, hackatomWasm)
+				require.NoError(t, err)
+				_, err = k.CommitStoreCode(ctx, uploader, sessionID)
+				return err
+			},
+			expErr: types.ErrInvalid,
+		},
+		"deposit not covered": {
+			setParams: func(p *types.Params) {
+				p.UploadSessionDeposit = sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+			},
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				_, _, err := k.BeginStoreCode(ctx, uploader, myChecksum[:], uint64(len(hackatomWasm)), nil)
+				return err
+			},
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		"commit unknown session": {
+			upload: func(t *testing.T, ctx sdk.Context, k types.ContractOpsKeeper, uploader sdk.AccAddress) error {
+				_, err := k.CommitStoreCode(ctx, uploader, 100)
+				return err
+			},
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			if spec.setParams != nil {
+				params := types.DefaultParams()
+				spec.setParams(&params)
+				keepers.WasmKeeper.SetParams(ctx, params)
+			}
+			uploader := keepers.Faucet.NewFundedAccount(ctx, types.DefaultUploadSessionDeposit...)
+
+			// when
+			gotErr := spec.upload(t, ctx, keepers.ContractKeeper, uploader)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), gotErr)
+		})
+	}
+}
+
+func TestDeleteExpiredUploadSessions(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithUploadSessionExpiry(2))
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.UploadSessionDeposit = deposit
+	k.SetParams(ctx, params)
+	uploader := keepers.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin("denom", 1000))
+	moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+	checksum := sha256.Sum256(hackatomWasm)
+
+	height := ctx.BlockHeight()
+	expiring, _, err := keepers.ContractKeeper.BeginStoreCode(ctx, uploader, checksum[:], uint64(len(hackatomWasm)), nil)
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.StoreCodeChunk(ctx, uploader, expiring, hackatomWasm[:100])
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(height + 1)
+	expiringLater, _, err := keepers.ContractKeeper.BeginStoreCode(ctx, uploader, checksum[:], uint64(len(hackatomWasm)), nil)
+	require.NoError(t, err)
+	supplyBefore := keepers.BankKeeper.GetSupply(ctx, "denom")
+
+	// not expired yet
+	require.NoError(t, k.DeleteExpiredUploadSessions(ctx))
+	assert.NotNil(t, k.GetUploadSession(ctx, uploader, expiring))
+
+	// when
+	em := sdk.NewEventManager()
+	ctx = ctx.WithBlockHeight(height + 2)
+	require.NoError(t, k.DeleteExpiredUploadSessions(ctx.WithEventManager(em)))
+
+	// then
+	assert.Nil(t, k.GetUploadSession(ctx, uploader, expiring))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetUploadChunkKey(uploader, expiring, 0)))
+	assert.NotNil(t, k.GetUploadSession(ctx, uploader, expiringLater))
+	// and the deposit is burned
+	assert.Equal(t, deposit, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	assert.Equal(t, supplyBefore.Sub(deposit[0]), keepers.BankKeeper.GetSupply(ctx, "denom"))
+	expEvent, err := sdk.TypedEventToEvent(&lbmtypes.EventUploadSessionExpired{
+		Uploader:      uploader.String(),
+		SessionId:     expiring,
+		BurnedDeposit: deposit,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, em.Events(), expEvent)
+}
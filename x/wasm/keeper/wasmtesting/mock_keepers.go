@@ -83,6 +83,7 @@ type MockCapabilityKeeper struct {
 	GetCapabilityFn          func(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
 	ClaimCapabilityFn        func(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
 	AuthenticateCapabilityFn func(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool
+	ReleaseCapabilityFn      func(ctx sdk.Context, cap *capabilitytypes.Capability) error
 }
 
 func (m MockCapabilityKeeper) GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool) {
@@ -106,6 +107,13 @@ func (m MockCapabilityKeeper) AuthenticateCapability(ctx sdk.Context, capability
 	return m.AuthenticateCapabilityFn(ctx, capability, name)
 }
 
+func (m MockCapabilityKeeper) ReleaseCapability(ctx sdk.Context, cap *capabilitytypes.Capability) error {
+	if m.ReleaseCapabilityFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.ReleaseCapabilityFn(ctx, cap)
+}
+
 var _ types.ICS20TransferPortSource = &MockIBCTransferKeeper{}
 
 type MockIBCTransferKeeper struct {
@@ -17,9 +17,33 @@ import (
 // RegisterLegacyAminoCodec registers the account types and interface
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	legacy.RegisterAminoMsg(cdc, &MsgStoreCodeAndInstantiateContract{}, "wasm/StoreCodeAndInstantiateContract")
+	legacy.RegisterAminoMsg(cdc, &MsgPurgeContract{}, "wasm/MsgPurgeContract")
+	legacy.RegisterAminoMsg(cdc, &MsgStoreCodeBegin{}, "wasm/MsgStoreCodeBegin")
+	legacy.RegisterAminoMsg(cdc, &MsgStoreCodeChunk{}, "wasm/MsgStoreCodeChunk")
+	legacy.RegisterAminoMsg(cdc, &MsgStoreCodeCommit{}, "wasm/MsgStoreCodeCommit")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeAdmin{}, "wasm/MsgProposeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptAdmin{}, "wasm/MsgAcceptAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelPendingAdmin{}, "wasm/MsgCancelPendingAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMigrationDelay{}, "wasm/MsgUpdateMigrationDelay")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelMigration{}, "wasm/MsgCancelMigration")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMigrationAllowlist{}, "wasm/MsgUpdateMigrationAllowlist")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCodeMetadata{}, "wasm/MsgSetCodeMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateContractMetadata{}, "wasm/MsgUpdateContractMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeAllowance{}, "wasm/MsgUpdateFeeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleCallback{}, "wasm/MsgScheduleCallback")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelSchedule{}, "wasm/MsgCancelSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgExecuteContracts{}, "wasm/MsgExecuteContracts")
 
 	cdc.RegisterConcrete(&DeactivateContractProposal{}, "wasm/DeactivateContractProposal", nil)
 	cdc.RegisterConcrete(&ActivateContractProposal{}, "wasm/ActivateContractProposal", nil)
+	cdc.RegisterConcrete(&PurgeContractProposal{}, "wasm/PurgeContractProposal", nil)
+	cdc.RegisterConcrete(&RemoveCodesProposal{}, "wasm/RemoveCodesProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "wasm/UpdateParamsProposal", nil)
+	cdc.RegisterConcrete(&UpdateMigrationAllowlistProposal{}, "wasm/UpdateMigrationAllowlistProposal", nil)
+	cdc.RegisterConcrete(&UpdateContractStorageQuotaProposal{}, "wasm/UpdateContractStorageQuotaProposal", nil)
+	cdc.RegisterConcrete(&RegisterPrivilegedContractProposal{}, "wasm/RegisterPrivilegedContractProposal", nil)
+	cdc.RegisterConcrete(&UnregisterPrivilegedContractProposal{}, "wasm/UnregisterPrivilegedContractProposal", nil)
+	cdc.RegisterConcrete(&UpdateAcceptedStargateQueriesProposal{}, "wasm/UpdateAcceptedStargateQueriesProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -27,11 +51,35 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgStoreCodeAndInstantiateContract{},
+		&MsgPurgeContract{},
+		&MsgStoreCodeBegin{},
+		&MsgStoreCodeChunk{},
+		&MsgStoreCodeCommit{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelPendingAdmin{},
+		&MsgUpdateMigrationDelay{},
+		&MsgCancelMigration{},
+		&MsgUpdateMigrationAllowlist{},
+		&MsgSetCodeMetadata{},
+		&MsgUpdateContractMetadata{},
+		&MsgUpdateFeeAllowance{},
+		&MsgScheduleCallback{},
+		&MsgCancelSchedule{},
+		&MsgExecuteContracts{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&DeactivateContractProposal{},
 		&ActivateContractProposal{},
+		&PurgeContractProposal{},
+		&RemoveCodesProposal{},
+		&UpdateParamsProposal{},
+		&UpdateMigrationAllowlistProposal{},
+		&UpdateContractStorageQuotaProposal{},
+		&RegisterPrivilegedContractProposal{},
+		&UnregisterPrivilegedContractProposal{},
+		&UpdateAcceptedStargateQueriesProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -13,16 +13,43 @@ import (
 const (
 	ProposalTypeDeactivateContract wasmtypes.ProposalType = "DeactivateContract"
 	ProposalTypeActivateContract   wasmtypes.ProposalType = "ActivateContract"
+	ProposalTypePurgeContract      wasmtypes.ProposalType = "PurgeContract"
+	ProposalTypeRemoveCodes        wasmtypes.ProposalType = "RemoveCodes"
+	ProposalTypeUpdateParams       wasmtypes.ProposalType = "UpdateWasmParams"
+
+	ProposalTypeUpdateMigrationAllowlist   wasmtypes.ProposalType = "UpdateMigrationAllowlist"
+	ProposalTypeUpdateContractStorageQuota wasmtypes.ProposalType = "UpdateContractStorageQuota"
+
+	ProposalTypeRegisterPrivilegedContract   wasmtypes.ProposalType = "RegisterPrivilegedContract"
+	ProposalTypeUnregisterPrivilegedContract wasmtypes.ProposalType = "UnregisterPrivilegedContract"
+
+	ProposalTypeUpdateAcceptedStargateQueries wasmtypes.ProposalType = "UpdateAcceptedStargateQueries"
 )
 
 var EnableAllProposals = append([]wasmtypes.ProposalType{
 	ProposalTypeDeactivateContract,
 	ProposalTypeActivateContract,
+	ProposalTypePurgeContract,
+	ProposalTypeRemoveCodes,
+	ProposalTypeUpdateParams,
+	ProposalTypeUpdateMigrationAllowlist,
+	ProposalTypeUpdateContractStorageQuota,
+	ProposalTypeRegisterPrivilegedContract,
+	ProposalTypeUnregisterPrivilegedContract,
+	ProposalTypeUpdateAcceptedStargateQueries,
 }, wasmtypes.EnableAllProposals...)
 
 func init() {
 	govtypes.RegisterProposalType(string(ProposalTypeDeactivateContract))
 	govtypes.RegisterProposalType(string(ProposalTypeActivateContract))
+	govtypes.RegisterProposalType(string(ProposalTypePurgeContract))
+	govtypes.RegisterProposalType(string(ProposalTypeRemoveCodes))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateParams))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateMigrationAllowlist))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateContractStorageQuota))
+	govtypes.RegisterProposalType(string(ProposalTypeRegisterPrivilegedContract))
+	govtypes.RegisterProposalType(string(ProposalTypeUnregisterPrivilegedContract))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateAcceptedStargateQueries))
 }
 
 func (p DeactivateContractProposal) GetTitle() string { return p.Title }
@@ -39,16 +66,20 @@ func (p DeactivateContractProposal) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
 	}
+	if p.ExpiryHeight < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiry height")
+	}
 
 	return nil
 }
 
 func (p DeactivateContractProposal) String() string {
 	return fmt.Sprintf(`Deactivate Contract Proposal:
-  Title:       %s
-  Description: %s
-  Contract:    %s
-`, p.Title, p.Description, p.Contract)
+  Title:        %s
+  Description:  %s
+  Contract:     %s
+  ExpiryHeight: %d
+`, p.Title, p.Description, p.Contract, p.ExpiryHeight)
 }
 
 func (p ActivateContractProposal) GetTitle() string { return p.Title }
@@ -74,3 +105,255 @@ func (p ActivateContractProposal) String() string {
   Contract:    %s
 `, p.Title, p.Description, p.Contract)
 }
+
+func (p PurgeContractProposal) GetTitle() string { return p.Title }
+
+func (p PurgeContractProposal) GetDescription() string { return p.Description }
+
+func (p PurgeContractProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p PurgeContractProposal) ProposalType() string { return string(ProposalTypePurgeContract) }
+
+func (p PurgeContractProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Beneficiary); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "beneficiary")
+	}
+
+	return nil
+}
+
+func (p PurgeContractProposal) String() string {
+	return fmt.Sprintf(`Purge Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Beneficiary: %s
+`, p.Title, p.Description, p.Contract, p.Beneficiary)
+}
+
+func (p RemoveCodesProposal) GetTitle() string { return p.Title }
+
+func (p RemoveCodesProposal) GetDescription() string { return p.Description }
+
+func (p RemoveCodesProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p RemoveCodesProposal) ProposalType() string { return string(ProposalTypeRemoveCodes) }
+
+func (p RemoveCodesProposal) ValidateBasic() error {
+	if len(p.CodeIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code ids")
+	}
+	for _, codeID := range p.CodeIDs {
+		if codeID == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id")
+		}
+	}
+
+	return nil
+}
+
+func (p RemoveCodesProposal) String() string {
+	return fmt.Sprintf(`Remove Codes Proposal:
+  Title:       %s
+  Description: %s
+  Codes:       %v
+`, p.Title, p.Description, p.CodeIDs)
+}
+
+func (p UpdateParamsProposal) GetTitle() string { return p.Title }
+
+func (p UpdateParamsProposal) GetDescription() string { return p.Description }
+
+func (p UpdateParamsProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p UpdateParamsProposal) ProposalType() string { return string(ProposalTypeUpdateParams) }
+
+func (p UpdateParamsProposal) ValidateBasic() error {
+	if err := p.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (p UpdateParamsProposal) String() string {
+	return fmt.Sprintf(`Update Params Proposal:
+  Title:       %s
+  Description: %s
+  Params:      %s
+`, p.Title, p.Description, p.Params)
+}
+
+func (p UpdateMigrationAllowlistProposal) GetTitle() string { return p.Title }
+
+func (p UpdateMigrationAllowlistProposal) GetDescription() string { return p.Description }
+
+func (p UpdateMigrationAllowlistProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p UpdateMigrationAllowlistProposal) ProposalType() string {
+	return string(ProposalTypeUpdateMigrationAllowlist)
+}
+
+func (p UpdateMigrationAllowlistProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
+	}
+	allowlist := wasmtypes.MigrationAllowlist{CodeIDs: p.CodeIDs, Checksums: p.Checksums}
+	if err := allowlist.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (p UpdateMigrationAllowlistProposal) String() string {
+	return fmt.Sprintf(`Update Migration Allowlist Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Codes:       %v
+  Checksums:   %X
+`, p.Title, p.Description, p.Contract, p.CodeIDs, p.Checksums)
+}
+
+func (p UpdateContractStorageQuotaProposal) GetTitle() string { return p.Title }
+
+func (p UpdateContractStorageQuotaProposal) GetDescription() string { return p.Description }
+
+func (p UpdateContractStorageQuotaProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p UpdateContractStorageQuotaProposal) ProposalType() string {
+	return string(ProposalTypeUpdateContractStorageQuota)
+}
+
+func (p UpdateContractStorageQuotaProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
+	}
+	if err := p.Quota.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "quota")
+	}
+
+	return nil
+}
+
+func (p UpdateContractStorageQuotaProposal) String() string {
+	return fmt.Sprintf(`Update Contract Storage Quota Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Max Bytes:   %d
+  Max Keys:    %d
+  Unlimited:   %t
+`, p.Title, p.Description, p.Contract, p.Quota.MaxBytes, p.Quota.MaxKeys, p.Quota.Unlimited)
+}
+
+func (p RegisterPrivilegedContractProposal) GetTitle() string { return p.Title }
+
+func (p RegisterPrivilegedContractProposal) GetDescription() string { return p.Description }
+
+func (p RegisterPrivilegedContractProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p RegisterPrivilegedContractProposal) ProposalType() string {
+	return string(ProposalTypeRegisterPrivilegedContract)
+}
+
+func (p RegisterPrivilegedContractProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
+	}
+	if !p.BeginBlock && !p.EndBlock {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "begin block or end block")
+	}
+	if p.GasLimit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "gas limit")
+	}
+
+	return nil
+}
+
+func (p RegisterPrivilegedContractProposal) String() string {
+	return fmt.Sprintf(`Register Privileged Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Begin Block: %t
+  End Block:   %t
+  Gas Limit:   %d
+`, p.Title, p.Description, p.Contract, p.BeginBlock, p.EndBlock, p.GasLimit)
+}
+
+func (p UnregisterPrivilegedContractProposal) GetTitle() string { return p.Title }
+
+func (p UnregisterPrivilegedContractProposal) GetDescription() string { return p.Description }
+
+func (p UnregisterPrivilegedContractProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p UnregisterPrivilegedContractProposal) ProposalType() string {
+	return string(ProposalTypeUnregisterPrivilegedContract)
+}
+
+func (p UnregisterPrivilegedContractProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contract")
+	}
+
+	return nil
+}
+
+func (p UnregisterPrivilegedContractProposal) String() string {
+	return fmt.Sprintf(`Unregister Privileged Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+func (p UpdateAcceptedStargateQueriesProposal) GetTitle() string { return p.Title }
+
+func (p UpdateAcceptedStargateQueriesProposal) GetDescription() string { return p.Description }
+
+func (p UpdateAcceptedStargateQueriesProposal) ProposalRoute() string { return wasmtypes.RouterKey }
+
+func (p UpdateAcceptedStargateQueriesProposal) ProposalType() string {
+	return string(ProposalTypeUpdateAcceptedStargateQueries)
+}
+
+func (p UpdateAcceptedStargateQueriesProposal) ValidateBasic() error {
+	if len(p.Accept) == 0 && len(p.RemovePaths) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "accept or remove paths")
+	}
+	paths := make(map[string]struct{}, len(p.Accept)+len(p.RemovePaths))
+	for i, q := range p.Accept {
+		if err := q.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "accept %d: %s", i, err)
+		}
+		if _, exists := paths[q.Path]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate path: %s", q.Path)
+		}
+		paths[q.Path] = struct{}{}
+	}
+	for _, path := range p.RemovePaths {
+		if path == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "remove path")
+		}
+		if _, exists := paths[path]; exists {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate path: %s", path)
+		}
+		paths[path] = struct{}{}
+	}
+
+	return nil
+}
+
+func (p UpdateAcceptedStargateQueriesProposal) String() string {
+	return fmt.Sprintf(`Update Accepted Stargate Queries Proposal:
+  Title:        %s
+  Description:  %s
+  Accept:       %v
+  Remove Paths: %v
+`, p.Title, p.Description, p.Accept, p.RemovePaths)
+}
@@ -10,6 +10,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	query "github.com/line/lbm-sdk/types/query"
+	types "github.com/line/wasmd/x/wasm/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -153,6 +154,8 @@ var xxx_messageInfo_QueryInactiveContractRequest proto.InternalMessageInfo
 type QueryInactiveContractResponse struct {
 	// inactivated is the result if the contract is inactive contract or not
 	Inactivated bool `protobuf:"varint,1,opt,name=inactivated,proto3" json:"inactivated,omitempty"`
+	// info is the deactivation details of an inactive contract
+	Info *types.InactiveContractInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
 }
 
 func (m *QueryInactiveContractResponse) Reset()         { *m = QueryInactiveContractResponse{} }
@@ -188,382 +191,3501 @@ func (m *QueryInactiveContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInactiveContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryInactiveContractsRequest)(nil), "lbm.wasm.v1.QueryInactiveContractsRequest")
-	proto.RegisterType((*QueryInactiveContractsResponse)(nil), "lbm.wasm.v1.QueryInactiveContractsResponse")
-	proto.RegisterType((*QueryInactiveContractRequest)(nil), "lbm.wasm.v1.QueryInactiveContractRequest")
-	proto.RegisterType((*QueryInactiveContractResponse)(nil), "lbm.wasm.v1.QueryInactiveContractResponse")
+// QueryPendingMigrationsRequest is the request type for the Query/PendingMigrations RPC method.
+type QueryPendingMigrationsRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("lbm/wasm/v1/query.proto", fileDescriptor_f1bdb66850244231) }
-
-var fileDescriptor_f1bdb66850244231 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x22, 0xfe, 0xd4, 0x5d, 0xc0, 0x42, 0xa2, 0xaa, 0x8a, 0x29, 0x91, 0xe0, 0xee,
-	0x7a, 0x92, 0xad, 0x1c, 0x0b, 0x2b, 0x20, 0x71, 0x62, 0x83, 0x8c, 0x2c, 0xc8, 0x49, 0x2c, 0x13,
-	0x29, 0xb1, 0x73, 0xb1, 0x1b, 0x38, 0x21, 0x16, 0x16, 0x56, 0x24, 0xc4, 0xc6, 0x07, 0xe0, 0x53,
-	0x30, 0xdf, 0x78, 0x12, 0x0b, 0x23, 0xa4, 0x7c, 0x10, 0x14, 0x3b, 0x6d, 0x53, 0xa0, 0xb4, 0x5b,
-	0xe2, 0xf7, 0x79, 0x9f, 0xe7, 0xf7, 0xfa, 0x4d, 0xe0, 0x8d, 0x2c, 0xca, 0xe9, 0x2b, 0xa6, 0x73,
-	0x5a, 0x05, 0xf4, 0x64, 0xc6, 0xcb, 0x53, 0x52, 0x94, 0xca, 0x28, 0x34, 0xc8, 0xa2, 0x9c, 0x34,
-	0x05, 0x52, 0x05, 0xa3, 0xeb, 0x42, 0x09, 0x65, 0xcf, 0x69, 0xf3, 0xe4, 0x24, 0xa3, 0xb1, 0x50,
-	0x4a, 0x64, 0x9c, 0xb2, 0x22, 0xa5, 0x4c, 0x4a, 0x65, 0x98, 0x49, 0x95, 0xd4, 0x6d, 0x75, 0x1a,
-	0x2b, 0x9d, 0x2b, 0x4d, 0x23, 0xa6, 0xb9, 0x73, 0xa6, 0x55, 0x10, 0x71, 0xc3, 0x02, 0x5a, 0x30,
-	0x91, 0x4a, 0x2b, 0x76, 0x5a, 0x5f, 0xc0, 0x9b, 0xcf, 0x1a, 0xc5, 0x13, 0xc9, 0x62, 0x93, 0x56,
-	0xfc, 0x91, 0x92, 0xa6, 0x64, 0xb1, 0xd1, 0x21, 0x3f, 0x99, 0x71, 0x6d, 0xd0, 0x63, 0x08, 0x57,
-	0x4d, 0x43, 0x30, 0x01, 0xfb, 0x83, 0xa3, 0xbb, 0xc4, 0x25, 0x90, 0x26, 0x81, 0x38, 0xf6, 0x36,
-	0x81, 0x3c, 0x65, 0x82, 0xb7, 0xbd, 0x61, 0xa7, 0xd3, 0x7f, 0x0f, 0x20, 0xde, 0x94, 0xa4, 0x0b,
-	0x25, 0x35, 0x47, 0x63, 0xd8, 0x67, 0x49, 0x52, 0x72, 0xad, 0xb9, 0x1e, 0x82, 0xc9, 0x85, 0xfd,
-	0x7e, 0xb8, 0x3a, 0x40, 0xc7, 0x6b, 0x20, 0x3d, 0x0b, 0xb2, 0xb7, 0x15, 0xc4, 0x59, 0xaf, 0x91,
-	0xdc, 0x87, 0xe3, 0x7f, 0x82, 0x2c, 0x26, 0x1e, 0xc2, 0xcb, 0x6d, 0xaa, 0x1d, 0xb7, 0x1f, 0x2e,
-	0x5e, 0xfd, 0x07, 0x1b, 0x2e, 0x6b, 0x39, 0xc1, 0x04, 0x0e, 0x52, 0x57, 0x63, 0x86, 0x27, 0xb6,
-	0xfd, 0x4a, 0xd8, 0x3d, 0x3a, 0xfa, 0xda, 0x83, 0x17, 0xad, 0x07, 0xfa, 0x04, 0xe0, 0xb5, 0xbf,
-	0xee, 0x02, 0x4d, 0x49, 0x67, 0xfb, 0xe4, 0xbf, 0xab, 0x19, 0x1d, 0xee, 0xa4, 0x75, 0x68, 0xfe,
-	0xde, 0xbb, 0x6f, 0xbf, 0x3e, 0xf6, 0x6e, 0xa3, 0x5b, 0xb4, 0xfb, 0xdd, 0xb5, 0x68, 0xfc, 0x45,
-	0xbc, 0x24, 0xf8, 0x0c, 0xe0, 0xd5, 0x3f, 0x6d, 0xd0, 0xc1, 0xf6, 0xa8, 0x05, 0xd5, 0x74, 0x17,
-	0x69, 0x0b, 0x15, 0x58, 0xa8, 0x43, 0x74, 0xb0, 0x05, 0x8a, 0xbe, 0x69, 0x57, 0xf0, 0xf6, 0xe1,
-	0xf1, 0xd9, 0x4f, 0xec, 0x7d, 0xa9, 0xb1, 0x77, 0x56, 0x63, 0x70, 0x5e, 0x63, 0xf0, 0xa3, 0xc6,
-	0xe0, 0xc3, 0x1c, 0x7b, 0xe7, 0x73, 0xec, 0x7d, 0x9f, 0x63, 0xef, 0xf9, 0x1d, 0x91, 0x9a, 0x97,
-	0xb3, 0x88, 0xc4, 0x2a, 0xa7, 0x59, 0x2a, 0xb9, 0xf5, 0x4d, 0xe8, 0x6b, 0xe7, 0x9f, 0x45, 0xb9,
-	0x39, 0x2d, 0xb8, 0x8e, 0x2e, 0xd9, 0x1f, 0xe0, 0xde, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0xc8, 0xe6, 0x11, 0x88, 0x03, 0x00, 0x00,
+func (m *QueryPendingMigrationsRequest) Reset()         { *m = QueryPendingMigrationsRequest{} }
+func (m *QueryPendingMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMigrationsRequest) ProtoMessage()    {}
+func (*QueryPendingMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{4}
+}
+func (m *QueryPendingMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMigrationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMigrationsRequest.Merge(m, src)
+}
+func (m *QueryPendingMigrationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMigrationsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingMigrationsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// InactiveContracts queries all inactive contracts
-	InactiveContracts(ctx context.Context, in *QueryInactiveContractsRequest, opts ...grpc.CallOption) (*QueryInactiveContractsResponse, error)
-	InactiveContract(ctx context.Context, in *QueryInactiveContractRequest, opts ...grpc.CallOption) (*QueryInactiveContractResponse, error)
+// QueryPendingMigrationsResponse is the response type for the Query/PendingMigrations RPC method.
+type QueryPendingMigrationsResponse struct {
+	// pending_migrations are the queued migrations
+	PendingMigrations []types.PendingMigration `protobuf:"bytes,1,rep,name=pending_migrations,json=pendingMigrations,proto3" json:"pending_migrations"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingMigrationsResponse) Reset()         { *m = QueryPendingMigrationsResponse{} }
+func (m *QueryPendingMigrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMigrationsResponse) ProtoMessage()    {}
+func (*QueryPendingMigrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingMigrationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) InactiveContracts(ctx context.Context, in *QueryInactiveContractsRequest, opts ...grpc.CallOption) (*QueryInactiveContractsResponse, error) {
-	out := new(QueryInactiveContractsResponse)
-	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/InactiveContracts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingMigrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMigrationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) InactiveContract(ctx context.Context, in *QueryInactiveContractRequest, opts ...grpc.CallOption) (*QueryInactiveContractResponse, error) {
-	out := new(QueryInactiveContractResponse)
-	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/InactiveContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPendingMigrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMigrationsResponse.Merge(m, src)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// InactiveContracts queries all inactive contracts
-	InactiveContracts(context.Context, *QueryInactiveContractsRequest) (*QueryInactiveContractsResponse, error)
-	InactiveContract(context.Context, *QueryInactiveContractRequest) (*QueryInactiveContractResponse, error)
+func (m *QueryPendingMigrationsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPendingMigrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMigrationsResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) InactiveContracts(ctx context.Context, req *QueryInactiveContractsRequest) (*QueryInactiveContractsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InactiveContracts not implemented")
-}
-func (*UnimplementedQueryServer) InactiveContract(ctx context.Context, req *QueryInactiveContractRequest) (*QueryInactiveContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InactiveContract not implemented")
-}
+var xxx_messageInfo_QueryPendingMigrationsResponse proto.InternalMessageInfo
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryMigrationAllowlistRequest is the request type for the Query/MigrationAllowlist RPC method.
+type QueryMigrationAllowlistRequest struct {
+	// address is the address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_InactiveContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInactiveContractsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InactiveContracts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.wasm.v1.Query/InactiveContracts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InactiveContracts(ctx, req.(*QueryInactiveContractsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryMigrationAllowlistRequest) Reset()         { *m = QueryMigrationAllowlistRequest{} }
+func (m *QueryMigrationAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationAllowlistRequest) ProtoMessage()    {}
+func (*QueryMigrationAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{6}
 }
-
-func _Query_InactiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInactiveContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InactiveContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lbm.wasm.v1.Query/InactiveContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InactiveContract(ctx, req.(*QueryInactiveContractRequest))
+func (m *QueryMigrationAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lbm.wasm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InactiveContracts",
-			Handler:    _Query_InactiveContracts_Handler,
-		},
-		{
-			MethodName: "InactiveContract",
-			Handler:    _Query_InactiveContract_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lbm/wasm/v1/query.proto",
+func (m *QueryMigrationAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationAllowlistRequest.Merge(m, src)
 }
-
-func (m *QueryInactiveContractsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMigrationAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationAllowlistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMigrationAllowlistRequest proto.InternalMessageInfo
+
+// QueryMigrationAllowlistResponse is the response type for the Query/MigrationAllowlist RPC method.
+type QueryMigrationAllowlistResponse struct {
+	// allowlist is the set of allowed migration targets. It is empty when the contract can be migrated to any code.
+	Allowlist types.MigrationAllowlist `protobuf:"bytes,1,opt,name=allowlist,proto3" json:"allowlist"`
 }
 
-func (m *QueryInactiveContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryMigrationAllowlistResponse) Reset()         { *m = QueryMigrationAllowlistResponse{} }
+func (m *QueryMigrationAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationAllowlistResponse) ProtoMessage()    {}
+func (*QueryMigrationAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{7}
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInactiveContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMigrationAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationAllowlistResponse.Merge(m, src)
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMigrationAllowlistResponse proto.InternalMessageInfo
+
+// QueryContractStorageRequest is the request type for the Query/ContractStorage RPC method.
+type QueryContractStorageRequest struct {
+	// address is the address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryInactiveContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractStorageRequest) Reset()         { *m = QueryContractStorageRequest{} }
+func (m *QueryContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageRequest) ProtoMessage()    {}
+func (*QueryContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{8}
+}
+func (m *QueryContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageRequest.Merge(m, src)
+}
+func (m *QueryContractStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryContractStorageRequest proto.InternalMessageInfo
+
+// QueryContractStorageResponse is the response type for the Query/ContractStorage RPC method.
+type QueryContractStorageResponse struct {
+	// storage is the storage usage of the contract and the deposit locked for it
+	Storage types.ContractStorage `protobuf:"bytes,1,opt,name=storage,proto3" json:"storage"`
+	// quota is the storage quota that applies to the contract, 0 or unlimited for no limit
+	Quota types.StorageQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
 }
 
-func (m *QueryInactiveContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractStorageResponse) Reset()         { *m = QueryContractStorageResponse{} }
+func (m *QueryContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageResponse) ProtoMessage()    {}
+func (*QueryContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{9}
+}
+func (m *QueryContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageResponse.Merge(m, src)
+}
+func (m *QueryContractStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryContractStorageResponse proto.InternalMessageInfo
+
+// QueryFeeAllowancesRequest is the request type for the Query/FeeAllowances RPC method.
+type QueryFeeAllowancesRequest struct {
+	// address is the address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeAllowancesRequest) Reset()         { *m = QueryFeeAllowancesRequest{} }
+func (m *QueryFeeAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAllowancesRequest) ProtoMessage()    {}
+func (*QueryFeeAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{10}
+}
+func (m *QueryFeeAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAllowancesRequest.Merge(m, src)
+}
+func (m *QueryFeeAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAllowancesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeAllowancesRequest proto.InternalMessageInfo
+
+// QueryFeeAllowancesResponse is the response type for the Query/FeeAllowances RPC method.
+type QueryFeeAllowancesResponse struct {
+	// allowances are the tx fees the contract pays for grantees
+	Allowances []types.FeeAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeAllowancesResponse) Reset()         { *m = QueryFeeAllowancesResponse{} }
+func (m *QueryFeeAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAllowancesResponse) ProtoMessage()    {}
+func (*QueryFeeAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{11}
+}
+func (m *QueryFeeAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAllowancesResponse.Merge(m, src)
+}
+func (m *QueryFeeAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAllowancesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeAllowancesResponse proto.InternalMessageInfo
+
+// QuerySchedulesRequest is the request type for the Query/Schedules RPC method.
+type QuerySchedulesRequest struct {
+	// address is the address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryInactiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Inactivated {
-		i--
-		if m.Inactivated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QuerySchedulesRequest) Reset()         { *m = QuerySchedulesRequest{} }
+func (m *QuerySchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySchedulesRequest) ProtoMessage()    {}
+func (*QuerySchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{12}
+}
+func (m *QuerySchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchedulesRequest.Merge(m, src)
+}
+func (m *QuerySchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchedulesRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QuerySchedulesRequest proto.InternalMessageInfo
+
+// QuerySchedulesResponse is the response type for the Query/Schedules RPC method.
+type QuerySchedulesResponse struct {
+	// schedules are the callbacks the contract registered
+	Schedules []types.Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySchedulesResponse) Reset()         { *m = QuerySchedulesResponse{} }
+func (m *QuerySchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySchedulesResponse) ProtoMessage()    {}
+func (*QuerySchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{13}
+}
+func (m *QuerySchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryInactiveContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchedulesResponse.Merge(m, src)
+}
+func (m *QuerySchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySchedulesResponse proto.InternalMessageInfo
+
+// QueryPrivilegedContractsRequest is the request type for the Query/PrivilegedContracts RPC method.
+type QueryPrivilegedContractsRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPrivilegedContractsRequest) Reset()         { *m = QueryPrivilegedContractsRequest{} }
+func (m *QueryPrivilegedContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrivilegedContractsRequest) ProtoMessage()    {}
+func (*QueryPrivilegedContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{14}
+}
+func (m *QueryPrivilegedContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrivilegedContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrivilegedContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryPrivilegedContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrivilegedContractsRequest.Merge(m, src)
+}
+func (m *QueryPrivilegedContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrivilegedContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrivilegedContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrivilegedContractsRequest proto.InternalMessageInfo
+
+// QueryPrivilegedContractsResponse is the response type for the Query/PrivilegedContracts RPC method.
+type QueryPrivilegedContractsResponse struct {
+	// contracts are the registered contracts
+	Contracts []types.PrivilegedContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPrivilegedContractsResponse) Reset()         { *m = QueryPrivilegedContractsResponse{} }
+func (m *QueryPrivilegedContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrivilegedContractsResponse) ProtoMessage()    {}
+func (*QueryPrivilegedContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{15}
+}
+func (m *QueryPrivilegedContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrivilegedContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrivilegedContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPrivilegedContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrivilegedContractsResponse.Merge(m, src)
+}
+func (m *QueryPrivilegedContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrivilegedContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrivilegedContractsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInactiveContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPrivilegedContractsResponse proto.InternalMessageInfo
+
+// QueryAcceptedStargateQueriesRequest is the request type for the Query/AcceptedStargateQueries RPC method.
+type QueryAcceptedStargateQueriesRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) Reset()         { *m = QueryAcceptedStargateQueriesRequest{} }
+func (m *QueryAcceptedStargateQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedStargateQueriesRequest) ProtoMessage()    {}
+func (*QueryAcceptedStargateQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{16}
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedStargateQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedStargateQueriesRequest.Merge(m, src)
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedStargateQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedStargateQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedStargateQueriesRequest proto.InternalMessageInfo
+
+// QueryAcceptedStargateQueriesResponse is the response type for the Query/AcceptedStargateQueries RPC method.
+type QueryAcceptedStargateQueriesResponse struct {
+	// queries are the accepted queries ordered by path
+	Queries []types.AcceptedStargateQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) Reset()         { *m = QueryAcceptedStargateQueriesResponse{} }
+func (m *QueryAcceptedStargateQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAcceptedStargateQueriesResponse) ProtoMessage()    {}
+func (*QueryAcceptedStargateQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1bdb66850244231, []int{17}
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAcceptedStargateQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAcceptedStargateQueriesResponse.Merge(m, src)
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAcceptedStargateQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAcceptedStargateQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAcceptedStargateQueriesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryInactiveContractsRequest)(nil), "lbm.wasm.v1.QueryInactiveContractsRequest")
+	proto.RegisterType((*QueryInactiveContractsResponse)(nil), "lbm.wasm.v1.QueryInactiveContractsResponse")
+	proto.RegisterType((*QueryInactiveContractRequest)(nil), "lbm.wasm.v1.QueryInactiveContractRequest")
+	proto.RegisterType((*QueryInactiveContractResponse)(nil), "lbm.wasm.v1.QueryInactiveContractResponse")
+	proto.RegisterType((*QueryPendingMigrationsRequest)(nil), "lbm.wasm.v1.QueryPendingMigrationsRequest")
+	proto.RegisterType((*QueryPendingMigrationsResponse)(nil), "lbm.wasm.v1.QueryPendingMigrationsResponse")
+	proto.RegisterType((*QueryMigrationAllowlistRequest)(nil), "lbm.wasm.v1.QueryMigrationAllowlistRequest")
+	proto.RegisterType((*QueryMigrationAllowlistResponse)(nil), "lbm.wasm.v1.QueryMigrationAllowlistResponse")
+	proto.RegisterType((*QueryContractStorageRequest)(nil), "lbm.wasm.v1.QueryContractStorageRequest")
+	proto.RegisterType((*QueryContractStorageResponse)(nil), "lbm.wasm.v1.QueryContractStorageResponse")
+	proto.RegisterType((*QueryFeeAllowancesRequest)(nil), "lbm.wasm.v1.QueryFeeAllowancesRequest")
+	proto.RegisterType((*QueryFeeAllowancesResponse)(nil), "lbm.wasm.v1.QueryFeeAllowancesResponse")
+	proto.RegisterType((*QuerySchedulesRequest)(nil), "lbm.wasm.v1.QuerySchedulesRequest")
+	proto.RegisterType((*QuerySchedulesResponse)(nil), "lbm.wasm.v1.QuerySchedulesResponse")
+	proto.RegisterType((*QueryPrivilegedContractsRequest)(nil), "lbm.wasm.v1.QueryPrivilegedContractsRequest")
+	proto.RegisterType((*QueryPrivilegedContractsResponse)(nil), "lbm.wasm.v1.QueryPrivilegedContractsResponse")
+	proto.RegisterType((*QueryAcceptedStargateQueriesRequest)(nil), "lbm.wasm.v1.QueryAcceptedStargateQueriesRequest")
+	proto.RegisterType((*QueryAcceptedStargateQueriesResponse)(nil), "lbm.wasm.v1.QueryAcceptedStargateQueriesResponse")
+}
+
+func init() { proto.RegisterFile("lbm/wasm/v1/query.proto", fileDescriptor_f1bdb66850244231) }
+
+var fileDescriptor_f1bdb66850244231 = []byte{
+	// 1024 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0x94, 0x5d, 0x4a, 0x5e, 0x84, 0x60, 0x87, 0x1f, 0x5b, 0x4c, 0x71, 0xdb, 0x74, 0x69,
+	0x9a, 0x16, 0xec, 0x4d, 0x57, 0xfc, 0x50, 0x91, 0x90, 0xba, 0xa0, 0x2d, 0x7b, 0x40, 0xea, 0xa6,
+	0x07, 0x24, 0x2e, 0xd1, 0xc4, 0x99, 0x7a, 0x2d, 0x1c, 0x4f, 0x9a, 0x71, 0xb2, 0x54, 0xa8, 0x17,
+	0x2e, 0x7b, 0x45, 0x42, 0x2b, 0x38, 0xb0, 0x07, 0x6e, 0x48, 0x20, 0x6e, 0x48, 0x9c, 0x39, 0xf5,
+	0x58, 0x89, 0x0b, 0x27, 0x04, 0x2d, 0x7f, 0x08, 0xca, 0xf8, 0x8d, 0xe3, 0xd8, 0xce, 0x0f, 0x50,
+	0xe0, 0xd6, 0x8e, 0xdf, 0xf7, 0xbe, 0xef, 0x7d, 0xf3, 0xec, 0xf7, 0x02, 0xd7, 0xfd, 0x66, 0xdb,
+	0x7e, 0xc0, 0x64, 0xdb, 0xee, 0xd7, 0xec, 0xe3, 0x1e, 0xef, 0x9e, 0x58, 0x9d, 0xae, 0x08, 0x05,
+	0x2d, 0xf9, 0xcd, 0xb6, 0x35, 0x78, 0x60, 0xf5, 0x6b, 0xc6, 0xf3, 0xae, 0x70, 0x85, 0x3a, 0xb7,
+	0x07, 0x7f, 0x45, 0x21, 0xc6, 0xb2, 0x2b, 0x84, 0xeb, 0x73, 0x9b, 0x75, 0x3c, 0x9b, 0x05, 0x81,
+	0x08, 0x59, 0xe8, 0x89, 0x40, 0xe2, 0xd3, 0x2d, 0x47, 0xc8, 0xb6, 0x90, 0x76, 0x93, 0x49, 0x1e,
+	0x65, 0xb6, 0xfb, 0xb5, 0x26, 0x0f, 0x59, 0xcd, 0xee, 0x30, 0xd7, 0x0b, 0x54, 0xb0, 0xce, 0x34,
+	0x88, 0x55, 0x2a, 0xb4, 0x94, 0xf0, 0xa4, 0xc3, 0x31, 0x53, 0xd9, 0x85, 0x57, 0xee, 0x0d, 0xf0,
+	0x77, 0x03, 0xe6, 0x84, 0x5e, 0x9f, 0xbf, 0x27, 0x82, 0xb0, 0xcb, 0x9c, 0x50, 0xd6, 0xf9, 0x71,
+	0x8f, 0xcb, 0x90, 0xde, 0x01, 0x18, 0xa6, 0x5c, 0x22, 0xab, 0x64, 0xb3, 0xb4, 0xb3, 0x61, 0x45,
+	0xfc, 0xd6, 0x80, 0xdf, 0x8a, 0x2a, 0x43, 0x7e, 0xeb, 0x80, 0xb9, 0x1c, 0xb1, 0xf5, 0x04, 0xb2,
+	0xfc, 0x90, 0x80, 0x39, 0x8e, 0x49, 0x76, 0x44, 0x20, 0x39, 0x5d, 0x86, 0x22, 0x6b, 0xb5, 0xba,
+	0x5c, 0x4a, 0x2e, 0x97, 0xc8, 0xea, 0x13, 0x9b, 0xc5, 0xfa, 0xf0, 0x80, 0xee, 0x8f, 0x08, 0x59,
+	0x50, 0x42, 0x2a, 0x53, 0x85, 0x44, 0xa9, 0x47, 0x94, 0xbc, 0x0d, 0xcb, 0xb9, 0x42, 0x74, 0xc5,
+	0x4b, 0xb0, 0x88, 0xac, 0xaa, 0xdc, 0x62, 0x5d, 0xff, 0x5b, 0x3e, 0x1d, 0x63, 0x56, 0x5c, 0xc1,
+	0x2a, 0x94, 0xbc, 0xe8, 0x19, 0x0b, 0x79, 0x4b, 0xc1, 0x9f, 0xaa, 0x27, 0x8f, 0xe8, 0x2e, 0x5c,
+	0xf1, 0x82, 0x23, 0xb1, 0xb4, 0x90, 0x30, 0x52, 0x75, 0x02, 0xb6, 0x83, 0x95, 0xce, 0x7d, 0x37,
+	0x38, 0x12, 0x75, 0x85, 0x89, 0xef, 0xea, 0x80, 0x07, 0x2d, 0x2f, 0x70, 0x3f, 0xf4, 0xdc, 0x6e,
+	0xd4, 0x15, 0xf3, 0xbe, 0xab, 0x5f, 0xf4, 0x5d, 0xe5, 0x30, 0x61, 0xa5, 0x1f, 0x01, 0xed, 0x44,
+	0x0f, 0x1b, 0xed, 0xf8, 0xa9, 0xba, 0xb4, 0xd2, 0x4e, 0x39, 0x5b, 0x55, 0x3a, 0xd1, 0xed, 0x2b,
+	0x67, 0xbf, 0xaf, 0x14, 0xea, 0xd7, 0x3a, 0x69, 0x82, 0xf9, 0x5d, 0xf3, 0x2e, 0xd6, 0x10, 0xe7,
+	0xde, 0xf3, 0x7d, 0xf1, 0xc0, 0xf7, 0xe4, 0x0c, 0x17, 0xfd, 0x09, 0xac, 0x8c, 0xc5, 0xa2, 0x01,
+	0x1f, 0x40, 0x91, 0xe9, 0x43, 0xb4, 0xfa, 0x46, 0xb6, 0xee, 0x6c, 0x02, 0xac, 0x7c, 0x08, 0x2e,
+	0xbf, 0x05, 0x2f, 0x2b, 0x32, 0x7d, 0xe3, 0x87, 0xa1, 0xe8, 0x0e, 0x2f, 0x66, 0x82, 0xca, 0xc7,
+	0x04, 0x96, 0xf3, 0x91, 0xa8, 0x71, 0x0f, 0x16, 0x65, 0x74, 0x84, 0x0a, 0xd7, 0xb2, 0x0a, 0x53,
+	0x58, 0x94, 0xa7, 0x71, 0x74, 0x17, 0xae, 0x1e, 0xf7, 0x44, 0xc8, 0xf0, 0x26, 0xcc, 0x6c, 0x02,
+	0x04, 0xde, 0x1b, 0x44, 0x21, 0x3a, 0x82, 0x94, 0x4f, 0xe1, 0x25, 0x25, 0xef, 0x0e, 0xe7, 0xaa,
+	0x7c, 0x16, 0x38, 0x5c, 0x4e, 0x2d, 0x2b, 0xd5, 0xc5, 0x0b, 0xff, 0xba, 0x8b, 0xbf, 0x27, 0x60,
+	0xe4, 0xf1, 0xa3, 0x39, 0xef, 0x03, 0xb0, 0xf8, 0x14, 0x3b, 0x37, 0xa7, 0xbc, 0x24, 0x18, 0xcb,
+	0x4b, 0xe0, 0xe6, 0xd7, 0xae, 0x27, 0xf0, 0x82, 0x12, 0x7b, 0xe8, 0xdc, 0xe7, 0xad, 0x9e, 0xff,
+	0x7f, 0x1a, 0xf5, 0x2d, 0x81, 0x17, 0xd3, 0xdc, 0x68, 0xd2, 0xbb, 0x50, 0x94, 0xfa, 0x10, 0x3d,
+	0x32, 0x72, 0x5a, 0x00, 0x43, 0x74, 0x6f, 0xc7, 0x90, 0xf9, 0xd9, 0xe3, 0xe1, 0x1b, 0x79, 0xd0,
+	0xf5, 0xfa, 0x9e, 0xcf, 0x5d, 0xde, 0xfa, 0xcf, 0x26, 0xd5, 0x4f, 0x04, 0x56, 0xc7, 0x73, 0x0d,
+	0x5f, 0x7f, 0x47, 0x1f, 0xa2, 0x31, 0x39, 0xaf, 0x7f, 0x36, 0x83, 0xb6, 0x28, 0x06, 0xcf, 0xcf,
+	0xa2, 0x36, 0xac, 0x2b, 0xd9, 0x7b, 0x8e, 0xc3, 0x3b, 0x21, 0x6f, 0x1d, 0x86, 0xac, 0xeb, 0xb2,
+	0x90, 0x0f, 0x0e, 0x3d, 0x3e, 0x77, 0x9b, 0x7e, 0x26, 0x70, 0x63, 0x32, 0x1f, 0x5a, 0xb5, 0x0f,
+	0x8b, 0xc7, 0xd1, 0x11, 0x1a, 0x55, 0xc9, 0x1a, 0x95, 0x97, 0xe3, 0x44, 0x7f, 0x8b, 0x10, 0x3d,
+	0x37, 0xa7, 0x76, 0x1e, 0x95, 0xe0, 0xaa, 0x62, 0xa0, 0x8f, 0x08, 0x5c, 0xcb, 0x2c, 0x24, 0x74,
+	0xcb, 0x4a, 0x2c, 0x68, 0xd6, 0xc4, 0xfd, 0xc8, 0xd8, 0x9e, 0x29, 0x36, 0x12, 0x51, 0xae, 0x7c,
+	0xfe, 0xeb, 0x5f, 0x5f, 0x2e, 0xac, 0xd1, 0x15, 0x3b, 0xb9, 0x1a, 0xe2, 0x7e, 0xc0, 0x1b, 0xc3,
+	0xa6, 0xf8, 0x86, 0xc0, 0xb3, 0xe9, 0x34, 0xb4, 0x3a, 0x9d, 0x4a, 0xab, 0xda, 0x9a, 0x25, 0x14,
+	0x45, 0xd5, 0x94, 0xa8, 0x6d, 0x5a, 0x9d, 0x22, 0xca, 0xfe, 0x0c, 0x3f, 0x3c, 0xa7, 0xca, 0xb6,
+	0xcc, 0x6e, 0x90, 0x67, 0xdb, 0xb8, 0x55, 0xc5, 0xd8, 0x9e, 0x29, 0x76, 0xa2, 0x6d, 0xd9, 0xfd,
+	0x83, 0xfe, 0x40, 0x80, 0x66, 0x47, 0x2e, 0xcd, 0x21, 0x1b, 0xbb, 0x15, 0x18, 0xaf, 0xcd, 0x16,
+	0x8c, 0xd2, 0xde, 0x51, 0xd2, 0xde, 0xa0, 0xb7, 0x46, 0xa4, 0x69, 0xcf, 0x86, 0x96, 0xd9, 0xb1,
+	0xca, 0x46, 0x3c, 0xf9, 0xe9, 0x57, 0x04, 0x9e, 0x49, 0xcd, 0x5f, 0xba, 0x99, 0xa5, 0xcf, 0x5f,
+	0x0c, 0x8c, 0xea, 0x0c, 0x91, 0xa8, 0xd2, 0x56, 0x2a, 0xab, 0xb4, 0x32, 0x4d, 0xa5, 0x1e, 0xfb,
+	0x5f, 0x13, 0x78, 0x7a, 0x64, 0x6c, 0xd2, 0x8d, 0x2c, 0x5b, 0xde, 0x5c, 0x37, 0x2a, 0x53, 0xe3,
+	0x50, 0xd3, 0x9b, 0x4a, 0xd3, 0x4d, 0x6a, 0x4d, 0xd3, 0x74, 0xc4, 0x79, 0x23, 0x31, 0x71, 0x1f,
+	0x12, 0x28, 0xc6, 0x83, 0x8a, 0x96, 0xb3, 0x74, 0xe9, 0x09, 0x6a, 0xac, 0x4f, 0x8c, 0x99, 0xf8,
+	0x16, 0xe4, 0x59, 0x14, 0x73, 0x3f, 0x26, 0xf0, 0x5c, 0xce, 0x8c, 0xa0, 0x39, 0x1d, 0x34, 0x7e,
+	0x6c, 0x19, 0xaf, 0xcf, 0x18, 0x8d, 0x3a, 0xab, 0x4a, 0xe7, 0x3a, 0x5d, 0x1b, 0x7d, 0x17, 0x62,
+	0x44, 0xe2, 0x23, 0xf2, 0x23, 0x81, 0xeb, 0x63, 0x3e, 0xce, 0xf4, 0x66, 0x96, 0x75, 0xf2, 0xdc,
+	0x30, 0x6a, 0xff, 0x00, 0x81, 0x5a, 0x2d, 0xa5, 0x75, 0x93, 0x6e, 0x8c, 0x68, 0x65, 0x88, 0x6a,
+	0x48, 0x84, 0x35, 0xf0, 0x03, 0x7f, 0x7b, 0xff, 0xec, 0x4f, 0xb3, 0xf0, 0xdd, 0x85, 0x59, 0x38,
+	0xbb, 0x30, 0xc9, 0xf9, 0x85, 0x49, 0xfe, 0xb8, 0x30, 0xc9, 0x17, 0x97, 0x66, 0xe1, 0xfc, 0xd2,
+	0x2c, 0xfc, 0x76, 0x69, 0x16, 0x3e, 0x7e, 0xd5, 0xf5, 0xc2, 0xfb, 0xbd, 0xa6, 0xe5, 0x88, 0xb6,
+	0xed, 0x7b, 0x01, 0x57, 0x49, 0x5b, 0xf6, 0xa7, 0x51, 0x72, 0xbf, 0xd9, 0x56, 0xbf, 0x6d, 0x9b,
+	0x4f, 0xaa, 0x1f, 0xb7, 0xb7, 0xfe, 0x1e, 0x00, 0xec, 0x56, 0x44, 0x3c, 0x82, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// InactiveContracts queries all inactive contracts
+	InactiveContracts(ctx context.Context, in *QueryInactiveContractsRequest, opts ...grpc.CallOption) (*QueryInactiveContractsResponse, error)
+	InactiveContract(ctx context.Context, in *QueryInactiveContractRequest, opts ...grpc.CallOption) (*QueryInactiveContractResponse, error)
+	// PendingMigrations queries all queued migrations ordered by contract address
+	PendingMigrations(ctx context.Context, in *QueryPendingMigrationsRequest, opts ...grpc.CallOption) (*QueryPendingMigrationsResponse, error)
+	// MigrationAllowlist queries the codes a contract can be migrated to
+	MigrationAllowlist(ctx context.Context, in *QueryMigrationAllowlistRequest, opts ...grpc.CallOption) (*QueryMigrationAllowlistResponse, error)
+	// ContractStorage queries the storage usage of a contract, the deposit locked for it and its storage quota
+	ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error)
+	// FeeAllowances queries the tx fees a contract pays for grantees ordered by grantee address
+	FeeAllowances(ctx context.Context, in *QueryFeeAllowancesRequest, opts ...grpc.CallOption) (*QueryFeeAllowancesResponse, error)
+	// Schedules queries the scheduled callbacks of a contract ordered by schedule id
+	Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error)
+	// PrivilegedContracts queries the contracts that are called on begin and end block
+	PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error)
+	// AcceptedStargateQueries queries the gRPC queries that contracts are allowed to call with a stargate query
+	AcceptedStargateQueries(ctx context.Context, in *QueryAcceptedStargateQueriesRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateQueriesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) InactiveContracts(ctx context.Context, in *QueryInactiveContractsRequest, opts ...grpc.CallOption) (*QueryInactiveContractsResponse, error) {
+	out := new(QueryInactiveContractsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/InactiveContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InactiveContract(ctx context.Context, in *QueryInactiveContractRequest, opts ...grpc.CallOption) (*QueryInactiveContractResponse, error) {
+	out := new(QueryInactiveContractResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/InactiveContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMigrations(ctx context.Context, in *QueryPendingMigrationsRequest, opts ...grpc.CallOption) (*QueryPendingMigrationsResponse, error) {
+	out := new(QueryPendingMigrationsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/PendingMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationAllowlist(ctx context.Context, in *QueryMigrationAllowlistRequest, opts ...grpc.CallOption) (*QueryMigrationAllowlistResponse, error) {
+	out := new(QueryMigrationAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/MigrationAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error) {
+	out := new(QueryContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/ContractStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAllowances(ctx context.Context, in *QueryFeeAllowancesRequest, opts ...grpc.CallOption) (*QueryFeeAllowancesResponse, error) {
+	out := new(QueryFeeAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/FeeAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error) {
+	out := new(QuerySchedulesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/Schedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrivilegedContracts(ctx context.Context, in *QueryPrivilegedContractsRequest, opts ...grpc.CallOption) (*QueryPrivilegedContractsResponse, error) {
+	out := new(QueryPrivilegedContractsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/PrivilegedContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AcceptedStargateQueries(ctx context.Context, in *QueryAcceptedStargateQueriesRequest, opts ...grpc.CallOption) (*QueryAcceptedStargateQueriesResponse, error) {
+	out := new(QueryAcceptedStargateQueriesResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Query/AcceptedStargateQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// InactiveContracts queries all inactive contracts
+	InactiveContracts(context.Context, *QueryInactiveContractsRequest) (*QueryInactiveContractsResponse, error)
+	InactiveContract(context.Context, *QueryInactiveContractRequest) (*QueryInactiveContractResponse, error)
+	// PendingMigrations queries all queued migrations ordered by contract address
+	PendingMigrations(context.Context, *QueryPendingMigrationsRequest) (*QueryPendingMigrationsResponse, error)
+	// MigrationAllowlist queries the codes a contract can be migrated to
+	MigrationAllowlist(context.Context, *QueryMigrationAllowlistRequest) (*QueryMigrationAllowlistResponse, error)
+	// ContractStorage queries the storage usage of a contract, the deposit locked for it and its storage quota
+	ContractStorage(context.Context, *QueryContractStorageRequest) (*QueryContractStorageResponse, error)
+	// FeeAllowances queries the tx fees a contract pays for grantees ordered by grantee address
+	FeeAllowances(context.Context, *QueryFeeAllowancesRequest) (*QueryFeeAllowancesResponse, error)
+	// Schedules queries the scheduled callbacks of a contract ordered by schedule id
+	Schedules(context.Context, *QuerySchedulesRequest) (*QuerySchedulesResponse, error)
+	// PrivilegedContracts queries the contracts that are called on begin and end block
+	PrivilegedContracts(context.Context, *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error)
+	// AcceptedStargateQueries queries the gRPC queries that contracts are allowed to call with a stargate query
+	AcceptedStargateQueries(context.Context, *QueryAcceptedStargateQueriesRequest) (*QueryAcceptedStargateQueriesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) InactiveContracts(ctx context.Context, req *QueryInactiveContractsRequest) (*QueryInactiveContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveContracts not implemented")
+}
+func (*UnimplementedQueryServer) InactiveContract(ctx context.Context, req *QueryInactiveContractRequest) (*QueryInactiveContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveContract not implemented")
+}
+func (*UnimplementedQueryServer) PendingMigrations(ctx context.Context, req *QueryPendingMigrationsRequest) (*QueryPendingMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMigrations not implemented")
+}
+func (*UnimplementedQueryServer) MigrationAllowlist(ctx context.Context, req *QueryMigrationAllowlistRequest) (*QueryMigrationAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) ContractStorage(ctx context.Context, req *QueryContractStorageRequest) (*QueryContractStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorage not implemented")
+}
+func (*UnimplementedQueryServer) FeeAllowances(ctx context.Context, req *QueryFeeAllowancesRequest) (*QueryFeeAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAllowances not implemented")
+}
+func (*UnimplementedQueryServer) Schedules(ctx context.Context, req *QuerySchedulesRequest) (*QuerySchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedules not implemented")
+}
+func (*UnimplementedQueryServer) PrivilegedContracts(ctx context.Context, req *QueryPrivilegedContractsRequest) (*QueryPrivilegedContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrivilegedContracts not implemented")
+}
+func (*UnimplementedQueryServer) AcceptedStargateQueries(ctx context.Context, req *QueryAcceptedStargateQueriesRequest) (*QueryAcceptedStargateQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptedStargateQueries not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_InactiveContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/InactiveContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveContracts(ctx, req.(*QueryInactiveContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InactiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/InactiveContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveContract(ctx, req.(*QueryInactiveContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/PendingMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMigrations(ctx, req.(*QueryPendingMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/MigrationAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationAllowlist(ctx, req.(*QueryMigrationAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/ContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorage(ctx, req.(*QueryContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/FeeAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAllowances(ctx, req.(*QueryFeeAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/Schedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedules(ctx, req.(*QuerySchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrivilegedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrivilegedContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrivilegedContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/PrivilegedContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrivilegedContracts(ctx, req.(*QueryPrivilegedContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AcceptedStargateQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAcceptedStargateQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AcceptedStargateQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Query/AcceptedStargateQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AcceptedStargateQueries(ctx, req.(*QueryAcceptedStargateQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.wasm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InactiveContracts",
+			Handler:    _Query_InactiveContracts_Handler,
+		},
+		{
+			MethodName: "InactiveContract",
+			Handler:    _Query_InactiveContract_Handler,
+		},
+		{
+			MethodName: "PendingMigrations",
+			Handler:    _Query_PendingMigrations_Handler,
+		},
+		{
+			MethodName: "MigrationAllowlist",
+			Handler:    _Query_MigrationAllowlist_Handler,
+		},
+		{
+			MethodName: "ContractStorage",
+			Handler:    _Query_ContractStorage_Handler,
+		},
+		{
+			MethodName: "FeeAllowances",
+			Handler:    _Query_FeeAllowances_Handler,
+		},
+		{
+			MethodName: "Schedules",
+			Handler:    _Query_Schedules_Handler,
+		},
+		{
+			MethodName: "PrivilegedContracts",
+			Handler:    _Query_PrivilegedContracts_Handler,
+		},
+		{
+			MethodName: "AcceptedStargateQueries",
+			Handler:    _Query_AcceptedStargateQueries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lbm/wasm/v1/query.proto",
+}
+
+func (m *QueryInactiveContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Inactivated {
+		i--
+		if m.Inactivated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMigrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMigrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMigrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMigrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMigrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMigrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingMigrations) > 0 {
+		for iNdEx := len(m.PendingMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMigrationAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMigrationAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrivilegedContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrivilegedContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrivilegedContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrivilegedContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrivilegedContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrivilegedContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInactiveContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInactiveContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inactivated {
+		n += 2
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMigrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMigrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingMigrations) > 0 {
+		for _, e := range m.PendingMigrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMigrationAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMigrationAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowlist.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Storage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrivilegedContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrivilegedContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcceptedStargateQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAcceptedStargateQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInactiveContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInactiveContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInactiveContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInactiveContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inactivated = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &types.InactiveContractInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMigrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMigrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMigrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMigrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMigrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMigrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMigrations = append(m.PendingMigrations, types.PendingMigration{})
+			if err := m.PendingMigrations[len(m.PendingMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, types.FeeAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryInactiveContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryInactiveContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Inactivated {
-		n += 2
+func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, types.Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryInactiveContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPrivilegedContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -586,10 +3708,10 @@ func (m *QueryInactiveContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInactiveContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInactiveContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrivilegedContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -649,7 +3771,7 @@ func (m *QueryInactiveContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInactiveContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPrivilegedContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -672,17 +3794,17 @@ func (m *QueryInactiveContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInactiveContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInactiveContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrivilegedContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -692,23 +3814,25 @@ func (m *QueryInactiveContractsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Contracts = append(m.Contracts, types.PrivilegedContract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -767,7 +3891,7 @@ func (m *QueryInactiveContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInactiveContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAcceptedStargateQueriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -790,17 +3914,17 @@ func (m *QueryInactiveContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInactiveContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInactiveContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -810,23 +3934,27 @@ func (m *QueryInactiveContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -849,7 +3977,7 @@ func (m *QueryInactiveContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInactiveContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAcceptedStargateQueriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,17 +4000,17 @@ func (m *QueryInactiveContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInactiveContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInactiveContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAcceptedStargateQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inactivated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -892,12 +4020,62 @@ func (m *QueryInactiveContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Inactivated = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, types.AcceptedStargateQuery{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
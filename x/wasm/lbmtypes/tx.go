@@ -1,6 +1,10 @@
 package lbmtypes
 
 import (
+	"crypto/sha256"
+	"math"
+	"strings"
+
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 
@@ -58,3 +62,514 @@ func (msg MsgStoreCodeAndInstantiateContract) GetSigners() []sdk.AccAddress {
 	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
 	return []sdk.AccAddress{senderAddr}
 }
+
+func (msg MsgPurgeContract) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgPurgeContract) Type() string {
+	return "purge-contract"
+}
+
+func (msg MsgPurgeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+		return sdkerrors.Wrap(err, "beneficiary")
+	}
+	return nil
+}
+
+func (msg MsgPurgeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPurgeContract) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgStoreCodeBegin) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgStoreCodeBegin) Type() string {
+	return "store-code-begin"
+}
+
+func (msg MsgStoreCodeBegin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if len(msg.Checksum) != sha256.Size {
+		return sdkerrors.Wrapf(wasmtypes.ErrInvalid, "checksum must be %d bytes", sha256.Size)
+	}
+	if msg.TotalSize == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "total size")
+	}
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+	return nil
+}
+
+func (msg MsgStoreCodeBegin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStoreCodeBegin) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgStoreCodeChunk) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgStoreCodeChunk) Type() string {
+	return "store-code-chunk"
+}
+
+func (msg MsgStoreCodeChunk) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.SessionID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "session id")
+	}
+	if len(msg.Data) == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "data")
+	}
+	return nil
+}
+
+func (msg MsgStoreCodeChunk) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStoreCodeChunk) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgStoreCodeCommit) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgStoreCodeCommit) Type() string {
+	return "store-code-commit"
+}
+
+func (msg MsgStoreCodeCommit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.SessionID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "session id")
+	}
+	return nil
+}
+
+func (msg MsgStoreCodeCommit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStoreCodeCommit) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgProposeAdmin) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgProposeAdmin) Type() string {
+	return "propose-contract-admin"
+}
+
+func (msg MsgProposeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	if strings.EqualFold(msg.Sender, msg.NewAdmin) {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalidMsg, "new admin is the same as the old")
+	}
+	return nil
+}
+
+func (msg MsgProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgAcceptAdmin) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgAcceptAdmin) Type() string {
+	return "accept-contract-admin"
+}
+
+func (msg MsgAcceptAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelPendingAdmin) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgCancelPendingAdmin) Type() string {
+	return "cancel-pending-contract-admin"
+}
+
+func (msg MsgCancelPendingAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelPendingAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelPendingAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateMigrationDelay) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgUpdateMigrationDelay) Type() string {
+	return "update-migration-delay"
+}
+
+func (msg MsgUpdateMigrationDelay) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if msg.MigrationDelay > wasmtypes.MaxMigrationDelay {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "migration delay must not exceed %d", wasmtypes.MaxMigrationDelay)
+	}
+	return nil
+}
+
+func (msg MsgUpdateMigrationDelay) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateMigrationDelay) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelMigration) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgCancelMigration) Type() string {
+	return "cancel-migration"
+}
+
+func (msg MsgCancelMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelMigration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelMigration) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateMigrationAllowlist) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgUpdateMigrationAllowlist) Type() string {
+	return "update-migration-allowlist"
+}
+
+func (msg MsgUpdateMigrationAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return msg.Allowlist().ValidateBasic()
+}
+
+func (msg MsgUpdateMigrationAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateMigrationAllowlist) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+// Allowlist returns the migration allowlist that replaces the current one
+func (msg MsgUpdateMigrationAllowlist) Allowlist() wasmtypes.MigrationAllowlist {
+	return wasmtypes.MigrationAllowlist{CodeIDs: msg.CodeIDs, Checksums: msg.Checksums}
+}
+
+func (msg MsgSetCodeMetadata) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgSetCodeMetadata) Type() string {
+	return "set-code-metadata"
+}
+
+func (msg MsgSetCodeMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "code id")
+	}
+	if msg.Metadata.IsEmpty() {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "metadata")
+	}
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "metadata")
+	}
+	return nil
+}
+
+func (msg MsgSetCodeMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCodeMetadata) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateContractMetadata) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgUpdateContractMetadata) Type() string {
+	return "update-contract-metadata"
+}
+
+func (msg MsgUpdateContractMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "metadata")
+	}
+	return nil
+}
+
+func (msg MsgUpdateContractMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateContractMetadata) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateFeeAllowance) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgUpdateFeeAllowance) Type() string {
+	return "update-fee-allowance"
+}
+
+func (msg MsgUpdateFeeAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return msg.Allowance().ValidateBasic()
+}
+
+func (msg MsgUpdateFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateFeeAllowance) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+// Allowance returns the fee allowance that replaces the current one of the grantee
+func (msg MsgUpdateFeeAllowance) Allowance() wasmtypes.FeeAllowance {
+	return wasmtypes.FeeAllowance{Grantee: msg.Grantee, SpendLimit: msg.SpendLimit}
+}
+
+func (msg MsgScheduleCallback) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgScheduleCallback) Type() string {
+	return "schedule-callback"
+}
+
+func (msg MsgScheduleCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.ExecuteHeight < 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrInvalid, "execute height must not be negative")
+	}
+	if msg.ExecuteHeight == 0 && msg.Interval == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "execute height or interval")
+	}
+	if msg.Interval > math.MaxInt32 {
+		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "interval must not exceed %d", math.MaxInt32)
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "gas limit")
+	}
+	if err := msg.GasDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "gas deposit")
+	}
+	return nil
+}
+
+func (msg MsgScheduleCallback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleCallback) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelSchedule) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgCancelSchedule) Type() string {
+	return "cancel-schedule"
+}
+
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if msg.ScheduleID == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "schedule id")
+	}
+	return nil
+}
+
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgExecuteContracts) Route() string {
+	return wasmtypes.RouterKey
+}
+
+func (msg MsgExecuteContracts) Type() string {
+	return "execute-contracts"
+}
+
+func (msg MsgExecuteContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if len(msg.Calls) == 0 {
+		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "calls")
+	}
+	for i, call := range msg.Calls {
+		if err := call.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "call %d", i)
+		}
+	}
+	return nil
+}
+
+func (msg MsgExecuteContracts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(wasmtypes.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExecuteContracts) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+// ValidateBasic performs basic validation of a call of MsgExecuteContracts
+func (c ContractCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !c.Funds.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "funds")
+	}
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	return nil
+}
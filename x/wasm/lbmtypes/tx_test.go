@@ -1,6 +1,7 @@
 package lbmtypes
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"testing"
@@ -135,3 +136,412 @@ func TestNewMsgStoreCodeAndInstantiateContractGetSigners(t *testing.T) {
 	bytes := sdk.MustAccAddressFromBech32(res[0].String())
 	require.Equal(t, "696e707574313131313131313131313131313131", fmt.Sprintf("%v", hex.EncodeToString(bytes)))
 }
+
+func TestPurgeContractValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+
+	cases := map[string]struct {
+		msg   MsgPurgeContract
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgPurgeContract{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgPurgeContract{
+				Sender:      goodAddress,
+				Contract:    goodAddress,
+				Beneficiary: goodAddress,
+			},
+			valid: true,
+		},
+		"bad sender": {
+			msg: MsgPurgeContract{
+				Sender:      badAddress,
+				Contract:    goodAddress,
+				Beneficiary: goodAddress,
+			},
+			valid: false,
+		},
+		"bad contract": {
+			msg: MsgPurgeContract{
+				Sender:      goodAddress,
+				Contract:    badAddress,
+				Beneficiary: goodAddress,
+			},
+			valid: false,
+		},
+		"missing beneficiary": {
+			msg: MsgPurgeContract{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestStoreCodeChunkedMsgsValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+	checksum := make([]byte, 32)
+
+	cases := map[string]struct {
+		msg   sdk.Msg
+		valid bool
+	}{
+		"begin correct": {
+			msg:   &MsgStoreCodeBegin{Sender: goodAddress, Checksum: checksum, TotalSize: 1},
+			valid: true,
+		},
+		"begin with instantiate permission": {
+			msg: &MsgStoreCodeBegin{
+				Sender:                goodAddress,
+				Checksum:              checksum,
+				TotalSize:             1,
+				InstantiatePermission: &wasmTypes.AllowNobody,
+			},
+			valid: true,
+		},
+		"begin bad sender": {
+			msg: &MsgStoreCodeBegin{Sender: badAddress, Checksum: checksum, TotalSize: 1},
+		},
+		"begin invalid checksum": {
+			msg: &MsgStoreCodeBegin{Sender: goodAddress, Checksum: checksum[1:], TotalSize: 1},
+		},
+		"begin zero total size": {
+			msg: &MsgStoreCodeBegin{Sender: goodAddress, Checksum: checksum},
+		},
+		"begin invalid instantiate permission": {
+			msg: &MsgStoreCodeBegin{
+				Sender:                goodAddress,
+				Checksum:              checksum,
+				TotalSize:             1,
+				InstantiatePermission: &wasmTypes.AccessConfig{},
+			},
+		},
+		"chunk correct": {
+			msg:   &MsgStoreCodeChunk{Sender: goodAddress, SessionID: 1, Data: []byte{0x1}},
+			valid: true,
+		},
+		"chunk bad sender": {
+			msg: &MsgStoreCodeChunk{Sender: badAddress, SessionID: 1, Data: []byte{0x1}},
+		},
+		"chunk without session id": {
+			msg: &MsgStoreCodeChunk{Sender: goodAddress, Data: []byte{0x1}},
+		},
+		"chunk without data": {
+			msg: &MsgStoreCodeChunk{Sender: goodAddress, SessionID: 1},
+		},
+		"commit correct": {
+			msg:   &MsgStoreCodeCommit{Sender: goodAddress, SessionID: 1},
+			valid: true,
+		},
+		"commit bad sender": {
+			msg: &MsgStoreCodeCommit{Sender: badAddress, SessionID: 1},
+		},
+		"commit without session id": {
+			msg: &MsgStoreCodeCommit{Sender: goodAddress},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTwoStepAdminMsgsValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+
+	cases := map[string]struct {
+		msg   sdk.Msg
+		valid bool
+	}{
+		"propose admin correct": {
+			msg:   &MsgProposeAdmin{Sender: goodAddress, NewAdmin: otherGoodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"propose admin bad new admin": {
+			msg:   &MsgProposeAdmin{Sender: goodAddress, NewAdmin: badAddress, Contract: goodAddress},
+			valid: false,
+		},
+		"propose admin same as sender": {
+			msg:   &MsgProposeAdmin{Sender: goodAddress, NewAdmin: goodAddress, Contract: goodAddress},
+			valid: false,
+		},
+		"propose admin bad contract": {
+			msg:   &MsgProposeAdmin{Sender: goodAddress, NewAdmin: otherGoodAddress, Contract: badAddress},
+			valid: false,
+		},
+		"accept admin correct": {
+			msg:   &MsgAcceptAdmin{Sender: goodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"accept admin bad sender": {
+			msg:   &MsgAcceptAdmin{Sender: badAddress, Contract: goodAddress},
+			valid: false,
+		},
+		"cancel pending admin correct": {
+			msg:   &MsgCancelPendingAdmin{Sender: goodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"cancel pending admin missing contract": {
+			msg:   &MsgCancelPendingAdmin{Sender: goodAddress},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMigrationQueueMsgsValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+
+	cases := map[string]struct {
+		msg   sdk.Msg
+		valid bool
+	}{
+		"update migration delay correct": {
+			msg:   &MsgUpdateMigrationDelay{Sender: goodAddress, Contract: goodAddress, MigrationDelay: 10},
+			valid: true,
+		},
+		"update migration delay to zero": {
+			msg:   &MsgUpdateMigrationDelay{Sender: goodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"update migration delay max": {
+			msg:   &MsgUpdateMigrationDelay{Sender: goodAddress, Contract: goodAddress, MigrationDelay: wasmTypes.MaxMigrationDelay},
+			valid: true,
+		},
+		"update migration delay exceeds max": {
+			msg:   &MsgUpdateMigrationDelay{Sender: goodAddress, Contract: goodAddress, MigrationDelay: wasmTypes.MaxMigrationDelay + 1},
+			valid: false,
+		},
+		"update migration delay bad contract": {
+			msg:   &MsgUpdateMigrationDelay{Sender: goodAddress, Contract: badAddress, MigrationDelay: 10},
+			valid: false,
+		},
+		"cancel migration correct": {
+			msg:   &MsgCancelMigration{Sender: goodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"cancel migration bad sender": {
+			msg:   &MsgCancelMigration{Sender: badAddress, Contract: goodAddress},
+			valid: false,
+		},
+		"update migration allowlist correct": {
+			msg:   &MsgUpdateMigrationAllowlist{Sender: goodAddress, Contract: goodAddress, CodeIDs: []uint64{1, 2}, Checksums: [][]byte{bytes.Repeat([]byte{1}, 32)}},
+			valid: true,
+		},
+		"update migration allowlist empty": {
+			msg:   &MsgUpdateMigrationAllowlist{Sender: goodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"update migration allowlist zero code id": {
+			msg:   &MsgUpdateMigrationAllowlist{Sender: goodAddress, Contract: goodAddress, CodeIDs: []uint64{0}},
+			valid: false,
+		},
+		"update migration allowlist duplicate code id": {
+			msg:   &MsgUpdateMigrationAllowlist{Sender: goodAddress, Contract: goodAddress, CodeIDs: []uint64{1, 1}},
+			valid: false,
+		},
+		"update migration allowlist invalid checksum": {
+			msg:   &MsgUpdateMigrationAllowlist{Sender: goodAddress, Contract: goodAddress, Checksums: [][]byte{{1}}},
+			valid: false,
+		},
+		"set code metadata correct": {
+			msg:   &MsgSetCodeMetadata{Sender: goodAddress, CodeID: 1, Metadata: wasmTypes.CodeMetadata{Source: "https://example.com/source"}},
+			valid: true,
+		},
+		"set code metadata empty": {
+			msg:   &MsgSetCodeMetadata{Sender: goodAddress, CodeID: 1},
+			valid: false,
+		},
+		"set code metadata without code id": {
+			msg:   &MsgSetCodeMetadata{Sender: goodAddress, Metadata: wasmTypes.CodeMetadata{Source: "https://example.com/source"}},
+			valid: false,
+		},
+		"set code metadata invalid": {
+			msg:   &MsgSetCodeMetadata{Sender: goodAddress, CodeID: 1, Metadata: wasmTypes.CodeMetadata{Source: "example.com"}},
+			valid: false,
+		},
+		"update contract metadata correct": {
+			msg:   &MsgUpdateContractMetadata{Sender: goodAddress, Contract: goodAddress, Metadata: wasmTypes.ContractMetadata{Description: "foo", Tags: []string{"bar"}}},
+			valid: true,
+		},
+		"update contract metadata empty": {
+			msg:   &MsgUpdateContractMetadata{Sender: goodAddress, Contract: goodAddress},
+			valid: true,
+		},
+		"update contract metadata bad contract": {
+			msg:   &MsgUpdateContractMetadata{Sender: goodAddress, Contract: badAddress, Metadata: wasmTypes.ContractMetadata{Description: "foo"}},
+			valid: false,
+		},
+		"update contract metadata invalid": {
+			msg:   &MsgUpdateContractMetadata{Sender: goodAddress, Contract: goodAddress, Metadata: wasmTypes.ContractMetadata{Website: "example.com"}},
+			valid: false,
+		},
+		"update fee allowance correct": {
+			msg:   &MsgUpdateFeeAllowance{Sender: goodAddress, Contract: goodAddress, Grantee: goodAddress, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			valid: true,
+		},
+		"update fee allowance to zero": {
+			msg:   &MsgUpdateFeeAllowance{Sender: goodAddress, Contract: goodAddress, Grantee: goodAddress},
+			valid: true,
+		},
+		"update fee allowance bad grantee": {
+			msg:   &MsgUpdateFeeAllowance{Sender: goodAddress, Contract: goodAddress, Grantee: badAddress},
+			valid: false,
+		},
+		"update fee allowance invalid spend limit": {
+			msg:   &MsgUpdateFeeAllowance{Sender: goodAddress, Contract: goodAddress, Grantee: goodAddress, SpendLimit: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}}},
+			valid: false,
+		},
+		"schedule callback correct": {
+			msg:   &MsgScheduleCallback{Sender: goodAddress, Interval: 10, Msg: []byte(`{}`), GasLimit: 100_000, GasDeposit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			valid: true,
+		},
+		"schedule callback at height": {
+			msg:   &MsgScheduleCallback{Sender: goodAddress, ExecuteHeight: 100, Msg: []byte(`{}`), GasLimit: 100_000},
+			valid: true,
+		},
+		"schedule callback without height and interval": {
+			msg:   &MsgScheduleCallback{Sender: goodAddress, Msg: []byte(`{}`), GasLimit: 100_000},
+			valid: false,
+		},
+		"schedule callback negative height": {
+			msg:   &MsgScheduleCallback{Sender: goodAddress, ExecuteHeight: -1, Interval: 10, Msg: []byte(`{}`), GasLimit: 100_000},
+			valid: false,
+		},
+		"schedule callback invalid msg": {
+			msg:   &MsgScheduleCallback{Sender: goodAddress, Interval: 10, Msg: []byte(`foo`), GasLimit: 100_000},
+			valid: false,
+		},
+		"schedule callback without gas limit": {
+			msg:   &MsgScheduleCallback{Sender: goodAddress, Interval: 10, Msg: []byte(`{}`)},
+			valid: false,
+		},
+		"cancel schedule correct": {
+			msg:   &MsgCancelSchedule{Sender: goodAddress, Contract: goodAddress, ScheduleID: 1},
+			valid: true,
+		},
+		"cancel schedule without id": {
+			msg:   &MsgCancelSchedule{Sender: goodAddress, Contract: goodAddress},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestExecuteContractsValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, wasmTypes.ContractAddrLen)).String()
+	sdk.GetConfig().SetAddressVerifier(wasmTypes.VerifyAddressLen())
+
+	cases := map[string]struct {
+		msg   MsgExecuteContracts
+		valid bool
+	}{
+		"correct": {
+			msg: MsgExecuteContracts{Sender: goodAddress, Calls: []ContractCall{
+				{Contract: goodAddress, Msg: []byte(`{"some": 123}`), Funds: sdk.Coins{sdk.NewInt64Coin("denom", 1)}},
+				{Contract: goodAddress, Msg: []byte(`{"other": 456}`)},
+			}},
+			valid: true,
+		},
+		"bad sender": {
+			msg:   MsgExecuteContracts{Sender: badAddress, Calls: []ContractCall{{Contract: goodAddress, Msg: []byte(`{}`)}}},
+			valid: false,
+		},
+		"no calls": {
+			msg:   MsgExecuteContracts{Sender: goodAddress},
+			valid: false,
+		},
+		"bad contract": {
+			msg:   MsgExecuteContracts{Sender: goodAddress, Calls: []ContractCall{{Contract: badAddress, Msg: []byte(`{}`)}}},
+			valid: false,
+		},
+		"invalid json msg": {
+			msg:   MsgExecuteContracts{Sender: goodAddress, Calls: []ContractCall{{Contract: goodAddress, Msg: []byte("invalid")}}},
+			valid: false,
+		},
+		"negative funds": {
+			msg: MsgExecuteContracts{Sender: goodAddress, Calls: []ContractCall{
+				{Contract: goodAddress, Msg: []byte(`{}`), Funds: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-1)}}},
+			}},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,22 +6,18 @@ import (
 	wasmtypes "github.com/line/wasmd/x/wasm/types"
 )
 
+// validateWasmCode ensures the code is not empty. The size limits are params and checked by the keeper.
 func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "is required")
 	}
-	if len(s) > wasmtypes.MaxWasmSize {
-		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "cannot be longer than %d bytes", wasmtypes.MaxWasmSize)
-	}
 	return nil
 }
 
+// validateLabel ensures the label is not empty. The length limit is a param and checked by the keeper.
 func validateLabel(label string) error {
 	if label == "" {
 		return sdkerrors.Wrap(wasmtypes.ErrEmpty, "is required")
 	}
-	if len(label) > wasmtypes.MaxLabelSize {
-		return sdkerrors.Wrapf(wasmtypes.ErrLimit, "cannot be longer than %d characters", wasmtypes.MaxWasmSize)
-	}
 	return nil
 }
@@ -1,36 +1,13 @@
 package simulation
 
 import (
-	"fmt"
 	"math/rand"
 
-	"github.com/line/lbm-sdk/codec"
 	simtypes "github.com/line/lbm-sdk/types/simulation"
-	"github.com/line/lbm-sdk/x/simulation"
 
 	"github.com/line/wasmd/x/wasm/types"
 )
 
-func ParamChanges(r *rand.Rand, cdc codec.Codec) []simtypes.ParamChange {
-	params := RandomParams(r)
-	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyUploadAccess),
-			func(r *rand.Rand) string {
-				jsonBz, err := cdc.MarshalJSON(&params.CodeUploadAccess)
-				if err != nil {
-					panic(err)
-				}
-				return string(jsonBz)
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyInstantiateAccess),
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("%q", params.CodeUploadAccess.Permission.String())
-			},
-		),
-	}
-}
-
 func RandomParams(r *rand.Rand) types.Params {
 	permissionType := types.AccessType(simtypes.RandIntBetween(r, 1, 3))
 	account, _ := simtypes.RandomAcc(r, simtypes.RandomAccounts(r, 10))
@@ -41,5 +18,11 @@ func RandomParams(r *rand.Rand) types.Params {
 		GasMultiplier:                types.DefaultGasMultiplier,
 		InstanceCost:                 types.DefaultInstanceCost,
 		CompileCost:                  types.DefaultCompileCost,
+		UniqueLabelPerCreator:        r.Intn(2) == 0,
+		MaxWasmSize:                  uint64(simtypes.RandIntBetween(r, 600*1024, 1200*1024)),
+		MaxLabelSize:                 uint64(simtypes.RandIntBetween(r, 64, 256)),
+		MaxDecompressedWasmSize:      uint64(simtypes.RandIntBetween(r, 600*1024, 1200*1024)),
+		StargateQueryGasPerByte:      types.DefaultStargateQueryGasPerByte,
+		UploadSessionDeposit:         types.DefaultUploadSessionDeposit,
 	}
 }
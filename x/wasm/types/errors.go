@@ -90,6 +90,15 @@ var (
 
 	// ErrInactiveContract error if the contract set inactive
 	ErrInactiveContract = sdkErrors.Register(DefaultCodespace, 101, "inactive contract")
+
+	// ErrMigrationNotAllowed error if the migration target is not in the migration allowlist of the contract
+	ErrMigrationNotAllowed = sdkErrors.Register(DefaultCodespace, 102, "migration not allowed")
+
+	// ErrInsufficientStorageDeposit error if the payer can not cover the deposit for the grown contract state
+	ErrInsufficientStorageDeposit = sdkErrors.Register(DefaultCodespace, 103, "insufficient storage deposit")
+
+	// ErrFeeNotSponsored error if a contract does not pay the fees of a tx that names it as fee granter
+	ErrFeeNotSponsored = sdkErrors.Register(DefaultCodespace, 104, "fee not sponsored")
 )
 
 type ErrNoSuchContract struct {
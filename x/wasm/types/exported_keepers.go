@@ -15,13 +15,21 @@ type ViewKeeper interface {
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
 	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
+	IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByLabel(ctx sdk.Context, labelPrefix string, cb func(label string, address sdk.AccAddress) bool)
 	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
 	GetCodeInfo(ctx sdk.Context, codeID uint64) *CodeInfo
+	GetRemovedCodeInfo(ctx sdk.Context, codeID uint64) *CodeInfo
+	GetCodeIDByChecksum(ctx sdk.Context, checksum []byte) (uint64, bool)
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	IterateInactiveContracts(ctx sdk.Context, fn func(contractAddress sdk.AccAddress) bool)
 	IsInactiveContract(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+	GetInactiveContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *InactiveContractInfo
+	GetMigrationAllowlist(ctx sdk.Context, contractAddress sdk.AccAddress) MigrationAllowlist
+	GetContractStorage(ctx sdk.Context, contractAddress sdk.AccAddress) ContractStorage
+	GetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress) StorageQuota
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -29,13 +37,24 @@ type ContractOpsKeeper interface {
 	// Create uploads and compiles a WASM contract, returning a short identifier for the contract
 	Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *AccessConfig) (codeID uint64, err error)
 
+	// CreateWithMetadata uploads and compiles a WASM contract like Create and stores the metadata that links the code
+	// to its source
+	CreateWithMetadata(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *AccessConfig, metadata *CodeMetadata) (codeID uint64, err error)
+
+	// SetCodeMetadata sets the metadata of a code once. Only the creator of the code can set it.
+	SetCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, metadata CodeMetadata) error
+
 	// Instantiate creates an instance of a WASM contract
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 
+	// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+	Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error)
+
 	// Execute executes the contract instance
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 
-	// Migrate allows to upgrade a contract to a new code with data migration.
+	// Migrate allows to upgrade a contract to a new code with data migration. The migration is queued when the
+	// contract has a migration delay and the caller is not an authority that migrates immediately.
 	Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error)
 
 	// Sudo allows to call privileged entry point of a contract.
@@ -47,20 +66,99 @@ type ContractOpsKeeper interface {
 	// ClearContractAdmin sets the admin value on the ContractInfo to nil, to disable further migrations/ updates.
 	ClearContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
 
+	// ProposeContractAdmin sets the pending admin value on the ContractInfo. The admin is not changed before the pending
+	// admin accepts with AcceptContractAdmin.
+	ProposeContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newAdmin sdk.AccAddress) error
+
+	// AcceptContractAdmin makes the caller the admin of the contract when it is the pending admin.
+	AcceptContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// CancelPendingContractAdmin clears the pending admin value on the ContractInfo.
+	CancelPendingContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// UpdateMigrationDelay sets the number of blocks a migration of the contract is queued before it is executed.
+	UpdateMigrationDelay(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, delay uint64) error
+
+	// CancelMigration drops the queued migration of the contract.
+	CancelMigration(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// UpdateMigrationAllowlist replaces the set of codes the contract can be migrated to. An empty allowlist removes
+	// the restriction.
+	UpdateMigrationAllowlist(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, allowlist MigrationAllowlist) error
+
+	// PurgeContract deletes the contract info, history, index entries and state of a contract and sends the remaining
+	// balance to the beneficiary.
+	PurgeContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, beneficiary sdk.AccAddress) error
+
+	// BeginStoreCode starts a session to upload a code in multiple chunks. It returns the session id and the
+	// expiry height of the session.
+	BeginStoreCode(ctx sdk.Context, uploader sdk.AccAddress, checksum []byte, totalSize uint64, instantiateAccess *AccessConfig) (uint64, int64, error)
+
+	// StoreCodeChunk appends the next chunk of the code to an upload session. It returns the size of all chunks
+	// received so far.
+	StoreCodeChunk(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64, data []byte) (uint64, error)
+
+	// CommitStoreCode verifies the checksum of a completely uploaded code and stores it like Create.
+	CommitStoreCode(ctx sdk.Context, uploader sdk.AccAddress, sessionID uint64) (codeID uint64, err error)
+
 	// PinCode pins the wasm contract in wasmvm cache
 	PinCode(ctx sdk.Context, codeID uint64) error
 
 	// UnpinCode removes the wasm contract from wasmvm cache
 	UnpinCode(ctx sdk.Context, codeID uint64) error
 
+	// RemoveCode deletes the code info of a code without contract instances and unpins the code
+	RemoveCode(ctx sdk.Context, codeID uint64) error
+
 	// SetContractInfoExtension updates the extension point data that is stored with the contract info
 	SetContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra ContractInfoExtension) error
 
+	// UpdateContractMetadata replaces the human readable metadata of a contract. Only the admin can update it.
+	UpdateContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, metadata ContractMetadata) error
+
+	// UpdateFeeAllowance replaces the amount of tx fees a contract pays for a grantee. Only the contract itself or its
+	// admin can update it, an empty spend limit removes the allowance.
+	UpdateFeeAllowance(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, allowance FeeAllowance) error
+
+	// ScheduleCallback registers a callback of a contract that the end blocker executes with the scheduled_callback
+	// sudo msg at the execute height and repeats in the interval. The gas deposit is paid from the contract balance.
+	ScheduleCallback(ctx sdk.Context, contractAddress sdk.AccAddress, executeHeight int64, interval uint64, msg RawContractMessage, gasLimit uint64, gasDeposit sdk.Coins) (uint64, error)
+
+	// CancelSchedule drops a schedule of a contract and refunds its gas deposit to the contract. Only the contract
+	// itself or its admin can cancel it.
+	CancelSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, scheduleID uint64) error
+
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, config AccessConfig) error
 
-	// DeactivateContract add the contract address to inactive contract list.
-	DeactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	// SetContractStorageQuota overrides the default storage quota of the params for a contract. An unlimited quota
+	// exempts the contract from all limits, an empty quota removes the override.
+	SetContractStorageQuota(ctx sdk.Context, contractAddress sdk.AccAddress, quota StorageQuota) error
+
+	// RegisterPrivilegedContract registers a contract to receive a sudo call on every begin and/or end block. A
+	// previous registration of the contract is replaced.
+	RegisterPrivilegedContract(ctx sdk.Context, privileged PrivilegedContract) error
+
+	// UnregisterPrivilegedContract stops the begin and end block calls of a contract.
+	UnregisterPrivilegedContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
+	// SetAcceptedStargateQuery accepts a gRPC query path for stargate queries of contracts. A previous entry of the
+	// path is replaced.
+	SetAcceptedStargateQuery(ctx sdk.Context, query AcceptedStargateQuery) error
+
+	// RemoveAcceptedStargateQuery disables stargate queries of contracts for the given path.
+	RemoveAcceptedStargateQuery(ctx sdk.Context, path string) error
+
+	// UpdateParams replaces the wasm params. Only the authority of the module is allowed to update them.
+	UpdateParams(ctx sdk.Context, authority sdk.AccAddress, ps Params) error
+
+	// DeactivateContract add the contract address to inactive contract list with the given deactivation details.
+	DeactivateContract(ctx sdk.Context, contractAddress sdk.AccAddress, info InactiveContractInfo) error
+
+	// SetPendingDeactivationProposal marks the contract as deactivated by the governance proposal that is executed
+	// currently. It must only be called by the proposal handler as the GovHooks assign the id of the next ending
+	// proposal to the deactivation details.
+	SetPendingDeactivationProposal(ctx sdk.Context, contractAddress sdk.AccAddress)
 
 	// ActivateContract remove the contract address from inactive contract list.
 	ActivateContract(ctx sdk.Context, contractAddress sdk.AccAddress) error
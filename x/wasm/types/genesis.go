@@ -22,6 +22,11 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "code: %d", i)
 		}
 	}
+	for i := range s.RemovedCodes {
+		if err := s.RemovedCodes[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "removed code: %d", i)
+		}
+	}
 	for i := range s.Contracts {
 		if err := s.Contracts[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "contract: %d", i)
@@ -42,6 +47,31 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "inactive contract address: %d", i)
 		}
 	}
+	for i := range s.InactiveContracts {
+		if err := s.InactiveContracts[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "inactive contract: %d", i)
+		}
+	}
+	paths := make(map[string]struct{}, len(s.AcceptedStargateQueries))
+	for i, q := range s.AcceptedStargateQueries {
+		if err := q.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "accepted stargate query: %d", i)
+		}
+		if _, exists := paths[q.Path]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "accepted stargate query: %d", i)
+		}
+		paths[q.Path] = struct{}{}
+	}
+	return nil
+}
+
+func (c InactiveContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if c.Info.ExpiryHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "negative expiry height")
+	}
 	return nil
 }
 
@@ -58,6 +88,16 @@ func (c Code) ValidateBasic() error {
 	return nil
 }
 
+func (c RemovedCode) ValidateBasic() error {
+	if c.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if err := c.CodeInfo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "code info")
+	}
+	return nil
+}
+
 func (c Contract) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
 		return sdkerrors.Wrap(err, "contract address")
@@ -74,6 +114,58 @@ func (c Contract) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
 	}
+	if c.MigrationAllowlist != nil {
+		if err := c.MigrationAllowlist.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "migration allowlist")
+		}
+	}
+	if err := c.StorageDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "storage deposit")
+	}
+	if c.StorageQuota != nil {
+		if err := c.StorageQuota.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "storage quota")
+		}
+	}
+	grantees := make(map[string]struct{}, len(c.FeeAllowances))
+	for i, a := range c.FeeAllowances {
+		if err := a.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "fee allowance %d", i)
+		}
+		if _, exists := grantees[a.Grantee]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "fee allowance %d", i)
+		}
+		grantees[a.Grantee] = struct{}{}
+	}
+	scheduleIDs := make(map[uint64]struct{}, len(c.Schedules))
+	for i, s := range c.Schedules {
+		if err := s.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "schedule %d", i)
+		}
+		if s.Contract != c.ContractAddress {
+			return sdkerrors.Wrapf(ErrInvalid, "schedule %d of another contract", i)
+		}
+		if _, exists := scheduleIDs[s.ID]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "schedule %d", i)
+		}
+		scheduleIDs[s.ID] = struct{}{}
+	}
+	if c.PendingMigration != nil {
+		if err := c.PendingMigration.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "pending migration")
+		}
+		if c.PendingMigration.Contract != c.ContractAddress {
+			return sdkerrors.Wrap(ErrInvalid, "pending migration of another contract")
+		}
+	}
+	if c.Privileged != nil {
+		if err := c.Privileged.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "privileged")
+		}
+		if c.Privileged.Contract != c.ContractAddress {
+			return sdkerrors.Wrap(ErrInvalid, "privileged registration of another contract")
+		}
+	}
 	return nil
 }
 
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -30,8 +32,18 @@ type GenesisState struct {
 	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
 	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
-	// InactiveContractAddresses is a list of contract address that set inactive
+	// InactiveContractAddresses is a list of contract address that set inactive.
+	// Deprecated: use inactive_contracts which keeps the deactivation details.
 	InactiveContractAddresses []string `protobuf:"bytes,6,rep,name=inactive_contract_addresses,json=inactiveContractAddresses,proto3" json:"inactive_contract_address, omitempty"`
+	// InactiveContracts is a list of inactive contracts with the deactivation
+	// details
+	InactiveContracts []InactiveContract `protobuf:"bytes,7,rep,name=inactive_contracts,json=inactiveContracts,proto3" json:"inactive_contracts,omitempty"`
+	// AcceptedStargateQueries are the gRPC queries that contracts are allowed
+	// to call with a stargate query
+	AcceptedStargateQueries []AcceptedStargateQuery `protobuf:"bytes,8,rep,name=accepted_stargate_queries,json=acceptedStargateQueries,proto3" json:"accepted_stargate_queries,omitempty"`
+	// RemovedCodes are the code infos of the codes that were removed by
+	// governance
+	RemovedCodes []RemovedCode `protobuf:"bytes,9,rep,name=removed_codes,json=removedCodes,proto3" json:"removed_codes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +121,27 @@ func (m *GenesisState) GetInactiveContractAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetInactiveContracts() []InactiveContract {
+	if m != nil {
+		return m.InactiveContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAcceptedStargateQueries() []AcceptedStargateQuery {
+	if m != nil {
+		return m.AcceptedStargateQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemovedCodes() []RemovedCode {
+	if m != nil {
+		return m.RemovedCodes
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in
 // order. The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -281,18 +314,88 @@ func (m *Code) GetPinned() bool {
 	return false
 }
 
+// RemovedCode struct encompasses the CodeID and CodeInfo of a removed code
+type RemovedCode struct {
+	CodeID   uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	CodeInfo CodeInfo `protobuf:"bytes,2,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
+}
+
+func (m *RemovedCode) Reset()         { *m = RemovedCode{} }
+func (m *RemovedCode) String() string { return proto.CompactTextString(m) }
+func (*RemovedCode) ProtoMessage()    {}
+func (*RemovedCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{2}
+}
+func (m *RemovedCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovedCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovedCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovedCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovedCode.Merge(m, src)
+}
+func (m *RemovedCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovedCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovedCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovedCode proto.InternalMessageInfo
+
+func (m *RemovedCode) GetCodeID() uint64 {
+	if m != nil {
+		return m.CodeID
+	}
+	return 0
+}
+
+func (m *RemovedCode) GetCodeInfo() CodeInfo {
+	if m != nil {
+		return m.CodeInfo
+	}
+	return CodeInfo{}
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo    ContractInfo `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState   []Model      `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// MigrationAllowlist is the optional set of allowed migration targets
+	MigrationAllowlist *MigrationAllowlist `protobuf:"bytes,4,opt,name=migration_allowlist,json=migrationAllowlist,proto3" json:"migration_allowlist,omitempty"`
+	// StorageDeposit is the deposit that is locked for the contract state
+	StorageDeposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,5,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"storage_deposit"`
+	// StorageQuota is the optional quota that overrides the default quota of
+	// the params
+	StorageQuota *StorageQuota `protobuf:"bytes,6,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
+	// FeeAllowances are the tx fees the contract pays for grantees
+	FeeAllowances []FeeAllowance `protobuf:"bytes,7,rep,name=fee_allowances,json=feeAllowances,proto3" json:"fee_allowances"`
+	// Schedules are the callbacks the contract registered
+	Schedules []Schedule `protobuf:"bytes,8,rep,name=schedules,proto3" json:"schedules"`
+	// Privileged is the optional registration of the contract for the begin
+	// and end block calls
+	Privileged *PrivilegedContract `protobuf:"bytes,9,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	// PendingMigration is the optional migration that is queued for the
+	// contract
+	PendingMigration *PendingMigration `protobuf:"bytes,10,opt,name=pending_migration,json=pendingMigration,proto3" json:"pending_migration,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
 func (m *Contract) String() string { return proto.CompactTextString(m) }
 func (*Contract) ProtoMessage()    {}
 func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ab3f539b23472a6, []int{2}
+	return fileDescriptor_2ab3f539b23472a6, []int{3}
 }
 func (m *Contract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,6 +445,108 @@ func (m *Contract) GetContractState() []Model {
 	return nil
 }
 
+func (m *Contract) GetMigrationAllowlist() *MigrationAllowlist {
+	if m != nil {
+		return m.MigrationAllowlist
+	}
+	return nil
+}
+
+func (m *Contract) GetStorageDeposit() github_com_line_lbm_sdk_types.Coins {
+	if m != nil {
+		return m.StorageDeposit
+	}
+	return nil
+}
+
+func (m *Contract) GetStorageQuota() *StorageQuota {
+	if m != nil {
+		return m.StorageQuota
+	}
+	return nil
+}
+
+func (m *Contract) GetFeeAllowances() []FeeAllowance {
+	if m != nil {
+		return m.FeeAllowances
+	}
+	return nil
+}
+
+func (m *Contract) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *Contract) GetPrivileged() *PrivilegedContract {
+	if m != nil {
+		return m.Privileged
+	}
+	return nil
+}
+
+func (m *Contract) GetPendingMigration() *PendingMigration {
+	if m != nil {
+		return m.PendingMigration
+	}
+	return nil
+}
+
+// InactiveContract struct encompasses ContractAddress and InactiveContractInfo
+type InactiveContract struct {
+	ContractAddress string               `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Info            InactiveContractInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info"`
+}
+
+func (m *InactiveContract) Reset()         { *m = InactiveContract{} }
+func (m *InactiveContract) String() string { return proto.CompactTextString(m) }
+func (*InactiveContract) ProtoMessage()    {}
+func (*InactiveContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{4}
+}
+func (m *InactiveContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InactiveContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InactiveContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InactiveContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InactiveContract.Merge(m, src)
+}
+func (m *InactiveContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *InactiveContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_InactiveContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InactiveContract proto.InternalMessageInfo
+
+func (m *InactiveContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *InactiveContract) GetInfo() InactiveContractInfo {
+	if m != nil {
+		return m.Info
+	}
+	return InactiveContractInfo{}
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -352,7 +557,7 @@ func (m *Sequence) Reset()         { *m = Sequence{} }
 func (m *Sequence) String() string { return proto.CompactTextString(m) }
 func (*Sequence) ProtoMessage()    {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ab3f539b23472a6, []int{3}
+	return fileDescriptor_2ab3f539b23472a6, []int{5}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,57 +604,82 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.wasm.v1.GenesisState")
 	proto.RegisterType((*GenesisState_GenMsgs)(nil), "cosmwasm.wasm.v1.GenesisState.GenMsgs")
 	proto.RegisterType((*Code)(nil), "cosmwasm.wasm.v1.Code")
+	proto.RegisterType((*RemovedCode)(nil), "cosmwasm.wasm.v1.RemovedCode")
 	proto.RegisterType((*Contract)(nil), "cosmwasm.wasm.v1.Contract")
+	proto.RegisterType((*InactiveContract)(nil), "cosmwasm.wasm.v1.InactiveContract")
 	proto.RegisterType((*Sequence)(nil), "cosmwasm.wasm.v1.Sequence")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc7, 0x63, 0x92, 0x98, 0x64, 0x48, 0x0b, 0x5a, 0x10, 0x18, 0xd3, 0x3a, 0x51, 0x8a, 0xaa,
-	0x54, 0x42, 0x89, 0xa0, 0x52, 0x4f, 0xad, 0xaa, 0xba, 0xa0, 0x12, 0x21, 0xa4, 0xd6, 0xa8, 0x97,
-	0x4a, 0x28, 0x32, 0xf6, 0x60, 0xac, 0x62, 0x6f, 0x9a, 0xdd, 0xa4, 0xe4, 0xdc, 0x5b, 0x4f, 0x7d,
-	0x85, 0xbe, 0x4c, 0xc5, 0x91, 0x63, 0x4f, 0x51, 0x15, 0x6e, 0x3c, 0x45, 0xb5, 0xeb, 0x8f, 0x18,
-	0x92, 0x5c, 0x2c, 0xcf, 0xcc, 0x7f, 0x7e, 0x3b, 0xb3, 0xbb, 0xb3, 0x60, 0x38, 0x94, 0x05, 0xdf,
-	0x6d, 0x16, 0xb4, 0xe4, 0x67, 0xb0, 0xdb, 0xf2, 0x30, 0x44, 0xe6, 0xb3, 0x66, 0xb7, 0x47, 0x39,
-	0x25, 0x2b, 0x49, 0xbc, 0x29, 0x3f, 0x83, 0x5d, 0x7d, 0xcd, 0xa3, 0x1e, 0x95, 0xc1, 0x96, 0xf8,
-	0x8b, 0x74, 0xfa, 0x93, 0x29, 0x0e, 0x1f, 0x76, 0x31, 0xa6, 0xe8, 0x9b, 0xd3, 0xd1, 0xab, 0x28,
-	0x54, 0xff, 0xa9, 0x42, 0xe5, 0x43, 0xb4, 0xe4, 0x09, 0xb7, 0x39, 0x92, 0x57, 0xa0, 0x76, 0xed,
-	0x9e, 0x1d, 0x30, 0x4d, 0xa9, 0x29, 0x8d, 0xa5, 0x3d, 0xad, 0xf9, 0xb0, 0x84, 0xe6, 0x47, 0x19,
-	0x37, 0x0b, 0xd7, 0xa3, 0x6a, 0xce, 0x8a, 0xd5, 0xe4, 0x00, 0x8a, 0x0e, 0x75, 0x91, 0x69, 0x0b,
-	0xb5, 0x7c, 0x63, 0x69, 0x6f, 0x7d, 0x3a, 0xed, 0x3d, 0x75, 0xd1, 0xdc, 0x10, 0x49, 0x77, 0xa3,
-	0xea, 0xb2, 0x14, 0xef, 0xd0, 0xc0, 0xe7, 0x18, 0x74, 0xf9, 0xd0, 0x8a, 0xb2, 0xc9, 0x67, 0x28,
-	0x3b, 0x34, 0xe4, 0x3d, 0xdb, 0xe1, 0x4c, 0xcb, 0x4b, 0x94, 0x3e, 0x0b, 0x15, 0x49, 0xcc, 0xad,
-	0x18, 0xb7, 0x9a, 0x26, 0x65, 0x90, 0x13, 0x92, 0xc0, 0x32, 0xfc, 0xd6, 0xc7, 0xd0, 0x41, 0xa6,
-	0x15, 0xe6, 0x61, 0x4f, 0x62, 0xc9, 0x04, 0x9b, 0x26, 0x65, 0xb1, 0xa9, 0x93, 0x9c, 0x42, 0xc9,
-	0xc3, 0xb0, 0x13, 0x30, 0x8f, 0x69, 0x45, 0x49, 0x7d, 0x3e, 0x4d, 0xcd, 0x6e, 0xaf, 0x30, 0x8e,
-	0x99, 0xc7, 0x4c, 0x3d, 0x5e, 0x81, 0x24, 0xf9, 0x99, 0x05, 0x16, 0xbd, 0x48, 0x44, 0x2e, 0x60,
-	0xcb, 0x0f, 0x6d, 0x87, 0xfb, 0x03, 0xec, 0x24, 0xbd, 0x74, 0x6c, 0xd7, 0xed, 0x21, 0x63, 0xc8,
-	0x34, 0xb5, 0x96, 0x6f, 0x94, 0xcd, 0xc6, 0xdd, 0xa8, 0xba, 0x3d, 0x57, 0xb6, 0x53, 0x9b, 0x70,
-	0x37, 0x13, 0x55, 0xb2, 0x7d, 0xef, 0x12, 0x94, 0xfe, 0x63, 0x01, 0x16, 0xe3, 0xd2, 0xc8, 0x5b,
-	0x00, 0xc6, 0x69, 0x4f, 0xb0, 0x5c, 0x8c, 0x6f, 0x81, 0x31, 0xdd, 0xd6, 0x31, 0xf3, 0x4e, 0x84,
-	0x4c, 0x1c, 0xeb, 0x61, 0xce, 0x2a, 0xb3, 0xc4, 0x20, 0xa7, 0xb0, 0xe6, 0x87, 0x8c, 0xdb, 0x21,
-	0xf7, 0x6d, 0x3e, 0x29, 0x49, 0x5b, 0x90, 0xa8, 0xc6, 0x4c, 0x54, 0x7b, 0x92, 0x90, 0x54, 0x77,
-	0x98, 0xb3, 0x56, 0xfd, 0x69, 0x37, 0xf9, 0x04, 0x2b, 0x78, 0x85, 0x4e, 0x3f, 0x8b, 0xce, 0x4b,
-	0xf4, 0xf6, 0x4c, 0xf4, 0x41, 0x24, 0xce, 0x60, 0x97, 0xf1, 0xbe, 0xcb, 0x2c, 0x42, 0x9e, 0xf5,
-	0x83, 0xfa, 0x6f, 0x05, 0x0a, 0xb2, 0x83, 0x67, 0xb0, 0x28, 0x9a, 0xef, 0xf8, 0xae, 0xec, 0xbf,
-	0x60, 0xc2, 0x78, 0x54, 0x55, 0x45, 0xa8, 0xbd, 0x6f, 0xa9, 0x22, 0xd4, 0x76, 0xc9, 0x1b, 0x71,
-	0x55, 0x85, 0x28, 0x3c, 0xa7, 0x71, 0x6f, 0xfa, 0xec, 0x5b, 0xdf, 0x0e, 0xcf, 0x69, 0x3c, 0x2e,
-	0x25, 0x27, 0xb6, 0xc9, 0x53, 0x00, 0x99, 0x7e, 0x36, 0xe4, 0xc8, 0x64, 0x03, 0x15, 0x4b, 0x02,
-	0x4d, 0xe1, 0x20, 0xeb, 0xa0, 0x76, 0xfd, 0x30, 0x44, 0x57, 0x2b, 0xd4, 0x94, 0x46, 0xc9, 0x8a,
-	0xad, 0xfa, 0x1f, 0x05, 0x4a, 0xe9, 0x56, 0xbc, 0x80, 0x95, 0x87, 0x07, 0x2e, 0x0b, 0x2e, 0x5b,
-	0xcb, 0xce, 0xfd, 0x33, 0x26, 0x6d, 0x78, 0x94, 0x4a, 0x33, 0x15, 0x1b, 0xf3, 0x87, 0x2b, 0x53,
-	0x75, 0xc5, 0xc9, 0xf8, 0xc8, 0x3e, 0x3c, 0x4e, 0x51, 0x4c, 0xdc, 0xea, 0x78, 0x50, 0x37, 0x66,
-	0x6c, 0x3f, 0x75, 0xf1, 0x32, 0x86, 0xa4, 0xeb, 0xcb, 0x49, 0xa8, 0x9b, 0x50, 0x4a, 0xe6, 0x8d,
-	0xd4, 0x40, 0xf5, 0xdd, 0xce, 0x57, 0x1c, 0xca, 0xea, 0x2b, 0x66, 0x79, 0x3c, 0xaa, 0x16, 0xdb,
-	0xfb, 0x47, 0x38, 0xb4, 0x8a, 0xbe, 0x7b, 0x84, 0x43, 0xb2, 0x06, 0xc5, 0x81, 0x7d, 0xd9, 0x47,
-	0x59, 0x76, 0xc1, 0x8a, 0x0c, 0xf3, 0xf5, 0xf5, 0xd8, 0x50, 0x6e, 0xc6, 0x86, 0xf2, 0x6f, 0x6c,
-	0x28, 0xbf, 0x6e, 0x8d, 0xdc, 0xcd, 0xad, 0x91, 0xfb, 0x7b, 0x6b, 0xe4, 0xbe, 0xd4, 0x3d, 0x9f,
-	0x5f, 0xf4, 0xcf, 0x9a, 0x0e, 0x0d, 0x5a, 0x97, 0x7e, 0x88, 0xf2, 0xe5, 0x73, 0x5b, 0x57, 0xd1,
-	0x0b, 0x28, 0x1f, 0xc7, 0x33, 0x55, 0x3e, 0x81, 0x2f, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3b,
-	0x04, 0x85, 0xd1, 0x85, 0x05, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x25, 0x5b, 0x92, 0xa5, 0xb5, 0xfc, 0x91, 0xb5, 0x11, 0xd3, 0x4a, 0x22, 0x09, 0xb2,
+	0x91, 0xaa, 0x68, 0x2a, 0xc1, 0x29, 0xd0, 0x53, 0xbf, 0x4c, 0x3b, 0x6d, 0x04, 0x23, 0xa8, 0x4d,
+	0x23, 0x97, 0x02, 0x01, 0xb1, 0x22, 0xc7, 0xf4, 0x22, 0x22, 0x97, 0xe6, 0xae, 0x64, 0xeb, 0xd4,
+	0x43, 0x5f, 0x20, 0xaf, 0xd0, 0x6b, 0x9f, 0x24, 0xc7, 0x1c, 0x7b, 0x72, 0x0b, 0xf9, 0x96, 0x17,
+	0xe8, 0xa1, 0x97, 0x82, 0xcb, 0x25, 0x45, 0x8b, 0x12, 0x8a, 0x5e, 0x7a, 0x91, 0xbd, 0xbb, 0xff,
+	0xf9, 0xcd, 0xcc, 0xee, 0xec, 0x0e, 0x51, 0xdd, 0x62, 0xdc, 0xbd, 0x26, 0xdc, 0xed, 0xca, 0x9f,
+	0xd1, 0x41, 0xd7, 0x01, 0x0f, 0x38, 0xe5, 0x1d, 0x3f, 0x60, 0x82, 0xe1, 0xcd, 0x78, 0xbd, 0x23,
+	0x7f, 0x46, 0x07, 0xb5, 0x6d, 0x87, 0x39, 0x4c, 0x2e, 0x76, 0xc3, 0xff, 0x22, 0x5d, 0x4d, 0x72,
+	0x18, 0xef, 0xf6, 0x09, 0x87, 0xee, 0xe8, 0xa0, 0x0f, 0x82, 0x1c, 0x74, 0x2d, 0x46, 0x3d, 0xb5,
+	0xfe, 0x38, 0xe3, 0x47, 0x8c, 0x7d, 0x50, 0x5e, 0x6a, 0xbb, 0xd9, 0xd5, 0x9b, 0x68, 0xa9, 0xf5,
+	0x77, 0x19, 0x55, 0x7f, 0x88, 0x42, 0x3a, 0x17, 0x44, 0x00, 0xfe, 0x12, 0x95, 0x7c, 0x12, 0x10,
+	0x97, 0x6b, 0xf9, 0x66, 0xbe, 0xbd, 0xfa, 0x5c, 0xeb, 0xcc, 0x86, 0xd8, 0x39, 0x95, 0xeb, 0x7a,
+	0xe1, 0xfd, 0x6d, 0x23, 0x67, 0x28, 0x35, 0x7e, 0x81, 0x8a, 0x16, 0xb3, 0x81, 0x6b, 0x4b, 0xcd,
+	0xe5, 0xf6, 0xea, 0xf3, 0x87, 0x59, 0xb3, 0x23, 0x66, 0x83, 0xbe, 0x13, 0x1a, 0x7d, 0xbc, 0x6d,
+	0x6c, 0x48, 0xf1, 0x33, 0xe6, 0x52, 0x01, 0xae, 0x2f, 0xc6, 0x46, 0x64, 0x8d, 0x5f, 0xa3, 0x8a,
+	0xc5, 0x3c, 0x11, 0x10, 0x4b, 0x70, 0x6d, 0x59, 0xa2, 0x6a, 0xf3, 0x50, 0x91, 0x44, 0x7f, 0xa4,
+	0x70, 0x5b, 0x89, 0x51, 0x0a, 0x39, 0x25, 0x85, 0x58, 0x0e, 0x57, 0x43, 0xf0, 0x2c, 0xe0, 0x5a,
+	0x61, 0x11, 0xf6, 0x5c, 0x49, 0xa6, 0xd8, 0xc4, 0x28, 0x8d, 0x4d, 0x26, 0xf1, 0x1b, 0x54, 0x76,
+	0xc0, 0x33, 0x5d, 0xee, 0x70, 0xad, 0x28, 0xa9, 0x4f, 0xb3, 0xd4, 0xf4, 0xf6, 0x86, 0x83, 0x57,
+	0xdc, 0xe1, 0x7a, 0x4d, 0x79, 0xc0, 0xb1, 0x7d, 0xca, 0xc1, 0x8a, 0x13, 0x89, 0xf0, 0x25, 0x7a,
+	0x44, 0x3d, 0x62, 0x09, 0x3a, 0x02, 0x33, 0xce, 0xc5, 0x24, 0xb6, 0x1d, 0x00, 0xe7, 0xc0, 0xb5,
+	0x52, 0x73, 0xb9, 0x5d, 0xd1, 0xdb, 0x1f, 0x6f, 0x1b, 0xfb, 0x0b, 0x65, 0xcf, 0x9a, 0x53, 0xee,
+	0x6e, 0xac, 0x8a, 0xb7, 0xef, 0x30, 0x46, 0xe1, 0x6b, 0x84, 0x33, 0x08, 0xae, 0xad, 0xc8, 0x94,
+	0x5a, 0xd9, 0x94, 0x7a, 0x33, 0x20, 0x7d, 0x5f, 0xa5, 0xf3, 0x38, 0x4b, 0x49, 0x25, 0xf6, 0x60,
+	0x36, 0x00, 0x8e, 0xdf, 0xe5, 0xd1, 0x2e, 0xb1, 0x2c, 0xf0, 0x05, 0xd8, 0x26, 0x17, 0x24, 0x70,
+	0x88, 0x00, 0xf3, 0x6a, 0x08, 0x01, 0x05, 0xae, 0x95, 0x65, 0x00, 0x9f, 0x64, 0x03, 0x38, 0x54,
+	0x26, 0xe7, 0xca, 0xe2, 0x6c, 0x08, 0xc1, 0x58, 0xff, 0x4c, 0x45, 0xb1, 0xb7, 0x90, 0x98, 0x0a,
+	0x66, 0x87, 0xcc, 0x61, 0x50, 0xe0, 0xd8, 0x42, 0x6b, 0x01, 0xb8, 0x6c, 0x04, 0xb6, 0x19, 0x55,
+	0x74, 0x45, 0x46, 0xf1, 0x24, 0x1b, 0x85, 0x11, 0xc9, 0x64, 0x61, 0x37, 0x94, 0xef, 0x9d, 0x7b,
+	0xb6, 0x29, 0x7f, 0xd5, 0x60, 0xaa, 0xe6, 0xb5, 0x5f, 0x96, 0xd0, 0x8a, 0xaa, 0x05, 0xfc, 0x2d,
+	0x42, 0x5c, 0xb0, 0x00, 0xa4, 0x89, 0xba, 0x76, 0xf5, 0xac, 0xb7, 0x57, 0xdc, 0x39, 0x0f, 0x65,
+	0x21, 0xe0, 0x65, 0xce, 0xa8, 0xf0, 0x78, 0x80, 0xdf, 0xa0, 0x6d, 0xea, 0x71, 0x41, 0x3c, 0x41,
+	0x89, 0x98, 0x6e, 0xbd, 0xb6, 0x24, 0x51, 0xed, 0xb9, 0xa8, 0xde, 0xd4, 0x20, 0x3e, 0x8d, 0x97,
+	0x39, 0x63, 0x8b, 0x66, 0xa7, 0xf1, 0x19, 0xda, 0x84, 0x1b, 0xb0, 0x86, 0x69, 0xf4, 0xb2, 0x44,
+	0xef, 0xcf, 0x45, 0xbf, 0x88, 0xc4, 0x29, 0xec, 0x06, 0xdc, 0x9f, 0xd2, 0x8b, 0x68, 0x99, 0x0f,
+	0xdd, 0xd6, 0xaf, 0x79, 0x54, 0x90, 0x19, 0xec, 0xa1, 0x95, 0x30, 0x79, 0x93, 0xda, 0x32, 0xff,
+	0x82, 0x8e, 0x26, 0xb7, 0x8d, 0x52, 0xb8, 0xd4, 0x3b, 0x36, 0x4a, 0xe1, 0x52, 0xcf, 0xc6, 0x5f,
+	0xa3, 0x4a, 0x24, 0xf2, 0x2e, 0x98, 0xca, 0xad, 0x36, 0xff, 0x99, 0xe9, 0x79, 0x17, 0x4c, 0xbd,
+	0x4f, 0x65, 0x4b, 0x8d, 0xf1, 0x13, 0x84, 0xa4, 0x79, 0x7f, 0x2c, 0x80, 0xcb, 0x04, 0xaa, 0x86,
+	0x04, 0xea, 0xe1, 0x04, 0x7e, 0x88, 0x4a, 0x3e, 0xf5, 0x3c, 0xb0, 0xb5, 0x42, 0x33, 0xdf, 0x2e,
+	0x1b, 0x6a, 0xd4, 0xba, 0x42, 0xab, 0xa9, 0x73, 0xfe, 0x3f, 0x22, 0x6d, 0xfd, 0x55, 0x44, 0xe5,
+	0x64, 0xf7, 0x3f, 0x45, 0x9b, 0xb3, 0x97, 0x5a, 0x7a, 0xae, 0x18, 0x1b, 0xd6, 0xfd, 0x7b, 0x8c,
+	0x7b, 0x68, 0x2d, 0x91, 0xa6, 0x5c, 0xd7, 0x17, 0x3f, 0xa0, 0x29, 0xf7, 0x55, 0x2b, 0x35, 0x87,
+	0x8f, 0xd1, 0x7a, 0x82, 0xe2, 0x82, 0x08, 0x50, 0x8f, 0xf1, 0xce, 0x9c, 0x13, 0x67, 0x36, 0x0c,
+	0x14, 0x24, 0xf1, 0x1f, 0x35, 0x93, 0xd7, 0x68, 0xcb, 0xa5, 0x4e, 0x40, 0x04, 0x65, 0x9e, 0x49,
+	0x06, 0x03, 0x76, 0x3d, 0xa0, 0x5c, 0x68, 0x85, 0x85, 0xc5, 0x13, 0x8b, 0x0f, 0x63, 0xad, 0x81,
+	0xdd, 0xcc, 0x1c, 0x66, 0x68, 0x23, 0x2c, 0x7e, 0xe2, 0x80, 0x69, 0x83, 0xcf, 0x38, 0x15, 0xea,
+	0xf5, 0xdd, 0xed, 0x44, 0x7d, 0xb2, 0x13, 0xf6, 0xc9, 0x8e, 0xea, 0x93, 0x9d, 0x23, 0x46, 0xbd,
+	0xe8, 0x6d, 0xf8, 0xed, 0x8f, 0xc6, 0x9e, 0x43, 0xc5, 0xe5, 0xb0, 0xdf, 0xb1, 0x98, 0xdb, 0x1d,
+	0x50, 0x0f, 0xba, 0x83, 0xbe, 0xfb, 0x39, 0xb7, 0xdf, 0xaa, 0x86, 0x19, 0x6a, 0xb9, 0xb1, 0xae,
+	0xf0, 0xc7, 0x11, 0x1d, 0x1f, 0xa1, 0xb5, 0xd8, 0xe1, 0xd5, 0x90, 0x09, 0xa2, 0x95, 0x16, 0x6d,
+	0xec, 0x79, 0x24, 0x3b, 0x0b, 0x55, 0x46, 0x95, 0xa7, 0x46, 0xf8, 0x04, 0xad, 0x5f, 0x00, 0x44,
+	0xdb, 0x40, 0x64, 0x23, 0x8a, 0xde, 0xd7, 0x39, 0x94, 0xef, 0x01, 0x0e, 0x63, 0x59, 0xbc, 0xb3,
+	0x17, 0xa9, 0x39, 0x8e, 0xbf, 0x41, 0x15, 0x6e, 0x5d, 0x82, 0x3d, 0x1c, 0x24, 0xcf, 0xe4, 0xbc,
+	0x86, 0xa6, 0x24, 0x8a, 0x31, 0x35, 0xc1, 0xc7, 0x08, 0xf9, 0x01, 0x1d, 0xd1, 0x01, 0x38, 0x60,
+	0x6b, 0x95, 0x45, 0x07, 0x72, 0x9a, 0x68, 0xe2, 0x8a, 0x31, 0x52, 0x76, 0xf8, 0x47, 0xf4, 0xc0,
+	0x07, 0xcf, 0xa6, 0x9e, 0x63, 0x26, 0xc7, 0xa4, 0xa1, 0x66, 0x7e, 0x7e, 0xd7, 0x38, 0x8d, 0xa4,
+	0xc9, 0x21, 0x1b, 0x9b, 0xfe, 0xcc, 0x4c, 0xeb, 0x67, 0xb4, 0x39, 0xdb, 0x5b, 0xfe, 0xcb, 0x05,
+	0xf8, 0x0e, 0x15, 0x52, 0x75, 0xff, 0xf4, 0xdf, 0x1b, 0x57, 0xaa, 0xfe, 0xa5, 0x65, 0x4b, 0x47,
+	0xe5, 0xf8, 0x2b, 0x00, 0x37, 0x51, 0x89, 0xda, 0xe6, 0x5b, 0x18, 0x4b, 0x77, 0x55, 0xbd, 0x32,
+	0xb9, 0x6d, 0x14, 0x7b, 0xc7, 0x27, 0x30, 0x36, 0x8a, 0xd4, 0x3e, 0x81, 0x31, 0xde, 0x46, 0xc5,
+	0x11, 0x19, 0x0c, 0x41, 0x3a, 0x2c, 0x18, 0xd1, 0x40, 0xff, 0xea, 0xfd, 0xa4, 0x9e, 0xff, 0x30,
+	0xa9, 0xe7, 0xff, 0x9c, 0xd4, 0xf3, 0xef, 0xee, 0xea, 0xb9, 0x0f, 0x77, 0xf5, 0xdc, 0xef, 0x77,
+	0xf5, 0xdc, 0x4f, 0xad, 0xd9, 0xe2, 0x0b, 0xe3, 0xb2, 0xbb, 0x37, 0xf2, 0x6f, 0x54, 0x81, 0xfd,
+	0x92, 0xfc, 0x30, 0xfb, 0xe2, 0x9f, 0x01, 0x00, 0x0e, 0x73, 0x71, 0x34, 0x3b, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -472,6 +702,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemovedCodes) > 0 {
+		for iNdEx := len(m.RemovedCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovedCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AcceptedStargateQueries) > 0 {
+		for iNdEx := len(m.AcceptedStargateQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedStargateQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.InactiveContracts) > 0 {
+		for iNdEx := len(m.InactiveContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.InactiveContractAddresses) > 0 {
 		for iNdEx := len(m.InactiveContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InactiveContractAddresses[iNdEx])
@@ -700,6 +972,44 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RemovedCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovedCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovedCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CodeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CodeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Contract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -720,6 +1030,96 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingMigration != nil {
+		{
+			size, err := m.PendingMigration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Privileged != nil {
+		{
+			size, err := m.Privileged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FeeAllowances) > 0 {
+		for iNdEx := len(m.FeeAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.StorageQuota != nil {
+		{
+			size, err := m.StorageQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MigrationAllowlist != nil {
+		{
+			size, err := m.MigrationAllowlist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ContractState) > 0 {
 		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -754,7 +1154,7 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Sequence) Marshal() (dAtA []byte, err error) {
+func (m *InactiveContract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -764,19 +1164,59 @@ func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sequence) MarshalTo(dAtA []byte) (int, error) {
+func (m *InactiveContract) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InactiveContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Value))
-		i--
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Value))
+		i--
 		dAtA[i] = 0x10
 	}
 	if len(m.IDKey) > 0 {
@@ -838,6 +1278,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InactiveContracts) > 0 {
+		for _, e := range m.InactiveContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AcceptedStargateQueries) > 0 {
+		for _, e := range m.AcceptedStargateQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemovedCodes) > 0 {
+		for _, e := range m.RemovedCodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -910,6 +1368,20 @@ func (m *Code) Size() (n int) {
 	return n
 }
 
+func (m *RemovedCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeID))
+	}
+	l = m.CodeInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *Contract) Size() (n int) {
 	if m == nil {
 		return 0
@@ -928,6 +1400,55 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MigrationAllowlist != nil {
+		l = m.MigrationAllowlist.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StorageQuota != nil {
+		l = m.StorageQuota.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FeeAllowances) > 0 {
+		for _, e := range m.FeeAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Privileged != nil {
+		l = m.Privileged.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingMigration != nil {
+		l = m.PendingMigration.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *InactiveContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1183,6 +1704,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.InactiveContractAddresses = append(m.InactiveContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveContracts = append(m.InactiveContracts, InactiveContract{})
+			if err := m.InactiveContracts[len(m.InactiveContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedStargateQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedStargateQueries = append(m.AcceptedStargateQueries, AcceptedStargateQuery{})
+			if err := m.AcceptedStargateQueries[len(m.AcceptedStargateQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedCodes = append(m.RemovedCodes, RemovedCode{})
+			if err := m.RemovedCodes[len(m.RemovedCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1515,7 +2138,7 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *RemovedCode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,17 +2161,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovedCode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovedCode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var stringLen uint64
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1558,27 +2181,14 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1605,41 +2215,517 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractState = append(m.ContractState, Model{})
-			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractState = append(m.ContractState, Model{})
+			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationAllowlist == nil {
+				m.MigrationAllowlist = &MigrationAllowlist{}
+			}
+			if err := m.MigrationAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposit = append(m.StorageDeposit, types.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageQuota == nil {
+				m.StorageQuota = &StorageQuota{}
+			}
+			if err := m.StorageQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAllowances = append(m.FeeAllowances, FeeAllowance{})
+			if err := m.FeeAllowances[len(m.FeeAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Privileged == nil {
+				m.Privileged = &PrivilegedContract{}
+			}
+			if err := m.Privileged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingMigration == nil {
+				m.PendingMigration = &PendingMigration{}
+			}
+			if err := m.PendingMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InactiveContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InactiveContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InactiveContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
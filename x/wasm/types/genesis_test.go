@@ -35,6 +35,18 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"codeinfo with any of addresses": {
+			srcMutator: func(s *GenesisState) {
+				s.Codes[0].CodeInfo.InstantiateConfig = AccessTypeAnyOfAddresses.With(make([]byte, SDKAddrLen))
+			},
+		},
+		"codeinfo with duplicate any of addresses": {
+			srcMutator: func(s *GenesisState) {
+				addr := sdk.AccAddress(make([]byte, SDKAddrLen)).String()
+				s.Codes[0].CodeInfo.InstantiateConfig = AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{addr, addr}}
+			},
+			expError: true,
+		},
 		"contract invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.Contracts[0].ContractAddress = "invalid"
@@ -65,6 +77,51 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"inactive contract address invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.InactiveContracts = []InactiveContract{{ContractAddress: "invalid"}}
+			},
+			expError: true,
+		},
+		"inactive contract expiry height negative": {
+			srcMutator: func(s *GenesisState) {
+				s.InactiveContracts = []InactiveContract{{
+					ContractAddress: s.Contracts[0].ContractAddress,
+					Info:            InactiveContractInfo{ExpiryHeight: -1},
+				}}
+			},
+			expError: true,
+		},
+		"removed code valid": {
+			srcMutator: func(s *GenesisState) {
+				s.RemovedCodes = []RemovedCode{{CodeID: 100, CodeInfo: s.Codes[0].CodeInfo}}
+			},
+		},
+		"removed code id invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.RemovedCodes = []RemovedCode{{CodeInfo: s.Codes[0].CodeInfo}}
+			},
+			expError: true,
+		},
+		"removed code info invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.RemovedCodes = []RemovedCode{{CodeID: 100}}
+			},
+			expError: true,
+		},
+		"accepted stargate query invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.AcceptedStargateQueries = []AcceptedStargateQuery{{Path: "cosmos.bank.v1beta1.Query/Balance", ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse"}}
+			},
+			expError: true,
+		},
+		"accepted stargate query duplicate path": {
+			srcMutator: func(s *GenesisState) {
+				q := AcceptedStargateQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", ResponseType: "cosmos.bank.v1beta1.QueryBalanceResponse"}
+				s.AcceptedStargateQueries = []AcceptedStargateQuery{q, q}
+			},
+			expError: true,
+		},
 		"genesis invalid message type": {
 			srcMutator: func(s *GenesisState) {
 				s.GenMsgs[0].Sum = nil
@@ -115,12 +172,6 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
-		"codeBytes greater limit": {
-			srcMutator: func(c *Code) {
-				c.CodeBytes = bytes.Repeat([]byte{0x1}, MaxWasmSize+1)
-			},
-			expError: true,
-		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -165,6 +216,41 @@ func TestContractValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"contract with migration allowlist": {
+			srcMutator: func(c *Contract) {
+				c.MigrationAllowlist = &MigrationAllowlist{CodeIDs: []uint64{1}, Checksums: [][]byte{bytes.Repeat([]byte{1}, 32)}}
+			},
+		},
+		"migration allowlist invalid": {
+			srcMutator: func(c *Contract) {
+				c.MigrationAllowlist = &MigrationAllowlist{Checksums: [][]byte{{1}}}
+			},
+			expError: true,
+		},
+		"migration delay exceeds max": {
+			srcMutator: func(c *Contract) {
+				c.ContractInfo.MigrationDelay = MaxMigrationDelay + 1
+			},
+			expError: true,
+		},
+		"contract with pending migration": {
+			srcMutator: func(c *Contract) {
+				c.PendingMigration = &PendingMigration{Contract: c.ContractAddress, Sender: c.ContractInfo.Creator, CodeID: 1, Msg: []byte(`{}`), ExecuteHeight: 10}
+			},
+		},
+		"pending migration invalid": {
+			srcMutator: func(c *Contract) {
+				c.PendingMigration = &PendingMigration{Contract: c.ContractAddress, Sender: c.ContractInfo.Creator, Msg: []byte(`{}`), ExecuteHeight: 10}
+			},
+			expError: true,
+		},
+		"pending migration of another contract": {
+			srcMutator: func(c *Contract) {
+				otherAddress := sdk.AccAddress(rand.Bytes(ContractAddrLen)).String()
+				c.PendingMigration = &PendingMigration{Contract: otherAddress, Sender: c.ContractInfo.Creator, CodeID: 1, Msg: []byte(`{}`), ExecuteHeight: 10}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -0,0 +1,45 @@
+package types
+
+// ContractEntryPoint identifies an entry point of a contract that can be called by the chain
+type ContractEntryPoint string
+
+const (
+	// EntryPointExecute covers execute calls by accounts and other contracts
+	EntryPointExecute ContractEntryPoint = "execute"
+	// EntryPointMigrate covers migrate calls
+	EntryPointMigrate ContractEntryPoint = "migrate"
+	// EntryPointUpdateAdmin covers updating or clearing the admin of a contract
+	EntryPointUpdateAdmin ContractEntryPoint = "update_admin"
+	// EntryPointSudo covers sudo calls by governance or other modules
+	EntryPointSudo ContractEntryPoint = "sudo"
+	// EntryPointReply covers replies to sub messages that were dispatched by the contract
+	EntryPointReply ContractEntryPoint = "reply"
+	// EntryPointQuery covers smart queries
+	EntryPointQuery ContractEntryPoint = "query"
+	// EntryPointIBCChannel covers the IBC channel open, connect and close callbacks
+	EntryPointIBCChannel ContractEntryPoint = "ibc_channel"
+	// EntryPointIBCPacket covers the IBC packet receive, acknowledgement and timeout callbacks
+	EntryPointIBCPacket ContractEntryPoint = "ibc_packet"
+	// EntryPointPurge covers purging a contract by its admin
+	EntryPointPurge ContractEntryPoint = "purge"
+)
+
+// AllContractEntryPoints returns all entry points that are subject to the inactive contract policy
+func AllContractEntryPoints() []ContractEntryPoint {
+	return []ContractEntryPoint{
+		EntryPointExecute,
+		EntryPointMigrate,
+		EntryPointUpdateAdmin,
+		EntryPointSudo,
+		EntryPointReply,
+		EntryPointQuery,
+		EntryPointIBCChannel,
+		EntryPointIBCPacket,
+		EntryPointPurge,
+	}
+}
+
+// String implements the Stringer interface
+func (e ContractEntryPoint) String() string {
+	return string(e)
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/address"
 )
 
 const (
@@ -31,11 +34,35 @@ var (
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
 	TXCounterPrefix                                = []byte{0x08}
+	CodeByChecksumIndexPrefix                      = []byte{0x09}
+	ContractsByCreatorPrefix                       = []byte{0x0a}
+	ContractByLabelPrefix                          = []byte{0x0b}
+	RemovedCodeKeyPrefix                           = []byte{0x0c}
+	ContractByCreatorAndLabelPrefix                = []byte{0x0d}
+	ParamsKey                                      = []byte{0x10}
 
-	InactiveContractPrefix = []byte{0x90}
+	InactiveContractPrefix         = []byte{0x90}
+	InactiveContractExpiryPrefix   = []byte{0x91}
+	PendingDeactivationProposalKey = []byte{0x92}
+	PendingContractPurgePrefix     = []byte{0x93}
+	UploadSessionPrefix            = []byte{0x94}
+	UploadChunkPrefix              = []byte{0x95}
+	UploadSessionExpiryPrefix      = []byte{0x96}
+	PendingMigrationPrefix         = []byte{0x97}
+	PendingMigrationQueuePrefix    = []byte{0x98}
+	MigrationAllowlistPrefix       = []byte{0x99}
+	ContractStoragePrefix          = []byte{0x9a}
+	ContractStorageQuotaPrefix     = []byte{0x9b}
+	FeeAllowancePrefix             = []byte{0x9c}
+	SchedulePrefix                 = []byte{0x9d}
+	ScheduleQueuePrefix            = []byte{0x9e}
+	PrivilegedContractPrefix       = []byte{0x9f}
+	AcceptedStargateQueryPrefix    = []byte{0xa0}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastUploadID   = append(SequenceKeyPrefix, []byte("lastUploadSessionId")...)
+	KeyLastScheduleID = append(SequenceKeyPrefix, []byte("lastScheduleId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -44,6 +71,12 @@ func GetCodeKey(codeID uint64) []byte {
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetRemovedCodeKey returns the key for the code info of a removed code: `<prefix><codeID>`
+func GetRemovedCodeKey(codeID uint64) []byte {
+	contractIDBz := sdk.Uint64ToBigEndian(codeID)
+	return append(sdk.CopyBytes(RemovedCodeKeyPrefix), contractIDBz...)
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
@@ -77,6 +110,54 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetContractByCreatorSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><creatorAddressLength><creatorAddress><created><contractAddr>`
+func GetContractByCreatorSecondaryIndexKey(creator sdk.AccAddress, created AbsoluteTxPosition, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractsByCreatorPrefix(creator)
+	prefixLen := len(prefix)
+	contractAddrLen := len(contractAddr)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+contractAddrLen)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], created.Bytes())
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr.Bytes())
+	return r
+}
+
+// GetContractsByCreatorPrefix returns the contracts by creator prefix for the WASM contract instance:
+// `<prefix><creatorAddressLength><creatorAddress>`
+func GetContractsByCreatorPrefix(addr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(addr)
+	return append(sdk.CopyBytes(ContractsByCreatorPrefix), bz...)
+}
+
+// GetContractByLabelSecondaryIndexKey returns the key for the secondary index: `<prefix><label><0x00><contractAddr>`.
+// The zero byte terminates the label so that the entries are sorted by label first.
+func GetContractByLabelSecondaryIndexKey(label string, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractByLabelPrefix(label)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+1+len(contractAddr))
+	copy(r[0:], prefix)
+	copy(r[prefixLen+1:], contractAddr.Bytes())
+	return r
+}
+
+// GetContractByCreatorAndLabelKey returns the key for the creator and label index:
+// `<prefix><creatorAddressLength><creatorAddress><label>`
+func GetContractByCreatorAndLabelKey(creator sdk.AccAddress, label string) []byte {
+	prefix := append(sdk.CopyBytes(ContractByCreatorAndLabelPrefix), address.MustLengthPrefix(creator)...)
+	return append(prefix, label...)
+}
+
+// GetContractByLabelPrefix returns the key prefix for the contracts with a label that starts with the given
+// label prefix: `<prefix><label>`
+func GetContractByLabelPrefix(label string) []byte {
+	prefixLen := len(ContractByLabelPrefix)
+	r := make([]byte, prefixLen+len(label))
+	copy(r[0:], ContractByLabelPrefix)
+	copy(r[prefixLen:], label)
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -103,6 +184,25 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetCodeByChecksumIndexPrefix returns the key prefix for the code ids stored with the given checksum: `<prefix><checksum>`
+func GetCodeByChecksumIndexPrefix(checksum []byte) []byte {
+	prefixLen := len(CodeByChecksumIndexPrefix)
+	r := make([]byte, prefixLen+len(checksum))
+	copy(r[0:], CodeByChecksumIndexPrefix)
+	copy(r[prefixLen:], checksum)
+	return r
+}
+
+// GetCodeByChecksumIndexKey returns the key for the secondary index: `<prefix><checksum><codeID>`
+func GetCodeByChecksumIndexKey(checksum []byte, codeID uint64) []byte {
+	prefix := GetCodeByChecksumIndexPrefix(checksum)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
 // ParsePinnedCodeIndex converts the serialized code ID back.
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
@@ -114,3 +214,121 @@ func GetInactiveContractKey(contractAddress sdk.AccAddress) []byte {
 	copy(key[len(InactiveContractPrefix):], contractAddress)
 	return key
 }
+
+// GetInactiveContractExpiryKey returns the key for the inactive contract expiry index:
+// `<prefix><expiryHeight><contractAddr>`
+func GetInactiveContractExpiryKey(expiryHeight int64, contractAddress sdk.AccAddress) []byte {
+	prefixLen := len(InactiveContractExpiryPrefix)
+	key := make([]byte, prefixLen+8+len(contractAddress))
+	copy(key, InactiveContractExpiryPrefix)
+	copy(key[prefixLen:], sdk.Uint64ToBigEndian(uint64(expiryHeight)))
+	copy(key[prefixLen+8:], contractAddress)
+	return key
+}
+
+// GetPendingContractPurgeKey returns the key for a purged contract with state entries left to delete:
+// `<prefix><contractAddr>`
+func GetPendingContractPurgeKey(contractAddress sdk.AccAddress) []byte {
+	key := make([]byte, len(PendingContractPurgePrefix)+len(contractAddress))
+	copy(key, PendingContractPurgePrefix)
+	copy(key[len(PendingContractPurgePrefix):], contractAddress)
+	return key
+}
+
+// GetUploadSessionKey returns the key for a chunked upload session: `<prefix><uploaderLength><uploader><sessionID>`
+func GetUploadSessionKey(uploader sdk.AccAddress, sessionID uint64) []byte {
+	return append(append(sdk.CopyBytes(UploadSessionPrefix), address.MustLengthPrefix(uploader)...), sdk.Uint64ToBigEndian(sessionID)...)
+}
+
+// GetUploadChunkPrefix returns the key prefix for the chunks of an upload session:
+// `<prefix><uploaderLength><uploader><sessionID>`
+func GetUploadChunkPrefix(uploader sdk.AccAddress, sessionID uint64) []byte {
+	return append(append(sdk.CopyBytes(UploadChunkPrefix), address.MustLengthPrefix(uploader)...), sdk.Uint64ToBigEndian(sessionID)...)
+}
+
+// GetUploadChunkKey returns the key for a chunk of an upload session:
+// `<prefix><uploaderLength><uploader><sessionID><chunkIndex>`
+func GetUploadChunkKey(uploader sdk.AccAddress, sessionID uint64, index uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, index)
+	return append(GetUploadChunkPrefix(uploader, sessionID), bz...)
+}
+
+// GetUploadSessionExpiryKey returns the key for the expiry index of upload sessions:
+// `<prefix><expiryHeight><uploaderLength><uploader><sessionID>`
+func GetUploadSessionExpiryKey(expiryHeight int64, uploader sdk.AccAddress, sessionID uint64) []byte {
+	key := append(sdk.CopyBytes(UploadSessionExpiryPrefix), sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+	return append(append(key, address.MustLengthPrefix(uploader)...), sdk.Uint64ToBigEndian(sessionID)...)
+}
+
+// ParseUploadSessionExpiryKey returns the uploader and session id of an expiry index key without the prefix and
+// expiry height
+func ParseUploadSessionExpiryKey(key []byte) (sdk.AccAddress, uint64) {
+	addrLen := int(key[0])
+	return key[1 : 1+addrLen], sdk.BigEndianToUint64(key[1+addrLen:])
+}
+
+// GetPendingMigrationKey returns the key for the queued migration of a contract: `<prefix><contractAddr>`
+func GetPendingMigrationKey(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(PendingMigrationPrefix), contractAddress...)
+}
+
+// GetPendingMigrationQueueKey returns the key for the execution queue of migrations:
+// `<prefix><executeHeight><contractAddr>`
+func GetPendingMigrationQueueKey(executeHeight int64, contractAddress sdk.AccAddress) []byte {
+	key := append(sdk.CopyBytes(PendingMigrationQueuePrefix), sdk.Uint64ToBigEndian(uint64(executeHeight))...)
+	return append(key, contractAddress...)
+}
+
+// GetMigrationAllowlistKey returns the key for the migration allowlist of a contract: `<prefix><contractAddr>`
+func GetMigrationAllowlistKey(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(MigrationAllowlistPrefix), contractAddress...)
+}
+
+// GetContractStorageKey returns the key for the storage usage and deposit of a contract: `<prefix><contractAddr>`
+func GetContractStorageKey(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ContractStoragePrefix), contractAddress...)
+}
+
+// GetFeeAllowancePrefix returns the prefix for the fee allowances of a contract: `<prefix><contractAddrLen><contractAddr>`
+func GetFeeAllowancePrefix(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(FeeAllowancePrefix), address.MustLengthPrefix(contractAddress)...)
+}
+
+// GetFeeAllowanceKey returns the key for the fee allowance of a grantee:
+// `<prefix><contractAddrLen><contractAddr><granteeAddr>`
+func GetFeeAllowanceKey(contractAddress, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancePrefix(contractAddress), grantee...)
+}
+
+// GetContractStorageQuotaKey returns the key for the storage quota override of a contract: `<prefix><contractAddr>`
+func GetContractStorageQuotaKey(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ContractStorageQuotaPrefix), contractAddress...)
+}
+
+// GetSchedulePrefix returns the prefix for the schedules of a contract: `<prefix><contractAddrLen><contractAddr>`
+func GetSchedulePrefix(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(SchedulePrefix), address.MustLengthPrefix(contractAddress)...)
+}
+
+// GetScheduleKey returns the key for a schedule of a contract: `<prefix><contractAddrLen><contractAddr><scheduleID>`
+func GetScheduleKey(contractAddress sdk.AccAddress, scheduleID uint64) []byte {
+	return append(GetSchedulePrefix(contractAddress), sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetScheduleQueueKey returns the key for the execution queue of schedules: `<prefix><nextHeight><scheduleID>`. The
+// value is the contract address.
+func GetScheduleQueueKey(nextHeight int64, scheduleID uint64) []byte {
+	key := append(sdk.CopyBytes(ScheduleQueuePrefix), sdk.Uint64ToBigEndian(uint64(nextHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetPrivilegedContractKey returns the key for the registration of a privileged contract: `<prefix><contractAddr>`
+func GetPrivilegedContractKey(contractAddress sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(PrivilegedContractPrefix), contractAddress...)
+}
+
+// GetAcceptedStargateQueryKey returns the key for an accepted stargate query: `<prefix><path>`
+func GetAcceptedStargateQueryKey(path string) []byte {
+	return append(sdk.CopyBytes(AcceptedStargateQueryPrefix), []byte(path)...)
+}
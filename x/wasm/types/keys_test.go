@@ -71,6 +71,73 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
+	creatorAddr := bytes.Repeat([]byte{4}, 20)
+	e := ContractCodeHistoryEntry{
+		CodeID:  1,
+		Updated: &AbsoluteTxPosition{2 + 1<<(8*7), 3 + 1<<(8*7)},
+	}
+
+	// test that contract addresses of 20 length are still supported
+	contractAddr := bytes.Repeat([]byte{5}, 20)
+	got := GetContractByCreatorSecondaryIndexKey(creatorAddr, *e.Updated, contractAddr)
+	exp := []byte{
+		10,                           // prefix
+		20,                           // creator address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // creator address with fixed length prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // index
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // address 20 bytes
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	}
+	assert.Equal(t, exp, got)
+
+	// test that contract addresses of 32 length are still supported
+	contractAddr = bytes.Repeat([]byte{7}, 32)
+	got = GetContractByCreatorSecondaryIndexKey(creatorAddr, *e.Updated, contractAddr)
+	exp = []byte{
+		10,                           // prefix
+		20,                           // creator address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // creator address with fixed length prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // index
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, // address 32 bytes
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractByLabelSecondaryIndexKey(t *testing.T) {
+	contractAddr := bytes.Repeat([]byte{5}, 20)
+	got := GetContractByLabelSecondaryIndexKey("foo", contractAddr)
+	exp := []byte{
+		11,            // prefix
+		'f', 'o', 'o', // label
+		0,                            // label terminator
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // address 20 bytes
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	}
+	assert.Equal(t, exp, got)
+	assert.Equal(t, exp[:3], GetContractByLabelPrefix("fo"))
+}
+
+func TestGetContractByCreatorAndLabelKey(t *testing.T) {
+	creatorAddr := bytes.Repeat([]byte{4}, 20)
+	got := GetContractByCreatorAndLabelKey(creatorAddr, "foo")
+	exp := []byte{
+		13,                           // prefix
+		20,                           // creator address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // creator address 20 bytes
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		'f', 'o', 'o', // label
+	}
+	assert.Equal(t, exp, got)
+}
+
 func TestGetInactiveContractKey(t *testing.T) {
 	addr := bytes.Repeat([]byte{4}, 20)
 	got := GetInactiveContractKey(addr)
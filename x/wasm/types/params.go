@@ -38,34 +38,62 @@ const (
 	DefaultInstanceCost = 60_000
 	// DefaultCompileCost is how much SDK gas we charge *per byte* for compiling WASM code.
 	DefaultCompileCost = 3
+	// DefaultMaxWasmSize is the largest a wasm code, compressed or not, can be when storing code on chain.
+	DefaultMaxWasmSize uint64 = 800 * 1024
+	// DefaultMaxLabelSize is the longest label that can be used when instantiating a contract.
+	DefaultMaxLabelSize uint64 = 128
+	// DefaultMaxDecompressedWasmSize is the largest a wasm code can be after the gzip decompression.
+	DefaultMaxDecompressedWasmSize uint64 = 800 * 1024
+	// MaxImportedWasmSize is the largest a wasm code can be after the gzip decompression when it is imported from
+	// genesis or restored from a snapshot. It is the upper bound of the max decompressed wasm size param so that codes
+	// that were accepted on upload are always restored, even after the param was lowered.
+	MaxImportedWasmSize uint64 = 3 * 1024 * 1024
+	// DefaultStargateQueryGasPerByte is how much SDK gas we charge *per byte* of the request and response of a
+	// stargate query.
+	DefaultStargateQueryGasPerByte uint64 = 3
 )
 
+// The param store keys of the legacy params subspace. The params are kept in the wasm store since consensus version 3
+// and the keys are only used to migrate the existing values.
 var ParamStoreKeyUploadAccess = []byte("uploadAccess")
 var ParamStoreKeyInstantiateAccess = []byte("instantiateAccess")
-
-// TODO: detach below params because these are  lbm-sdk custom params
-
 var ParamStoreKeyGasMultiplier = []byte("gasMultiplier")
 var ParamStoreKeyInstanceCost = []byte("instanceCost")
 var ParamStoreKeyCompileCost = []byte("compileCost")
+var ParamStoreKeyUniqueLabelPerCreator = []byte("uniqueLabelPerCreator")
 
 var AllAccessTypes = []AccessType{
 	AccessTypeNobody,
 	AccessTypeOnlyAddress,
 	AccessTypeEverybody,
+	AccessTypeAnyOfAddresses,
 }
 
-func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
+// With returns an AccessConfig of the type for the given addresses. The OnlyAddress type requires exactly one address
+// and the AnyOfAddresses type at least one. The addresses are ignored for the other types.
+func (a AccessType) With(addrs ...sdk.AccAddress) AccessConfig {
 	switch a {
 	case AccessTypeNobody:
 		return AllowNobody
 	case AccessTypeOnlyAddress:
-		if err := sdk.VerifyAddressFormat(addr); err != nil {
+		if len(addrs) != 1 {
+			panic("exactly one address expected")
+		}
+		if err := sdk.VerifyAddressFormat(addrs[0]); err != nil {
 			panic(err)
 		}
-		return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addr.String()}
+		return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addrs[0].String()}
 	case AccessTypeEverybody:
 		return AllowEverybody
+	case AccessTypeAnyOfAddresses:
+		bech32Addrs := make([]string, len(addrs))
+		for i, v := range addrs {
+			bech32Addrs[i] = v.String()
+		}
+		if err := assertValidAddresses(bech32Addrs); err != nil {
+			panic(err)
+		}
+		return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: bech32Addrs}
 	}
 	panic("unsupported access type")
 }
@@ -78,6 +106,8 @@ func (a AccessType) String() string {
 		return "OnlyAddress"
 	case AccessTypeEverybody:
 		return "Everybody"
+	case AccessTypeAnyOfAddresses:
+		return "AnyOfAddresses"
 	}
 	return "Unspecified"
 }
@@ -106,16 +136,27 @@ func (a *AccessType) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, data []byte) error {
 }
 
 func (a AccessConfig) Equals(o AccessConfig) bool {
-	return a.Permission == o.Permission && a.Address == o.Address
+	if a.Permission != o.Permission || a.Address != o.Address || len(a.Addresses) != len(o.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if a.Addresses[i] != o.Addresses[i] {
+			return false
+		}
+	}
+	return true
 }
 
+// DefaultUploadSessionDeposit is the amount that is held in escrow for each chunked upload session by default.
+var DefaultUploadSessionDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000)))
+
 var (
 	DefaultUploadAccess = AllowEverybody
 	AllowEverybody      = AccessConfig{Permission: AccessTypeEverybody}
 	AllowNobody         = AccessConfig{Permission: AccessTypeNobody}
 )
 
-// ParamKeyTable returns the parameter key table.
+// ParamKeyTable returns the parameter key table of the legacy params subspace.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -128,6 +169,11 @@ func DefaultParams() Params {
 		GasMultiplier:                DefaultGasMultiplier,
 		InstanceCost:                 DefaultInstanceCost,
 		CompileCost:                  DefaultCompileCost,
+		MaxWasmSize:                  DefaultMaxWasmSize,
+		MaxLabelSize:                 DefaultMaxLabelSize,
+		MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+		StargateQueryGasPerByte:      DefaultStargateQueryGasPerByte,
+		UploadSessionDeposit:         DefaultUploadSessionDeposit,
 	}
 }
 
@@ -139,7 +185,7 @@ func (p Params) String() string {
 	return string(out)
 }
 
-// ParamSetPairs returns the parameter set pairs.
+// ParamSetPairs returns the parameter set pairs of the legacy params subspace.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyUploadAccess, &p.CodeUploadAccess, validateAccessConfig),
@@ -147,6 +193,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasMultiplier, validateGasMultiplier),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstanceCost, &p.InstanceCost, validateInstanceCost),
 		paramtypes.NewParamSetPair(ParamStoreKeyCompileCost, &p.CompileCost, validateCompileCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyUniqueLabelPerCreator, &p.UniqueLabelPerCreator, validateUniqueLabelPerCreator),
 	}
 }
 
@@ -158,9 +205,52 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessConfig(p.CodeUploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := validateGasMultiplier(p.GasMultiplier); err != nil {
+		return errors.Wrap(err, "gas multiplier")
+	}
+	if err := validateInstanceCost(p.InstanceCost); err != nil {
+		return errors.Wrap(err, "instance cost")
+	}
+	if err := validateCompileCost(p.CompileCost); err != nil {
+		return errors.Wrap(err, "compile cost")
+	}
+	if p.MaxWasmSize == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "max wasm size must be greater than 0")
+	}
+	if p.MaxLabelSize == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "max label size must be greater than 0")
+	}
+	if p.MaxDecompressedWasmSize == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "max decompressed wasm size must be greater than 0")
+	}
+	if p.MaxDecompressedWasmSize > MaxImportedWasmSize {
+		return sdkerrors.Wrapf(ErrInvalid, "max decompressed wasm size must not be greater than %d", MaxImportedWasmSize)
+	}
+	if err := p.StorageDepositPerByte.Validate(); err != nil {
+		return errors.Wrap(err, "storage deposit per byte")
+	}
+	if err := p.UploadSessionDeposit.Validate(); err != nil {
+		return errors.Wrap(err, "upload session deposit")
+	}
 	return nil
 }
 
+// StorageDeposit returns the deposit that is required for the given number of bytes of contract state. Fractions are
+// rounded up.
+func (p Params) StorageDeposit(bytes uint64) sdk.Coins {
+	var deposit sdk.Coins
+	for _, c := range p.StorageDepositPerByte {
+		amount := c.Amount.MulInt(sdk.NewIntFromUint64(bytes)).Ceil().TruncateInt()
+		deposit = deposit.Add(sdk.NewCoin(c.Denom, amount))
+	}
+	return deposit
+}
+
+// StorageQuota returns the default storage quota of contracts
+func (p Params) StorageQuota() StorageQuota {
+	return StorageQuota{MaxBytes: p.MaxContractStorageBytes, MaxKeys: p.MaxContractStorageKeys}
+}
+
 func validateAccessConfig(i interface{}) error {
 	v, ok := i.(AccessConfig)
 	if !ok {
@@ -190,17 +280,43 @@ func (a AccessConfig) ValidateBasic() error {
 	case AccessTypeUnspecified:
 		return sdkerrors.Wrap(ErrEmpty, "type")
 	case AccessTypeNobody, AccessTypeEverybody:
-		if len(a.Address) != 0 {
+		if len(a.Address) != 0 || len(a.Addresses) != 0 {
 			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
 		}
 		return nil
 	case AccessTypeOnlyAddress:
+		if len(a.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "addresses not allowed for this type")
+		}
 		_, err := sdk.AccAddressFromBech32(a.Address)
 		return err
+	case AccessTypeAnyOfAddresses:
+		if len(a.Address) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
+		}
+		return assertValidAddresses(a.Addresses)
 	}
 	return sdkerrors.Wrapf(ErrInvalid, "unknown type: %q", a.Permission)
 }
 
+// assertValidAddresses returns an error when the list is empty or contains invalid or duplicate addresses
+func assertValidAddresses(addrs []string) error {
+	if len(addrs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "addresses")
+	}
+	idx := make(map[string]struct{}, len(addrs))
+	for _, a := range addrs {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return sdkerrors.Wrapf(err, "address: %s", a)
+		}
+		if _, exists := idx[a]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", a)
+		}
+		idx[a] = struct{}{}
+	}
+	return nil
+}
+
 func validateGasMultiplier(i interface{}) error {
 	a, ok := i.(uint64)
 	if !ok {
@@ -234,6 +350,13 @@ func validateCompileCost(i interface{}) error {
 	return nil
 }
 
+func validateUniqueLabelPerCreator(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
 func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
 	switch a.Permission {
 	case AccessTypeNobody:
@@ -242,6 +365,13 @@ func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
 		return true
 	case AccessTypeOnlyAddress:
 		return a.Address == actor.String()
+	case AccessTypeAnyOfAddresses:
+		for _, v := range a.Addresses {
+			if v == actor.String() {
+				return true
+			}
+		}
+		return false
 	default:
 		panic("unknown type")
 	}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 func TestValidateParams(t *testing.T) {
 	var (
 		anyAddress     sdk.AccAddress = make([]byte, ContractAddrLen)
+		otherAddress   sdk.AccAddress = bytes.Repeat([]byte{1}, ContractAddrLen)
 		invalidAddress                = "invalid address"
 	)
 
@@ -28,6 +30,9 @@ func TestValidateParams(t *testing.T) {
 		"all good with nobody": {
 			src: Params{
 				CodeUploadAccess:             AllowNobody,
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeNobody,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -37,6 +42,9 @@ func TestValidateParams(t *testing.T) {
 		"all good with everybody": {
 			src: Params{
 				CodeUploadAccess:             AllowEverybody,
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeEverybody,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -46,6 +54,9 @@ func TestValidateParams(t *testing.T) {
 		"all good with only address": {
 			src: Params{
 				CodeUploadAccess:             AccessTypeOnlyAddress.With(anyAddress),
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -54,16 +65,22 @@ func TestValidateParams(t *testing.T) {
 		},
 		"reject empty type in instantiate permission": {
 			src: Params{
-				CodeUploadAccess: AllowNobody,
-				GasMultiplier:    DefaultGasMultiplier,
-				InstanceCost:     DefaultInstanceCost,
-				CompileCost:      DefaultCompileCost,
+				CodeUploadAccess:        AllowNobody,
+				MaxWasmSize:             DefaultMaxWasmSize,
+				MaxLabelSize:            DefaultMaxLabelSize,
+				MaxDecompressedWasmSize: DefaultMaxDecompressedWasmSize,
+				GasMultiplier:           DefaultGasMultiplier,
+				InstanceCost:            DefaultInstanceCost,
+				CompileCost:             DefaultCompileCost,
 			},
 			expErr: true,
 		},
 		"reject unknown type in instantiate": {
 			src: Params{
 				CodeUploadAccess:             AllowNobody,
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: 1111,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -74,6 +91,9 @@ func TestValidateParams(t *testing.T) {
 		"reject CodeUploadAccess invalid address in only address": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeOnlyAddress, Address: invalidAddress},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -84,6 +104,9 @@ func TestValidateParams(t *testing.T) {
 		"reject CodeUploadAccess Everybody with obsolete address": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeEverybody, Address: anyAddress.String()},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -94,6 +117,9 @@ func TestValidateParams(t *testing.T) {
 		"reject CodeUploadAccess Nobody with obsolete address": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeNobody, Address: anyAddress.String()},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -110,9 +136,157 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+		},
+		"reject CodeUploadAccess any of addresses without addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess any of addresses with duplicate address": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress.String(), anyAddress.String()}},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess any of addresses with invalid address": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress.String(), invalidAddress}},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess any of addresses with obsolete address": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Address: anyAddress.String(), Addresses: []string{otherAddress.String()}},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess only address with obsolete addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeOnlyAddress, Address: anyAddress.String(), Addresses: []string{otherAddress.String()}},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject zero gas multiplier": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject zero instance cost": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				GasMultiplier:                DefaultGasMultiplier,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject zero compile cost": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+			},
+			expErr: true,
+		},
+		"reject zero max wasm size": {
+			src: func() Params {
+				p := DefaultParams()
+				p.MaxWasmSize = 0
+				return p
+			}(),
+			expErr: true,
+		},
+		"reject zero max label size": {
+			src: func() Params {
+				p := DefaultParams()
+				p.MaxLabelSize = 0
+				return p
+			}(),
+			expErr: true,
+		},
+		"reject zero max decompressed wasm size": {
+			src: func() Params {
+				p := DefaultParams()
+				p.MaxDecompressedWasmSize = 0
+				return p
+			}(),
+			expErr: true,
+		},
+		"reject max decompressed wasm size above the import limit": {
+			src: func() Params {
+				p := DefaultParams()
+				p.MaxDecompressedWasmSize = MaxImportedWasmSize + 1
+				return p
+			}(),
+			expErr: true,
+		},
 		"reject undefined permission in CodeUploadAccess": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeUnspecified},
+				MaxWasmSize:                  DefaultMaxWasmSize,
+				MaxLabelSize:                 DefaultMaxLabelSize,
+				MaxDecompressedWasmSize:      DefaultMaxDecompressedWasmSize,
 				InstantiateDefaultPermission: AccessTypeOnlyAddress,
 				GasMultiplier:                DefaultGasMultiplier,
 				InstanceCost:                 DefaultInstanceCost,
@@ -138,11 +312,12 @@ func TestAccessTypeMarshalJson(t *testing.T) {
 		src AccessType
 		exp string
 	}{
-		"Unspecified": {src: AccessTypeUnspecified, exp: `"Unspecified"`},
-		"Nobody":      {src: AccessTypeNobody, exp: `"Nobody"`},
-		"OnlyAddress": {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
-		"Everybody":   {src: AccessTypeEverybody, exp: `"Everybody"`},
-		"unknown":     {src: 999, exp: `"Unspecified"`},
+		"Unspecified":    {src: AccessTypeUnspecified, exp: `"Unspecified"`},
+		"Nobody":         {src: AccessTypeNobody, exp: `"Nobody"`},
+		"OnlyAddress":    {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
+		"Everybody":      {src: AccessTypeEverybody, exp: `"Everybody"`},
+		"AnyOfAddresses": {src: AccessTypeAnyOfAddresses, exp: `"AnyOfAddresses"`},
+		"unknown":        {src: 999, exp: `"Unspecified"`},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -158,11 +333,12 @@ func TestAccessTypeUnmarshalJson(t *testing.T) {
 		src string
 		exp AccessType
 	}{
-		"Unspecified": {src: `"Unspecified"`, exp: AccessTypeUnspecified},
-		"Nobody":      {src: `"Nobody"`, exp: AccessTypeNobody},
-		"OnlyAddress": {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
-		"Everybody":   {src: `"Everybody"`, exp: AccessTypeEverybody},
-		"unknown":     {src: `""`, exp: AccessTypeUnspecified},
+		"Unspecified":    {src: `"Unspecified"`, exp: AccessTypeUnspecified},
+		"Nobody":         {src: `"Nobody"`, exp: AccessTypeNobody},
+		"OnlyAddress":    {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
+		"Everybody":      {src: `"Everybody"`, exp: AccessTypeEverybody},
+		"AnyOfAddresses": {src: `"AnyOfAddresses"`, exp: AccessTypeAnyOfAddresses},
+		"unknown":        {src: `""`, exp: AccessTypeUnspecified},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -184,7 +360,12 @@ func TestParamsUnmarshalJson(t *testing.T) {
 				"instantiate_default_permission": "Everybody",
 				"gas_multiplier": 140000000,
 				"instance_cost": 60000,
-				"compile_cost": 3}`,
+				"compile_cost": 3,
+				"max_wasm_size": 819200,
+				"max_label_size": 128,
+				"max_decompressed_wasm_size": 819200,
+				"stargate_query_gas_per_byte": 3,
+				"upload_session_deposit": [{"denom": "stake", "amount": "1000000"}]}`,
 			exp: DefaultParams(),
 		},
 	}
@@ -103,6 +103,11 @@ func (p StoreCodeProposal) ValidateBasic() error {
 			return sdkerrors.Wrap(err, "instantiate permission")
 		}
 	}
+	if p.Metadata != nil {
+		if err := p.Metadata.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "metadata")
+		}
+	}
 	return nil
 }
 
@@ -124,12 +129,14 @@ func (p StoreCodeProposal) MarshalYAML() (interface{}, error) {
 		RunAs                 string        `yaml:"run_as"`
 		WASMByteCode          string        `yaml:"wasm_byte_code"`
 		InstantiatePermission *AccessConfig `yaml:"instantiate_permission"`
+		Metadata              *CodeMetadata `yaml:"metadata,omitempty"`
 	}{
 		Title:                 p.Title,
 		Description:           p.Description,
 		RunAs:                 p.RunAs,
 		WASMByteCode:          base64.StdEncoding.EncodeToString(p.WASMByteCode),
 		InstantiatePermission: p.InstantiatePermission,
+		Metadata:              p.Metadata,
 	}, nil
 }
 
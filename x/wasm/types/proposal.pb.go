@@ -38,6 +38,8 @@ type StoreCodeProposal struct {
 	WASMByteCode []byte `protobuf:"bytes,4,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
 	// InstantiatePermission to apply on contract creation, optional
 	InstantiatePermission *AccessConfig `protobuf:"bytes,7,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// Metadata links the code to its source, optional
+	Metadata *CodeMetadata `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *StoreCodeProposal) Reset()      { *m = StoreCodeProposal{} }
@@ -539,58 +541,59 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/proposal.proto", fileDescriptor_be6422d717c730cb) }
 
 var fileDescriptor_be6422d717c730cb = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x34, 0x89, 0x93, 0x4e, 0x23, 0x08, 0xde, 0xb4, 0x1b, 0x0a, 0xb2, 0x23, 0x2f, 0x42,
-	0x91, 0x10, 0xb6, 0x52, 0x24, 0x04, 0xdc, 0xea, 0x80, 0x44, 0x57, 0xaa, 0x54, 0xb9, 0xaa, 0x90,
-	0x00, 0x61, 0x4d, 0xec, 0xa9, 0x77, 0x84, 0x3d, 0x63, 0x79, 0x26, 0xed, 0xf6, 0x3f, 0x70, 0xe0,
-	0x80, 0x38, 0xf1, 0x03, 0x10, 0x17, 0xc4, 0x9d, 0x2b, 0x52, 0x8f, 0x7b, 0xdc, 0x93, 0x61, 0xd3,
-	0x7f, 0xd0, 0x23, 0x12, 0x12, 0x9a, 0x19, 0xa7, 0xa4, 0x5d, 0x9a, 0x5d, 0xc4, 0x06, 0x89, 0x8b,
-	0xe5, 0xf1, 0x7b, 0x6f, 0xbe, 0x6f, 0x3e, 0x7d, 0x6f, 0x9e, 0xa1, 0x1d, 0x31, 0x9e, 0x9d, 0x22,
-	0x9e, 0x79, 0xea, 0x71, 0x32, 0xf2, 0xf2, 0x82, 0xe5, 0x8c, 0xa3, 0xd4, 0xcd, 0x0b, 0x26, 0x98,
-	0xd9, 0x9d, 0x27, 0xb8, 0xea, 0x71, 0x32, 0xda, 0xee, 0x25, 0x2c, 0x61, 0x2a, 0xe8, 0xc9, 0x37,
-	0x9d, 0xb7, 0x6d, 0xc9, 0x3c, 0xc6, 0xbd, 0x09, 0xe2, 0xd8, 0x3b, 0x19, 0x4d, 0xb0, 0x40, 0x23,
-	0x2f, 0x62, 0x84, 0x56, 0xf1, 0xd7, 0x9f, 0x02, 0x12, 0x67, 0x39, 0xe6, 0x3a, 0xea, 0xfc, 0x01,
-	0xe0, 0x2b, 0x87, 0x82, 0x15, 0x78, 0xcc, 0x62, 0x7c, 0x50, 0x31, 0x30, 0x7b, 0xb0, 0x29, 0x88,
-	0x48, 0x71, 0x1f, 0x0c, 0xc0, 0x70, 0x3d, 0xd0, 0x0b, 0x73, 0x00, 0x37, 0x62, 0xcc, 0xa3, 0x82,
-	0xe4, 0x82, 0x30, 0xda, 0x5f, 0x53, 0xb1, 0xc5, 0x4f, 0xe6, 0x26, 0x34, 0x8a, 0x29, 0x0d, 0x11,
-	0xef, 0xd7, 0x75, 0x61, 0x31, 0xa5, 0xbb, 0xdc, 0x7c, 0x17, 0xbe, 0x24, 0xb1, 0xc3, 0xc9, 0x99,
-	0xc0, 0x61, 0xc4, 0x62, 0xdc, 0x6f, 0x0c, 0xc0, 0xb0, 0xe3, 0x77, 0x67, 0xa5, 0xdd, 0xf9, 0x64,
-	0xf7, 0x70, 0xdf, 0x3f, 0x13, 0x8a, 0x40, 0xd0, 0x91, 0x79, 0xf3, 0x95, 0x79, 0x04, 0xb7, 0x08,
-	0xe5, 0x02, 0x51, 0x41, 0x90, 0xc0, 0x61, 0x8e, 0x8b, 0x8c, 0x70, 0x2e, 0xb1, 0x5b, 0x03, 0x30,
-	0xdc, 0xd8, 0xb1, 0xdc, 0x9b, 0x1a, 0xb9, 0xbb, 0x51, 0x84, 0x39, 0x1f, 0x33, 0x7a, 0x4c, 0x92,
-	0x60, 0x73, 0xa1, 0xfa, 0xe0, 0xaa, 0xf8, 0x7e, 0xa3, 0xdd, 0xec, 0x1a, 0xf7, 0x1b, 0x6d, 0xa3,
-	0xdb, 0x72, 0x7e, 0x59, 0x83, 0xaf, 0xed, 0xfd, 0x95, 0x35, 0x66, 0x54, 0x14, 0x28, 0x12, 0xab,
-	0x52, 0xa2, 0x07, 0x9b, 0x28, 0xce, 0x08, 0x55, 0x02, 0xac, 0x07, 0x7a, 0x61, 0xde, 0x83, 0x2d,
-	0xa9, 0x4a, 0x48, 0xe2, 0x7e, 0x73, 0x00, 0x86, 0x0d, 0x1f, 0xce, 0x4a, 0xdb, 0x90, 0x12, 0xec,
-	0x7d, 0x18, 0x18, 0x32, 0xb4, 0x17, 0xcb, 0xd2, 0x14, 0x4d, 0x70, 0xda, 0x37, 0x74, 0xa9, 0x5a,
-	0x98, 0x43, 0x58, 0xcf, 0x78, 0xa2, 0xf4, 0xe8, 0xf8, 0x5b, 0xbf, 0x97, 0xb6, 0x19, 0xa0, 0xd3,
-	0xf9, 0x29, 0xf6, 0x31, 0xe7, 0x28, 0xc1, 0x81, 0x4c, 0x31, 0x3f, 0x87, 0xcd, 0xe3, 0x29, 0x8d,
-	0x79, 0xbf, 0x3d, 0xa8, 0x0f, 0x37, 0x76, 0x5e, 0x75, 0xb5, 0x6f, 0x5c, 0xe9, 0x1b, 0xb7, 0xf2,
-	0x8d, 0x3b, 0x66, 0x84, 0xfa, 0x6f, 0x9d, 0x97, 0x76, 0xed, 0x87, 0x5f, 0xed, 0x7b, 0x09, 0x11,
-	0x0f, 0xa6, 0x13, 0x37, 0x62, 0x99, 0x97, 0x12, 0x8a, 0xbd, 0x74, 0x92, 0xbd, 0xcd, 0xe3, 0x2f,
-	0x2b, 0x03, 0xc9, 0x5c, 0x1e, 0xe8, 0x4d, 0x9d, 0x9f, 0x01, 0xbc, 0xbb, 0x4f, 0x92, 0xe2, 0x45,
-	0x6a, 0xb8, 0x0d, 0xdb, 0x51, 0xb5, 0x57, 0xa5, 0xd7, 0xd5, 0xfa, 0xf9, 0x24, 0xab, 0xc4, 0x31,
-	0x9e, 0x29, 0x8e, 0xf3, 0x0d, 0x80, 0xbd, 0xc3, 0x69, 0xcc, 0x56, 0xc2, 0xbd, 0x7e, 0x83, 0x7b,
-	0x45, 0xab, 0xf1, 0x6c, 0x5a, 0x5f, 0xad, 0xc1, 0xbb, 0x1f, 0x3d, 0xc4, 0xd1, 0x74, 0xf5, 0xce,
-	0x5c, 0x26, 0x76, 0x45, 0xb8, 0xf9, 0x0f, 0x4c, 0x66, 0xac, 0xc2, 0x64, 0xdf, 0x01, 0x78, 0xe7,
-	0x28, 0x8f, 0x91, 0xc0, 0xbb, 0xb2, 0x6f, 0xfe, 0xb5, 0x14, 0x23, 0xb8, 0x4e, 0xf1, 0x69, 0xa8,
-	0x3b, 0x52, 0xa9, 0xe1, 0xf7, 0x2e, 0x4b, 0xbb, 0x7b, 0x86, 0xb2, 0xf4, 0x03, 0xe7, 0x2a, 0xe4,
-	0x04, 0x6d, 0x8a, 0x4f, 0x15, 0xe4, 0x32, 0x99, 0x9c, 0x07, 0xd0, 0x1c, 0xa7, 0x18, 0x15, 0x2f,
-	0x86, 0xdc, 0x12, 0x07, 0x39, 0x3f, 0x02, 0xd8, 0x3d, 0x20, 0x54, 0xda, 0x9d, 0x5f, 0x01, 0xbd,
-	0x79, 0x0d, 0xc8, 0xef, 0x5e, 0x96, 0x76, 0x47, 0x9f, 0x44, 0x7d, 0x76, 0xe6, 0xd0, 0xef, 0xfd,
-	0x0d, 0xb4, 0xbf, 0x75, 0x59, 0xda, 0xa6, 0xce, 0x5e, 0x08, 0x3a, 0xd7, 0x29, 0xbd, 0x2f, 0x29,
-	0xa9, 0xa6, 0x93, 0xe6, 0xa9, 0x0f, 0x1b, 0xbe, 0x35, 0x2b, 0xed, 0x96, 0xee, 0x3a, 0x7e, 0x59,
-	0xda, 0x2f, 0xeb, 0x1d, 0xe6, 0x49, 0x4e, 0xd0, 0xd2, 0x9d, 0xc8, 0x9d, 0x9f, 0x00, 0x34, 0x8f,
-	0x68, 0xfe, 0xbf, 0xe2, 0xfc, 0x2d, 0x80, 0xe6, 0xe2, 0x3c, 0xd1, 0xd6, 0x5b, 0xbc, 0x7a, 0xc0,
-	0xad, 0x57, 0xcf, 0x67, 0xb7, 0x8e, 0xae, 0xb5, 0xe7, 0x19, 0x5d, 0x7e, 0x43, 0xb6, 0xc7, 0x2d,
-	0x03, 0xcc, 0xb9, 0x00, 0xd0, 0xd6, 0x64, 0xae, 0x8f, 0xae, 0x63, 0x92, 0xfc, 0x87, 0xca, 0x7e,
-	0x01, 0x37, 0x91, 0xa2, 0x1c, 0x46, 0x0a, 0x3a, 0x9c, 0x2a, 0x4a, 0x5a, 0xe6, 0x8d, 0x9d, 0x37,
-	0x96, 0x9f, 0x50, 0xf3, 0xaf, 0xce, 0x79, 0x07, 0x3d, 0x15, 0xe1, 0xfe, 0xc7, 0xe7, 0x4f, 0xac,
-	0xda, 0xe3, 0x27, 0x56, 0xed, 0xfb, 0x99, 0x05, 0xce, 0x67, 0x16, 0x78, 0x34, 0xb3, 0xc0, 0x6f,
-	0x33, 0x0b, 0x7c, 0x7d, 0x61, 0xd5, 0x1e, 0x5d, 0x58, 0xb5, 0xc7, 0x17, 0x56, 0xed, 0x53, 0xe7,
-	0xe6, 0xfd, 0x21, 0xc1, 0x62, 0xef, 0xa1, 0xfe, 0xdb, 0x51, 0x97, 0xc8, 0xc4, 0x50, 0xff, 0x3a,
-	0xef, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0x64, 0x4e, 0x2a, 0x98, 0x74, 0x09, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0xcd, 0xe4, 0x8f, 0x93, 0x4e, 0x23, 0x08, 0xde, 0xb4, 0x1b, 0x0a, 0xb2, 0x23, 0x2f, 0x42,
+	0x91, 0x10, 0xb6, 0x52, 0x24, 0x04, 0x7b, 0xab, 0x03, 0x12, 0x5d, 0xa9, 0x52, 0xe5, 0xaa, 0x42,
+	0x02, 0x44, 0x34, 0xb1, 0xa7, 0xde, 0x11, 0xf6, 0x8c, 0xe5, 0x99, 0xb4, 0xdb, 0xef, 0xc0, 0x81,
+	0x03, 0xe2, 0xc4, 0x07, 0x40, 0x5c, 0x10, 0x47, 0x24, 0xae, 0x48, 0x3d, 0xee, 0x71, 0x4f, 0x86,
+	0x4d, 0xbf, 0x41, 0x8f, 0x9c, 0xd0, 0xcc, 0x38, 0x21, 0xed, 0xd2, 0xee, 0x22, 0x1a, 0xa4, 0xbd,
+	0x38, 0x19, 0xff, 0xde, 0xcc, 0x7b, 0xf3, 0xf4, 0x7e, 0x33, 0x86, 0x76, 0xc8, 0x78, 0x7a, 0x82,
+	0x78, 0xea, 0xa9, 0xc7, 0xf1, 0xd0, 0xcb, 0x72, 0x96, 0x31, 0x8e, 0x12, 0x37, 0xcb, 0x99, 0x60,
+	0x66, 0x67, 0x0e, 0x70, 0xd5, 0xe3, 0x78, 0xb8, 0xd5, 0x8d, 0x59, 0xcc, 0x54, 0xd1, 0x93, 0xff,
+	0x34, 0x6e, 0xcb, 0x92, 0x38, 0xc6, 0xbd, 0x09, 0xe2, 0xd8, 0x3b, 0x1e, 0x4e, 0xb0, 0x40, 0x43,
+	0x2f, 0x64, 0x84, 0x96, 0xf5, 0x37, 0x9f, 0x21, 0x12, 0xa7, 0x19, 0xe6, 0xba, 0xea, 0xfc, 0x52,
+	0x85, 0xaf, 0x1d, 0x08, 0x96, 0xe3, 0x11, 0x8b, 0xf0, 0x7e, 0xa9, 0xc0, 0xec, 0xc2, 0x86, 0x20,
+	0x22, 0xc1, 0x3d, 0xd0, 0x07, 0x83, 0xb5, 0x40, 0x0f, 0xcc, 0x3e, 0x5c, 0x8f, 0x30, 0x0f, 0x73,
+	0x92, 0x09, 0xc2, 0x68, 0xaf, 0xaa, 0x6a, 0xcb, 0xaf, 0xcc, 0x0d, 0x68, 0xe4, 0x53, 0x3a, 0x46,
+	0xbc, 0x57, 0xd3, 0x13, 0xf3, 0x29, 0xdd, 0xe1, 0xe6, 0xfb, 0xf0, 0x15, 0xc9, 0x3d, 0x9e, 0x9c,
+	0x0a, 0x3c, 0x0e, 0x59, 0x84, 0x7b, 0xf5, 0x3e, 0x18, 0xb4, 0xfd, 0xce, 0xac, 0xb0, 0xdb, 0x9f,
+	0xee, 0x1c, 0xec, 0xf9, 0xa7, 0x42, 0x09, 0x08, 0xda, 0x12, 0x37, 0x1f, 0x99, 0x87, 0x70, 0x93,
+	0x50, 0x2e, 0x10, 0x15, 0x04, 0x09, 0x3c, 0xce, 0x70, 0x9e, 0x12, 0xce, 0x25, 0x77, 0xb3, 0x0f,
+	0x06, 0xeb, 0xdb, 0x96, 0x7b, 0xd5, 0x23, 0x77, 0x27, 0x0c, 0x31, 0xe7, 0x23, 0x46, 0x8f, 0x48,
+	0x1c, 0x6c, 0x2c, 0xcd, 0xde, 0x5f, 0x4c, 0x36, 0xef, 0xc3, 0x56, 0x8a, 0x05, 0x8a, 0x90, 0x40,
+	0xbd, 0xd6, 0x75, 0x0b, 0x49, 0x01, 0x7b, 0x25, 0x2a, 0x58, 0xe0, 0x1f, 0xd4, 0x5b, 0x8d, 0x8e,
+	0xf1, 0xa0, 0xde, 0x32, 0x3a, 0x4d, 0xe7, 0xb7, 0x2a, 0x7c, 0x63, 0xf7, 0x6f, 0x86, 0x11, 0xa3,
+	0x22, 0x47, 0xa1, 0x58, 0x95, 0x8b, 0x5d, 0xd8, 0x40, 0x51, 0x4a, 0xa8, 0x32, 0x6f, 0x2d, 0xd0,
+	0x03, 0xf3, 0x1e, 0x6c, 0x4a, 0x47, 0xc7, 0x24, 0xea, 0x35, 0xfa, 0x60, 0x50, 0xf7, 0xe1, 0xac,
+	0xb0, 0x0d, 0xa9, 0x7e, 0xf7, 0xa3, 0xc0, 0x90, 0xa5, 0xdd, 0x48, 0x4e, 0x4d, 0xd0, 0x04, 0x27,
+	0x3d, 0x43, 0x4f, 0x55, 0x03, 0x73, 0x00, 0x6b, 0x29, 0x8f, 0x95, 0x97, 0x6d, 0x7f, 0xf3, 0xcf,
+	0xc2, 0x36, 0x03, 0x74, 0x32, 0xdf, 0xc5, 0x1e, 0xe6, 0x1c, 0xc5, 0x38, 0x90, 0x10, 0xf3, 0x0b,
+	0xd8, 0x38, 0x9a, 0xd2, 0x88, 0xf7, 0x5a, 0xfd, 0xda, 0x60, 0x7d, 0xfb, 0x75, 0x57, 0x67, 0xce,
+	0x95, 0x99, 0x73, 0xcb, 0xcc, 0xb9, 0x23, 0x46, 0xa8, 0xff, 0xce, 0x59, 0x61, 0x57, 0x7e, 0xfc,
+	0xdd, 0xbe, 0x17, 0x13, 0xf1, 0x70, 0x3a, 0x71, 0x43, 0x96, 0x7a, 0x09, 0xa1, 0xd8, 0x4b, 0x26,
+	0xe9, 0xbb, 0x3c, 0xfa, 0xaa, 0x0c, 0x9f, 0xc4, 0xf2, 0x40, 0x2f, 0xea, 0xfc, 0x0a, 0xe0, 0xdd,
+	0x3d, 0x12, 0xe7, 0xb7, 0xe9, 0xe1, 0x16, 0x6c, 0x85, 0xe5, 0x5a, 0xa5, 0x5f, 0x8b, 0xf1, 0x8b,
+	0x59, 0x56, 0x9a, 0x63, 0x3c, 0xd7, 0x1c, 0xe7, 0x5b, 0x00, 0xbb, 0x07, 0xd3, 0x88, 0xad, 0x44,
+	0x7b, 0xed, 0x8a, 0xf6, 0x52, 0x56, 0xfd, 0xf9, 0xb2, 0xbe, 0xae, 0xc2, 0xbb, 0x1f, 0x3f, 0xc2,
+	0xe1, 0x74, 0xf5, 0xc9, 0xbc, 0xc9, 0xec, 0x52, 0x70, 0xe3, 0x5f, 0x84, 0xcc, 0x58, 0x45, 0xc8,
+	0xbe, 0x07, 0xf0, 0xce, 0x61, 0x16, 0x21, 0x81, 0x77, 0x64, 0xdf, 0xfc, 0x67, 0x2b, 0x86, 0x70,
+	0x8d, 0xe2, 0x93, 0xb1, 0xee, 0x48, 0xe5, 0x86, 0xdf, 0xbd, 0x28, 0xec, 0xce, 0x29, 0x4a, 0x93,
+	0xfb, 0xce, 0xa2, 0xe4, 0x04, 0x2d, 0x8a, 0x4f, 0x14, 0xe5, 0x4d, 0x36, 0x39, 0x0f, 0xa1, 0x39,
+	0x4a, 0x30, 0xca, 0x6f, 0x47, 0xdc, 0x0d, 0x09, 0x72, 0x7e, 0x02, 0xb0, 0xb3, 0x4f, 0xa8, 0x8c,
+	0x3b, 0x5f, 0x10, 0xbd, 0x7d, 0x89, 0xc8, 0xef, 0x5c, 0x14, 0x76, 0x5b, 0xef, 0x44, 0xbd, 0x76,
+	0xe6, 0xd4, 0x1f, 0xfc, 0x03, 0xb5, 0xbf, 0x79, 0x51, 0xd8, 0xa6, 0x46, 0x2f, 0x15, 0x9d, 0xcb,
+	0x92, 0x3e, 0x84, 0xad, 0xb2, 0xe9, 0x64, 0x78, 0x6a, 0x83, 0xba, 0x6f, 0xcd, 0x0a, 0xbb, 0xa9,
+	0xbb, 0x8e, 0x5f, 0x14, 0xf6, 0xab, 0x7a, 0x85, 0x39, 0xc8, 0x09, 0x9a, 0xba, 0x13, 0xb9, 0xf3,
+	0x33, 0x80, 0xe6, 0x21, 0xcd, 0x5e, 0x2a, 0xcd, 0xdf, 0x01, 0x68, 0x2e, 0xdf, 0x45, 0x3a, 0x7a,
+	0xcb, 0x47, 0x0f, 0xb8, 0xf6, 0xe8, 0xf9, 0xfc, 0xda, 0x6b, 0xaf, 0xfa, 0x22, 0xd7, 0x9e, 0x5f,
+	0x97, 0xed, 0x71, 0xcd, 0xe5, 0xe7, 0x9c, 0x03, 0x68, 0x6b, 0x31, 0x97, 0xaf, 0xae, 0x23, 0x12,
+	0xff, 0x8f, 0xce, 0x7e, 0x09, 0x37, 0x90, 0x92, 0x3c, 0x0e, 0x15, 0xf5, 0x78, 0xaa, 0x24, 0x69,
+	0x9b, 0xd7, 0xb7, 0xdf, 0xba, 0x79, 0x87, 0x5a, 0x7f, 0xb9, 0xcf, 0x3b, 0xe8, 0x99, 0x0a, 0xf7,
+	0x3f, 0x39, 0x7b, 0x6a, 0x55, 0x9e, 0x3c, 0xb5, 0x2a, 0x3f, 0xcc, 0x2c, 0x70, 0x36, 0xb3, 0xc0,
+	0xe3, 0x99, 0x05, 0xfe, 0x98, 0x59, 0xe0, 0x9b, 0x73, 0xab, 0xf2, 0xf8, 0xdc, 0xaa, 0x3c, 0x39,
+	0xb7, 0x2a, 0x9f, 0x39, 0x57, 0xcf, 0x0f, 0x49, 0x16, 0x79, 0x8f, 0xd4, 0xaf, 0x3e, 0x44, 0x26,
+	0x86, 0xfa, 0x4e, 0x7a, 0xef, 0xaf, 0x01, 0x00, 0xa8, 0x90, 0xe7, 0xfd, 0xb0, 0x09, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -627,6 +630,9 @@ func (this *StoreCodeProposal) Equal(that interface{}) bool {
 	if !this.InstantiatePermission.Equal(that1.InstantiatePermission) {
 		return false
 	}
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
+	}
 	return true
 }
 func (this *InstantiateContractProposal) Equal(that interface{}) bool {
@@ -1007,6 +1013,18 @@ func (m *StoreCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.InstantiatePermission != nil {
 		{
 			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
@@ -1429,20 +1447,20 @@ func (m *PinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.CodeIDs) > 0 {
-		dAtA3 := make([]byte, len(m.CodeIDs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.CodeIDs)*10)
+		var j3 int
 		for _, num := range m.CodeIDs {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintProposal(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintProposal(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1484,20 +1502,20 @@ func (m *UnpinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.CodeIDs) > 0 {
-		dAtA5 := make([]byte, len(m.CodeIDs)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.CodeIDs)*10)
+		var j5 int
 		for _, num := range m.CodeIDs {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintProposal(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintProposal(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1644,6 +1662,10 @@ func (m *StoreCodeProposal) Size() (n int) {
 		l = m.InstantiatePermission.Size()
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
 	return n
 }
 
@@ -2109,6 +2131,42 @@ func (m *StoreCodeProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &CodeMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -137,12 +137,6 @@ func TestValidateStoreCodeProposal(t *testing.T) {
 			}),
 			expErr: true,
 		},
-		"wasm code invalid": {
-			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
-				p.WASMByteCode = bytes.Repeat([]byte{0x0}, MaxWasmSize+1)
-			}),
-			expErr: true,
-		},
 		"with invalid instantiate permission": {
 			src: StoreCodeProposalFixture(func(p *StoreCodeProposal) {
 				p.InstantiatePermission = &AccessConfig{}
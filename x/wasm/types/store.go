@@ -12,6 +12,7 @@ var _ wasmvm.KVStore = (*WasmStore)(nil)
 // The spec of interface `Iterator` is a bit different so we cannot use cosmos KVStore directly.
 type WasmStore struct {
 	storetypes.KVStore
+	counter *StorageCounter
 }
 
 // Iterator re-define for wasmvm's `Iterator`
@@ -24,7 +25,75 @@ func (s WasmStore) ReverseIterator(start, end []byte) wasmvm.Iterator {
 	return s.KVStore.ReverseIterator(start, end)
 }
 
+// Set counts the written bytes before the value is stored. It panics when the counter rejects the grown usage.
+func (s WasmStore) Set(key, value []byte) {
+	if s.counter != nil {
+		s.counter.set(key, len(value))
+	}
+	s.KVStore.Set(key, value)
+}
+
+// Delete counts the released bytes before the value is deleted.
+func (s WasmStore) Delete(key []byte) {
+	if s.counter != nil {
+		s.counter.set(key, -1)
+	}
+	s.KVStore.Delete(key)
+}
+
 // NewWasmStore creates a instance of WasmStore
 func NewWasmStore(kvStore storetypes.KVStore) WasmStore {
-	return WasmStore{kvStore}
+	return WasmStore{KVStore: kvStore}
+}
+
+// NewCountingWasmStore creates a instance of WasmStore that counts the bytes of all keys and values with the counter
+func NewCountingWasmStore(kvStore storetypes.KVStore, counter *StorageCounter) WasmStore {
+	return WasmStore{KVStore: kvStore, counter: counter}
+}
+
+// StorageCounter counts the bytes of all keys and values and the number of keys in the store of a contract. The sizes
+// of the replaced values are read from an unmetered view of the same store as the writes are charged already.
+type StorageCounter struct {
+	unmetered storetypes.KVStore
+	check     func(bytes, keys uint64) error
+	// Bytes is the current storage usage
+	Bytes uint64
+	// Keys is the current number of keys
+	Keys uint64
+	// Err is the error of the check that aborted a write
+	Err error
+}
+
+// NewStorageCounter creates a counter that starts with the given usage. The optional check is called with the new
+// usage before each write that grows it, a returned error aborts the write.
+func NewStorageCounter(unmetered storetypes.KVStore, bytes, keys uint64, check func(bytes, keys uint64) error) *StorageCounter {
+	return &StorageCounter{unmetered: unmetered, check: check, Bytes: bytes, Keys: keys}
+}
+
+// set updates the usage for the key with a value of the given size, negative for a deletion.
+func (c *StorageCounter) set(key []byte, valueSize int) {
+	var oldSize, newSize, oldKeys, newKeys uint64
+	if old := c.unmetered.Get(key); old != nil {
+		oldSize, oldKeys = uint64(len(key)+len(old)), 1
+	}
+	if valueSize >= 0 {
+		newSize, newKeys = uint64(len(key)+valueSize), 1
+	}
+	// state written before the usage was counted is unknown to the counter
+	bytes := saturatingSub(c.Bytes, oldSize) + newSize
+	keys := saturatingSub(c.Keys, oldKeys) + newKeys
+	if (bytes > c.Bytes || keys > c.Keys) && c.check != nil {
+		if err := c.check(bytes, keys); err != nil {
+			c.Err = err
+			panic(err)
+		}
+	}
+	c.Bytes, c.Keys = bytes, keys
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+	return a - b
 }
@@ -2,7 +2,6 @@ package types
 
 import (
 	"bytes"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -64,6 +63,22 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with metadata": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Metadata:     &CodeMetadata{Source: "https://example.com/source", Builder: "cosmwasm/rust-optimizer:0.12.9"},
+			},
+			valid: true,
+		},
+		"invalid metadata": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Metadata:     &CodeMetadata{Builder: "invalid"},
+			},
+			valid: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -117,13 +132,6 @@ func TestInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
-		"label too long": {
-			msg: MsgInstantiateContract{
-				Sender: goodAddress,
-				Label:  strings.Repeat("food", 33),
-			},
-			valid: false,
-		},
 		"bad sender minimal": {
 			msg: MsgInstantiateContract{
 				Sender: badAddress,
@@ -185,6 +193,126 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestInstantiateContract2Validation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgInstantiateContract2
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgInstantiateContract2{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: true,
+		},
+		"missing code": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"missing label": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"bad sender minimal": {
+			msg: MsgInstantiateContract2{
+				Sender: badAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("{}"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"correct maximal": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				Admin:  goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte(`{"some": "data"}`),
+				Funds:  sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Salt:   bytes.Repeat([]byte{0}, MaxSaltSize),
+				FixMsg: true,
+			},
+			valid: true,
+		},
+		"negative funds": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte(`{"some": "data"}`),
+				// we cannot use sdk.NewCoin() constructors as they panic on creating invalid data (before we can test)
+				Funds: sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(-200)}},
+				Salt:  []byte{0},
+			},
+			valid: false,
+		},
+		"non json init msg": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte("invalid-json"),
+				Salt:   []byte{0},
+			},
+			valid: false,
+		},
+		"empty salt": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte(`{"some": "data"}`),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract2{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+				Label:  "foo",
+				Msg:    []byte(`{"some": "data"}`),
+				Salt:   bytes.Repeat([]byte{0}, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestExecuteContractValidation(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -417,6 +545,55 @@ func TestMsgClearAdministrator(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateParams(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateParams
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateParams{
+				Authority: goodAddress,
+				Params:    DefaultParams(),
+			},
+		},
+		"bad authority": {
+			src: MsgUpdateParams{
+				Authority: badAddress,
+				Params:    DefaultParams(),
+			},
+			expErr: true,
+		},
+		"authority missing": {
+			src: MsgUpdateParams{
+				Params: DefaultParams(),
+			},
+			expErr: true,
+		},
+		"invalid params": {
+			src: MsgUpdateParams{
+				Authority: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgMigrateContract(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -516,6 +693,14 @@ func TestMsgJsonSignBytes(t *testing.T) {
 {
 	"type":"wasm/MsgInstantiateContract",
 	"value": {"msg": {"foo":"bar"}, "funds":[]}
+}`,
+		},
+		"MsgInstantiateContract2": {
+			src: &MsgInstantiateContract2{Msg: RawContractMessage(myInnerMsg)},
+			exp: `
+{
+	"type":"wasm/MsgInstantiateContract2",
+	"value": {"msg": {"foo":"bar"}, "funds":[]}
 }`,
 		},
 		"MsgExecuteContract": {
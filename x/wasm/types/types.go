@@ -1,8 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -40,6 +44,37 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := c.InstantiateConfig.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "instantiate config")
 	}
+	if c.Metadata != nil {
+		if err := c.Metadata.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "metadata")
+		}
+		if len(c.Metadata.CodeHashAttestation) != 0 && !bytes.Equal(c.Metadata.CodeHashAttestation, c.CodeHash) {
+			return sdkerrors.Wrap(ErrInvalid, "code hash attestation does not match the code hash")
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true when none of the metadata fields is set
+func (m CodeMetadata) IsEmpty() bool {
+	return m.Source == "" && m.Builder == "" && len(m.CodeHashAttestation) == 0
+}
+
+// ValidateBasic performs stateless validation of the set metadata fields. All fields are optional.
+func (m CodeMetadata) ValidateBasic() error {
+	if m.Source != "" {
+		if err := validateSourceURL(m.Source); err != nil {
+			return sdkerrors.Wrap(err, "source")
+		}
+	}
+	if m.Builder != "" {
+		if err := validateBuilder(m.Builder); err != nil {
+			return sdkerrors.Wrap(err, "builder")
+		}
+	}
+	if len(m.CodeHashAttestation) != 0 && len(m.CodeHashAttestation) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "code hash attestation must be %d bytes", sha256.Size)
+	}
 	return nil
 }
 
@@ -52,7 +87,63 @@ func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, instantiatePermission
 	}
 }
 
-var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate}
+var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{
+	ContractCodeHistoryOperationTypeGenesis,
+	ContractCodeHistoryOperationTypeInit,
+	ContractCodeHistoryOperationTypeMigrate,
+	ContractCodeHistoryOperationTypeAdminProposed,
+	ContractCodeHistoryOperationTypeAdminAccepted,
+	ContractCodeHistoryOperationTypeAdminProposalCanceled,
+}
+
+// IsCodeChange returns true for the operations that set the code of a contract. The admin operations are recorded in
+// the history as well but keep the code unchanged.
+func (o ContractCodeHistoryOperationType) IsCodeChange() bool {
+	switch o {
+	case ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate:
+		return true
+	default:
+		return false
+	}
+}
+
+var _ ContractInfoExtension = &ContractMetadata{}
+
+// IsEmpty returns true when no metadata field is set
+func (m ContractMetadata) IsEmpty() bool {
+	return m.Description == "" && m.Website == "" && m.IconURI == "" && len(m.Tags) == 0
+}
+
+// ValidateBasic performs stateless validation of the set metadata fields. All fields are optional.
+func (m ContractMetadata) ValidateBasic() error {
+	if len(m.Description) > MaxContractDescriptionSize {
+		return sdkerrors.Wrapf(ErrLimit, "description cannot be longer than %d characters", MaxContractDescriptionSize)
+	}
+	if m.Website != "" {
+		if err := validateURI(m.Website, MaxContractURISize, "https"); err != nil {
+			return sdkerrors.Wrap(err, "website")
+		}
+	}
+	if m.IconURI != "" {
+		if err := validateURI(m.IconURI, MaxContractURISize, "https", "ipfs"); err != nil {
+			return sdkerrors.Wrap(err, "icon uri")
+		}
+	}
+	if len(m.Tags) > MaxContractTags {
+		return sdkerrors.Wrapf(ErrLimit, "cannot have more than %d tags", MaxContractTags)
+	}
+	seen := make(map[string]struct{}, len(m.Tags))
+	for _, tag := range m.Tags {
+		if err := validateTag(tag); err != nil {
+			return sdkerrors.Wrapf(err, "tag %q", tag)
+		}
+		if _, ok := seen[tag]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "tag %q", tag)
+		}
+		seen[tag] = struct{}{}
+	}
+	return nil
+}
 
 // NewContractInfo creates a new instance of a given WASM contract info
 func NewContractInfo(codeID uint64, creator, admin sdk.AccAddress, label string, createdAt *AbsoluteTxPosition) ContractInfo {
@@ -89,9 +180,17 @@ func (c *ContractInfo) ValidateBasic() error {
 			return sdkerrors.Wrap(err, "admin")
 		}
 	}
+	if len(c.PendingAdmin) != 0 {
+		if _, err := sdk.AccAddressFromBech32(c.PendingAdmin); err != nil {
+			return sdkerrors.Wrap(err, "pending admin")
+		}
+	}
 	if err := validateLabel(c.Label); err != nil {
 		return sdkerrors.Wrap(err, "label")
 	}
+	if c.MigrationDelay > MaxMigrationDelay {
+		return sdkerrors.Wrapf(ErrLimit, "migration delay must not exceed %d", MaxMigrationDelay)
+	}
 	if c.Extension == nil {
 		return nil
 	}
@@ -172,6 +271,218 @@ func (c *ContractInfo) AddMigration(ctx sdk.Context, codeID uint64, msg []byte)
 	return h
 }
 
+// ProposeAdmin sets the pending admin and returns the history entry that records the proposal.
+func (c *ContractInfo) ProposeAdmin(ctx sdk.Context, pendingAdmin sdk.AccAddress) ContractCodeHistoryEntry {
+	c.PendingAdmin = pendingAdmin.String()
+	return c.adminHistoryEntry(ctx, ContractCodeHistoryOperationTypeAdminProposed, fmt.Sprintf(`{"pending_admin":%q}`, c.PendingAdmin))
+}
+
+// AcceptAdmin makes the pending admin the admin and returns the history entry that records the change.
+func (c *ContractInfo) AcceptAdmin(ctx sdk.Context) ContractCodeHistoryEntry {
+	c.Admin, c.PendingAdmin = c.PendingAdmin, ""
+	return c.adminHistoryEntry(ctx, ContractCodeHistoryOperationTypeAdminAccepted, fmt.Sprintf(`{"admin":%q}`, c.Admin))
+}
+
+// CancelPendingAdmin clears the pending admin and returns the history entry that records the canceled proposal.
+func (c *ContractInfo) CancelPendingAdmin(ctx sdk.Context) ContractCodeHistoryEntry {
+	msg := fmt.Sprintf(`{"pending_admin":%q}`, c.PendingAdmin)
+	c.PendingAdmin = ""
+	return c.adminHistoryEntry(ctx, ContractCodeHistoryOperationTypeAdminProposalCanceled, msg)
+}
+
+func (c ContractInfo) adminHistoryEntry(ctx sdk.Context, op ContractCodeHistoryOperationType, msg string) ContractCodeHistoryEntry {
+	return ContractCodeHistoryEntry{
+		Operation: op,
+		CodeID:    c.CodeID,
+		Updated:   NewAbsoluteTxPosition(ctx),
+		Msg:       RawContractMessage(msg),
+	}
+}
+
+// PendingAdminAddr convert into sdk.AccAddress or nil when not set
+func (c *ContractInfo) PendingAdminAddr() sdk.AccAddress {
+	if c.PendingAdmin == "" {
+		return nil
+	}
+	pendingAdmin, err := sdk.AccAddressFromBech32(c.PendingAdmin)
+	if err != nil { // should never happen
+		panic(err.Error())
+	}
+	return pendingAdmin
+}
+
+// ValidateBasic performs stateless validation of the storage quota
+func (q StorageQuota) ValidateBasic() error {
+	if q.Unlimited && (q.MaxBytes != 0 || q.MaxKeys != 0) {
+		return sdkerrors.Wrap(ErrInvalid, "unlimited quota with limits")
+	}
+	return nil
+}
+
+// IsEmpty returns true when the quota neither sets a limit nor is unlimited
+func (q StorageQuota) IsEmpty() bool {
+	return q.MaxBytes == 0 && q.MaxKeys == 0 && !q.Unlimited
+}
+
+// WithDefaults returns the quota with the limits of the defaults where the quota has none. An unlimited quota is
+// returned as is.
+func (q StorageQuota) WithDefaults(defaults StorageQuota) StorageQuota {
+	if q.Unlimited {
+		return q
+	}
+	if q.MaxBytes == 0 {
+		q.MaxBytes = defaults.MaxBytes
+	}
+	if q.MaxKeys == 0 {
+		q.MaxKeys = defaults.MaxKeys
+	}
+	return q
+}
+
+// Check fails with ErrLimit when the storage usage exceeds a limit of the quota
+func (q StorageQuota) Check(bytes, keys uint64) error {
+	if q.Unlimited {
+		return nil
+	}
+	if q.MaxBytes != 0 && bytes > q.MaxBytes {
+		return sdkerrors.Wrapf(ErrLimit, "contract storage of %d bytes exceeds the quota of %d bytes", bytes, q.MaxBytes)
+	}
+	if q.MaxKeys != 0 && keys > q.MaxKeys {
+		return sdkerrors.Wrapf(ErrLimit, "contract storage of %d keys exceeds the quota of %d keys", keys, q.MaxKeys)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the fee allowance
+func (a FeeAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "grantee")
+	}
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "spend limit")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of a schedule
+func (s Schedule) ValidateBasic() error {
+	if s.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if s.NextHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "next height must be positive")
+	}
+	if err := s.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "msg")
+	}
+	if s.Interval > math.MaxInt32 {
+		return sdkerrors.Wrapf(ErrLimit, "interval must not exceed %d", math.MaxInt32)
+	}
+	if s.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if err := s.GasDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "gas deposit")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of a queued migration
+func (m PendingMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if m.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if err := m.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "msg")
+	}
+	if m.ExecuteHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "execute height must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of a privileged contract registration
+func (p PrivilegedContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !p.BeginBlock && !p.EndBlock {
+		return sdkerrors.Wrap(ErrEmpty, "begin block or end block")
+	}
+	if p.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation of an accepted stargate query
+func (q AcceptedStargateQuery) ValidateBasic() error {
+	if !strings.HasPrefix(q.Path, "/") {
+		return sdkerrors.Wrap(ErrInvalid, "path must start with /")
+	}
+	if strings.TrimSpace(q.ResponseType) == "" {
+		return sdkerrors.Wrap(ErrEmpty, "response type")
+	}
+	if _, ok := StargateQueryEncoding_name[int32(q.Encoding)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "encoding")
+	}
+	return nil
+}
+
+// IsEmpty returns true when the allowlist does not restrict migrations
+func (a MigrationAllowlist) IsEmpty() bool {
+	return len(a.CodeIDs) == 0 && len(a.Checksums) == 0
+}
+
+// Allows returns true when the code ID or the checksum of the migration target is listed
+func (a MigrationAllowlist) Allows(codeID uint64, checksum []byte) bool {
+	for _, v := range a.CodeIDs {
+		if v == codeID {
+			return true
+		}
+	}
+	for _, v := range a.Checksums {
+		if bytes.Equal(v, checksum) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic performs stateless validation of the allowlist entries
+func (a MigrationAllowlist) ValidateBasic() error {
+	codeIDs := make(map[uint64]struct{}, len(a.CodeIDs))
+	for _, v := range a.CodeIDs {
+		if v == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if _, exists := codeIDs[v]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "code id %d", v)
+		}
+		codeIDs[v] = struct{}{}
+	}
+	checksums := make(map[string]struct{}, len(a.Checksums))
+	for _, v := range a.Checksums {
+		if len(v) != sha256.Size {
+			return sdkerrors.Wrapf(ErrInvalid, "checksum must be %d bytes", sha256.Size)
+		}
+		if _, exists := checksums[string(v)]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "checksum %X", v)
+		}
+		checksums[string(v)] = struct{}{}
+	}
+	return nil
+}
+
 // ResetFromGenesis resets contracts timestamp and history.
 func (c *ContractInfo) ResetFromGenesis(ctx sdk.Context) ContractCodeHistoryEntry {
 	c.Created = NewAbsoluteTxPosition(ctx)
@@ -211,6 +522,13 @@ func (c *ContractInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return codectypes.UnpackInterfaces(details, unpacker)
 }
 
+var _ codectypes.UnpackInterfacesMessage = &QueryContractInfoResponse{}
+
+// UnpackInterfaces implements codectypes.UnpackInterfaces so that clients can read the extension of the contract info
+func (r *QueryContractInfoResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return r.ContractInfo.UnpackInterfaces(unpacker)
+}
+
 // NewAbsoluteTxPosition gets a block position from the context
 func NewAbsoluteTxPosition(ctx sdk.Context) *AbsoluteTxPosition {
 	// we must safely handle nil gas meters
@@ -345,8 +663,44 @@ func (a AccessConfig) IsSubset(superSet AccessConfig) bool {
 		return a.Permission == AccessTypeNobody
 	case AccessTypeOnlyAddress:
 		// An exact match or nobody
-		return a.Permission == AccessTypeNobody || (a.Permission == AccessTypeOnlyAddress && a.Address == superSet.Address)
+		switch a.Permission {
+		case AccessTypeNobody:
+			return true
+		case AccessTypeOnlyAddress:
+			return a.Address == superSet.Address
+		case AccessTypeAnyOfAddresses:
+			return isSubset([]string{superSet.Address}, a.Addresses)
+		}
+		return false
+	case AccessTypeAnyOfAddresses:
+		// Nobody or any subset of the addresses
+		switch a.Permission {
+		case AccessTypeNobody:
+			return true
+		case AccessTypeOnlyAddress:
+			return isSubset(superSet.Addresses, []string{a.Address})
+		case AccessTypeAnyOfAddresses:
+			return isSubset(superSet.Addresses, a.Addresses)
+		}
+		return false
 	default:
 		return false
 	}
 }
+
+// isSubset returns true when all elements of the subset are contained in the superset
+func isSubset(superSet, subSet []string) bool {
+	if len(subSet) > len(superSet) {
+		return false
+	}
+	idx := make(map[string]struct{}, len(superSet))
+	for _, v := range superSet {
+		idx[v] = struct{}{}
+	}
+	for _, v := range subSet {
+		if _, ok := idx[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -8,7 +8,9 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	types "github.com/line/lbm-sdk/codec/types"
+	types1 "github.com/line/lbm-sdk/codec/types"
+	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types"
 	github_com_line_ostracon_libs_bytes "github.com/line/ostracon/libs/bytes"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
@@ -39,6 +41,8 @@ const (
 	AccessTypeOnlyAddress AccessType = 2
 	// AccessTypeEverybody unrestricted
 	AccessTypeEverybody AccessType = 3
+	// AccessTypeAnyOfAddresses allow any of the addresses
+	AccessTypeAnyOfAddresses AccessType = 4
 )
 
 var AccessType_name = map[int32]string{
@@ -46,13 +50,15 @@ var AccessType_name = map[int32]string{
 	1: "ACCESS_TYPE_NOBODY",
 	2: "ACCESS_TYPE_ONLY_ADDRESS",
 	3: "ACCESS_TYPE_EVERYBODY",
+	4: "ACCESS_TYPE_ANY_OF_ADDRESSES",
 }
 
 var AccessType_value = map[string]int32{
-	"ACCESS_TYPE_UNSPECIFIED":  0,
-	"ACCESS_TYPE_NOBODY":       1,
-	"ACCESS_TYPE_ONLY_ADDRESS": 2,
-	"ACCESS_TYPE_EVERYBODY":    3,
+	"ACCESS_TYPE_UNSPECIFIED":      0,
+	"ACCESS_TYPE_NOBODY":           1,
+	"ACCESS_TYPE_ONLY_ADDRESS":     2,
+	"ACCESS_TYPE_EVERYBODY":        3,
+	"ACCESS_TYPE_ANY_OF_ADDRESSES": 4,
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
@@ -71,6 +77,13 @@ const (
 	ContractCodeHistoryOperationTypeMigrate ContractCodeHistoryOperationType = 2
 	// ContractCodeHistoryOperationTypeGenesis based on genesis data
 	ContractCodeHistoryOperationTypeGenesis ContractCodeHistoryOperationType = 3
+	// ContractCodeHistoryOperationTypeAdminProposed new admin proposed
+	ContractCodeHistoryOperationTypeAdminProposed ContractCodeHistoryOperationType = 4
+	// ContractCodeHistoryOperationTypeAdminAccepted proposed admin accepted
+	ContractCodeHistoryOperationTypeAdminAccepted ContractCodeHistoryOperationType = 5
+	// ContractCodeHistoryOperationTypeAdminProposalCanceled proposed admin
+	// dropped before it accepted
+	ContractCodeHistoryOperationTypeAdminProposalCanceled ContractCodeHistoryOperationType = 6
 )
 
 var ContractCodeHistoryOperationType_name = map[int32]string{
@@ -78,13 +91,19 @@ var ContractCodeHistoryOperationType_name = map[int32]string{
 	1: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT",
 	2: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE",
 	3: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS",
+	4: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_PROPOSED",
+	5: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_ACCEPTED",
+	6: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_PROPOSAL_CANCELED",
 }
 
 var ContractCodeHistoryOperationType_value = map[string]int32{
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED": 0,
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT":        1,
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE":     2,
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS":     3,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED":             0,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT":                    1,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE":                 2,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS":                 3,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_PROPOSED":          4,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_ACCEPTED":          5,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_PROPOSAL_CANCELED": 6,
 }
 
 func (x ContractCodeHistoryOperationType) String() string {
@@ -95,6 +114,35 @@ func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{1}
 }
 
+// StargateQueryEncoding is the encoding of the response of an accepted
+// stargate query that is returned to the contract
+type StargateQueryEncoding int32
+
+const (
+	// StargateQueryEncodingProtobuf returns the protobuf encoded response
+	StargateQueryEncodingProtobuf StargateQueryEncoding = 0
+	// StargateQueryEncodingJSON returns the proto3 json encoded response
+	StargateQueryEncodingJSON StargateQueryEncoding = 1
+)
+
+var StargateQueryEncoding_name = map[int32]string{
+	0: "STARGATE_QUERY_ENCODING_PROTOBUF",
+	1: "STARGATE_QUERY_ENCODING_JSON",
+}
+
+var StargateQueryEncoding_value = map[string]int32{
+	"STARGATE_QUERY_ENCODING_PROTOBUF": 0,
+	"STARGATE_QUERY_ENCODING_JSON":     1,
+}
+
+func (x StargateQueryEncoding) String() string {
+	return proto.EnumName(StargateQueryEncoding_name, int32(x))
+}
+
+func (StargateQueryEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{2}
+}
+
 // AccessTypeParam
 type AccessTypeParam struct {
 	Value AccessType `protobuf:"varint,1,opt,name=value,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"value,omitempty" yaml:"value"`
@@ -137,6 +185,8 @@ var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 type AccessConfig struct {
 	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"permission,omitempty" yaml:"permission"`
 	Address    string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Addresses are the allowed addresses of the AccessTypeAnyOfAddresses type
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
 }
 
 func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
@@ -179,6 +229,33 @@ type Params struct {
 	GasMultiplier                uint64       `protobuf:"varint,3,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
 	InstanceCost                 uint64       `protobuf:"varint,4,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
 	CompileCost                  uint64       `protobuf:"varint,5,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// UniqueLabelPerCreator rejects instantiating a contract with a label that
+	// the creator already used for another contract
+	UniqueLabelPerCreator bool `protobuf:"varint,6,opt,name=unique_label_per_creator,json=uniqueLabelPerCreator,proto3" json:"unique_label_per_creator,omitempty" yaml:"unique_label_per_creator"`
+	// MaxWasmSize is the max size in bytes of the wasm code, compressed or not,
+	// that can be uploaded
+	MaxWasmSize uint64 `protobuf:"varint,7,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty" yaml:"max_wasm_size"`
+	// MaxLabelSize is the max length of a contract label
+	MaxLabelSize uint64 `protobuf:"varint,8,opt,name=max_label_size,json=maxLabelSize,proto3" json:"max_label_size,omitempty" yaml:"max_label_size"`
+	// MaxDecompressedWasmSize is the max size in bytes of an uploaded wasm code
+	// after the gzip decompression
+	MaxDecompressedWasmSize uint64 `protobuf:"varint,9,opt,name=max_decompressed_wasm_size,json=maxDecompressedWasmSize,proto3" json:"max_decompressed_wasm_size,omitempty" yaml:"max_decompressed_wasm_size"`
+	// StorageDepositPerByte is the deposit that is locked for each byte of
+	// contract state, empty to not require a deposit
+	StorageDepositPerByte github_com_line_lbm_sdk_types.DecCoins `protobuf:"bytes,10,rep,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3,castrepeated=github.com/line/lbm-sdk/types.DecCoins" json:"storage_deposit_per_byte" yaml:"storage_deposit_per_byte"`
+	// MaxContractStorageBytes is the default max size in bytes of all keys and
+	// values in the state of a contract, 0 for no limit
+	MaxContractStorageBytes uint64 `protobuf:"varint,11,opt,name=max_contract_storage_bytes,json=maxContractStorageBytes,proto3" json:"max_contract_storage_bytes,omitempty" yaml:"max_contract_storage_bytes"`
+	// MaxContractStorageKeys is the default max number of keys in the state of a
+	// contract, 0 for no limit
+	MaxContractStorageKeys uint64 `protobuf:"varint,12,opt,name=max_contract_storage_keys,json=maxContractStorageKeys,proto3" json:"max_contract_storage_keys,omitempty" yaml:"max_contract_storage_keys"`
+	// StargateQueryGasPerByte is the SDK gas that is charged per byte of the
+	// request and the response of a stargate query
+	StargateQueryGasPerByte uint64 `protobuf:"varint,13,opt,name=stargate_query_gas_per_byte,json=stargateQueryGasPerByte,proto3" json:"stargate_query_gas_per_byte,omitempty" yaml:"stargate_query_gas_per_byte"`
+	// UploadSessionDeposit is the amount that is held in escrow for each
+	// chunked upload session until it is committed, empty to not require a
+	// deposit
+	UploadSessionDeposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,14,rep,name=upload_session_deposit,json=uploadSessionDeposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"upload_session_deposit" yaml:"upload_session_deposit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -221,6 +298,8 @@ type CodeInfo struct {
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	// InstantiateConfig access control to apply on contract creation, optional
 	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// Metadata links the code to its source, optional
+	Metadata *CodeMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
@@ -256,6 +335,230 @@ func (m *CodeInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CodeInfo proto.InternalMessageInfo
 
+// CodeMetadata links a code to its source so that the code can be verified by
+// a reproducible build
+type CodeMetadata struct {
+	// Source is a valid absolute HTTPS URI to the contract's source code
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Builder is a valid docker image name with tag, such as
+	// "cosmwasm/workspace-optimizer:0.12.9"
+	Builder string `protobuf:"bytes,2,opt,name=builder,proto3" json:"builder,omitempty"`
+	// CodeHashAttestation is the checksum of the wasm code that the builder
+	// produces from the source. It must match the code hash.
+	CodeHashAttestation []byte `protobuf:"bytes,3,opt,name=code_hash_attestation,json=codeHashAttestation,proto3" json:"code_hash_attestation,omitempty"`
+}
+
+func (m *CodeMetadata) Reset()         { *m = CodeMetadata{} }
+func (m *CodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadata) ProtoMessage()    {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{4}
+}
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
+// ContractStorage is the storage usage of a contract and the deposit that is
+// locked for it
+type ContractStorage struct {
+	// Bytes is the size of all keys and values in the contract state
+	Bytes uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Deposit is the amount that is locked in the wasm module account and
+	// refunded to the contract when the state is deleted
+	Deposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"deposit"`
+	// Keys is the number of keys in the contract state
+	Keys uint64 `protobuf:"varint,3,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *ContractStorage) Reset()         { *m = ContractStorage{} }
+func (m *ContractStorage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorage) ProtoMessage()    {}
+func (*ContractStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{5}
+}
+func (m *ContractStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorage.Merge(m, src)
+}
+func (m *ContractStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorage proto.InternalMessageInfo
+
+// StorageQuota limits the state of a contract
+type StorageQuota struct {
+	// MaxBytes is the max size in bytes of all keys and values in the contract
+	// state
+	MaxBytes uint64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// MaxKeys is the max number of keys in the contract state
+	MaxKeys uint64 `protobuf:"varint,2,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	// Unlimited exempts the contract from all limits including the defaults of
+	// the params. MaxBytes and MaxKeys must be 0 then.
+	Unlimited bool `protobuf:"varint,3,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+}
+
+func (m *StorageQuota) Reset()         { *m = StorageQuota{} }
+func (m *StorageQuota) String() string { return proto.CompactTextString(m) }
+func (*StorageQuota) ProtoMessage()    {}
+func (*StorageQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{6}
+}
+func (m *StorageQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageQuota.Merge(m, src)
+}
+func (m *StorageQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageQuota proto.InternalMessageInfo
+
+// FeeAllowance is the amount of tx fees a contract pays for a grantee
+type FeeAllowance struct {
+	// Grantee is the address whose tx fees the contract pays
+	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// SpendLimit is the remaining amount of fees the contract pays for the
+	// grantee
+	SpendLimit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *FeeAllowance) Reset()         { *m = FeeAllowance{} }
+func (m *FeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*FeeAllowance) ProtoMessage()    {}
+func (*FeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{7}
+}
+func (m *FeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAllowance.Merge(m, src)
+}
+func (m *FeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAllowance proto.InternalMessageInfo
+
+// ContractMetadata is the standard ContractInfoExtension with human readable
+// information about a contract, such as for wallets and explorers
+type ContractMetadata struct {
+	// Description is a short human readable description of the contract
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Website is a valid absolute HTTPS URI to the project's website
+	Website string `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	// IconURI is a valid absolute HTTPS or IPFS URI to the contract's icon
+	IconURI string `protobuf:"bytes,3,opt,name=icon_uri,json=iconUri,proto3" json:"icon_uri,omitempty"`
+	// Tags are lowercase keywords to categorize the contract
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *ContractMetadata) Reset()         { *m = ContractMetadata{} }
+func (m *ContractMetadata) String() string { return proto.CompactTextString(m) }
+func (*ContractMetadata) ProtoMessage()    {}
+func (*ContractMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{8}
+}
+func (m *ContractMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMetadata.Merge(m, src)
+}
+func (m *ContractMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMetadata proto.InternalMessageInfo
+
 // ContractInfo stores a WASM contract instance
 type ContractInfo struct {
 	// CodeID is the reference to the stored Wasm code
@@ -273,14 +576,20 @@ type ContractInfo struct {
 	IBCPortID string              `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
-	Extension *types.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	Extension *types1.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	// PendingAdmin is an optional address proposed by the admin that becomes the
+	// new admin once it accepts
+	PendingAdmin string `protobuf:"bytes,8,opt,name=pending_admin,json=pendingAdmin,proto3" json:"pending_admin,omitempty"`
+	// MigrationDelay is the number of blocks a migration by the admin is queued
+	// before it is executed, 0 to migrate immediately
+	MigrationDelay uint64 `protobuf:"varint,9,opt,name=migration_delay,json=migrationDelay,proto3" json:"migration_delay,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{9}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +632,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{10}
 }
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +675,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{11}
 }
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +716,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{12}
 }
 func (m *Model) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,164 +745,617 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
-	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
-	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
-	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
-	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
-	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
-	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
-	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
-	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
-	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+// InactiveContractInfo stores the details of a contract deactivation
+type InactiveContractInfo struct {
+	// Reason is the human readable reason of the deactivation
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// ProposalID is the id of the governance proposal that deactivated the
+	// contract or 0 when unknown
+	ProposalID uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// Deactivated Tx position when the contract was deactivated
+	Deactivated *AbsoluteTxPosition `protobuf:"bytes,3,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
+	// ExpiryHeight is the block height at which the contract is activated again
+	// automatically or 0 for no expiry
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
-
-var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0xce, 0x87, 0x27, 0x6e, 0x71, 0xa7, 0x09, 0xb5, 0x4d, 0xe5, 0x75, 0x97, 0x22,
-	0xd2, 0x36, 0xb5, 0xdb, 0x20, 0x81, 0x14, 0x89, 0x0a, 0xaf, 0xbd, 0x34, 0x5b, 0x11, 0xdb, 0x1a,
-	0xbb, 0xa0, 0x20, 0x55, 0xab, 0xf5, 0xee, 0xc4, 0x19, 0x75, 0xbd, 0x63, 0xed, 0x8c, 0x53, 0xfb,
-	0x1f, 0xa0, 0x48, 0x48, 0xdc, 0xe0, 0x12, 0x09, 0x01, 0x42, 0xfd, 0x01, 0x5c, 0xb9, 0x57, 0x88,
-	0x43, 0x8f, 0x9c, 0x56, 0x90, 0x5e, 0xe0, 0xea, 0x63, 0xb9, 0xa0, 0x9d, 0xb1, 0xb1, 0x69, 0xd3,
-	0x26, 0x5c, 0x56, 0xfb, 0x7e, 0x3c, 0xcf, 0xfb, 0x39, 0xb3, 0x0b, 0x2e, 0x3b, 0x94, 0xf5, 0x1e,
-	0xd9, 0xac, 0x57, 0x16, 0x8f, 0x83, 0xdb, 0x65, 0x3e, 0xea, 0x63, 0x56, 0xea, 0x07, 0x94, 0x53,
-	0x98, 0x99, 0x5a, 0x4b, 0xe2, 0x71, 0x70, 0x3b, 0x9f, 0x8b, 0x34, 0x94, 0x59, 0xc2, 0x5e, 0x96,
-	0x82, 0x74, 0xce, 0xaf, 0x76, 0x69, 0x97, 0x4a, 0x7d, 0xf4, 0x36, 0xd1, 0xe6, 0xba, 0x94, 0x76,
-	0x3d, 0x5c, 0x16, 0x52, 0x67, 0xb0, 0x57, 0xb6, 0xfd, 0x91, 0x34, 0x69, 0x0f, 0xc0, 0x1b, 0x15,
-	0xc7, 0xc1, 0x8c, 0xb5, 0x47, 0x7d, 0xdc, 0xb4, 0x03, 0xbb, 0x07, 0x6b, 0x60, 0xe1, 0xc0, 0xf6,
-	0x06, 0x38, 0xab, 0x14, 0x95, 0xf5, 0xf3, 0x9b, 0x97, 0x4b, 0x2f, 0x26, 0x50, 0x9a, 0x21, 0xf4,
-	0xcc, 0x38, 0x54, 0xd3, 0x23, 0xbb, 0xe7, 0x6d, 0x69, 0x02, 0xa4, 0x21, 0x09, 0xde, 0x4a, 0x7e,
-	0xf3, 0xad, 0xaa, 0x68, 0x5f, 0x2b, 0x20, 0x2d, 0xbd, 0xab, 0xd4, 0xdf, 0x23, 0x5d, 0xd8, 0x02,
-	0xa0, 0x8f, 0x83, 0x1e, 0x61, 0x8c, 0x50, 0xff, 0x4c, 0x11, 0xd6, 0xc6, 0xa1, 0x7a, 0x41, 0x46,
-	0x98, 0x21, 0x35, 0x34, 0x47, 0x03, 0x37, 0xc0, 0x92, 0xed, 0xba, 0x01, 0x66, 0x2c, 0x1b, 0x2f,
-	0x2a, 0xeb, 0x29, 0x1d, 0x8e, 0x43, 0xf5, 0xbc, 0xc4, 0x4c, 0x0c, 0x1a, 0x9a, 0xba, 0x4c, 0x32,
-	0xfb, 0x35, 0x01, 0x16, 0x45, 0xbd, 0x0c, 0x52, 0x00, 0x1d, 0xea, 0x62, 0x6b, 0xd0, 0xf7, 0xa8,
-	0xed, 0x5a, 0xb6, 0x88, 0x2d, 0x72, 0x5b, 0xd9, 0x2c, 0xbc, 0x2a, 0x37, 0x59, 0x8f, 0x7e, 0xe5,
-	0x49, 0xa8, 0xc6, 0xc6, 0xa1, 0x9a, 0x93, 0xd1, 0x5e, 0xe6, 0xd1, 0x50, 0x26, 0x52, 0xde, 0x17,
-	0x3a, 0x09, 0x85, 0x5f, 0x2a, 0xa0, 0x40, 0x7c, 0xc6, 0x6d, 0x9f, 0x13, 0x9b, 0x63, 0xcb, 0xc5,
-	0x7b, 0xf6, 0xc0, 0xe3, 0xd6, 0x5c, 0x67, 0xe2, 0x67, 0xe8, 0xcc, 0xb5, 0x71, 0xa8, 0xbe, 0x23,
-	0xe3, 0xbe, 0x9e, 0x4d, 0x43, 0x97, 0xe7, 0x1c, 0x6a, 0xd2, 0xde, 0x9c, 0xf5, 0xef, 0x23, 0x70,
-	0xbe, 0x6b, 0x33, 0xab, 0x37, 0xf0, 0x38, 0xe9, 0x7b, 0x04, 0x07, 0xd9, 0x44, 0x51, 0x59, 0x4f,
-	0xea, 0xb9, 0x71, 0xa8, 0xae, 0xc9, 0x00, 0xff, 0xb5, 0x6b, 0xe8, 0x5c, 0xd7, 0x66, 0x3b, 0xff,
-	0xca, 0xf0, 0x43, 0x70, 0x4e, 0x46, 0x70, 0xb0, 0xe5, 0x50, 0xc6, 0xb3, 0x49, 0x41, 0x90, 0x1d,
-	0x87, 0xea, 0xea, 0x7c, 0x86, 0x13, 0xb3, 0x86, 0xd2, 0x53, 0xb9, 0x4a, 0x19, 0x87, 0x5b, 0x20,
-	0xed, 0xd0, 0x5e, 0x9f, 0x78, 0x13, 0xf4, 0x82, 0x40, 0x5f, 0x1a, 0x87, 0xea, 0xc5, 0x69, 0x5f,
-	0x67, 0x56, 0x0d, 0xad, 0x4c, 0xc4, 0x08, 0x2b, 0xc6, 0x19, 0xd3, 0xbe, 0x53, 0xc0, 0x72, 0x95,
-	0xba, 0xd8, 0xf4, 0xf7, 0x28, 0x7c, 0x0b, 0xa4, 0xc4, 0x20, 0xf6, 0x6d, 0xb6, 0x2f, 0xe6, 0x98,
-	0x46, 0xcb, 0x91, 0x62, 0xdb, 0x66, 0xfb, 0x30, 0x0b, 0x96, 0x9c, 0x00, 0xdb, 0x9c, 0x06, 0x72,
-	0x59, 0xd0, 0x54, 0x84, 0x2d, 0x00, 0xe7, 0xfb, 0xe8, 0x88, 0x09, 0x8b, 0x5c, 0x4e, 0xdf, 0x83,
-	0x64, 0xb4, 0x07, 0xe8, 0xc2, 0x1c, 0x5e, 0x1a, 0xee, 0x25, 0x97, 0x13, 0x99, 0xe4, 0xbd, 0xe4,
-	0x72, 0x32, 0xb3, 0xa0, 0xfd, 0x1c, 0x07, 0xe9, 0x2a, 0xf5, 0x79, 0x60, 0x3b, 0x5c, 0x24, 0xfa,
-	0x36, 0x58, 0x12, 0x89, 0x12, 0x57, 0xa4, 0x99, 0xd4, 0xc1, 0x71, 0xa8, 0x2e, 0x8a, 0x3a, 0x6a,
-	0x68, 0x31, 0x32, 0x99, 0xee, 0x6b, 0x12, 0x5e, 0x05, 0x0b, 0xb6, 0xdb, 0x23, 0xbe, 0x18, 0x57,
-	0x0a, 0x49, 0x21, 0xd2, 0x7a, 0x76, 0x07, 0x7b, 0x62, 0x06, 0x29, 0x24, 0x05, 0x78, 0x67, 0xc2,
-	0x82, 0xdd, 0x49, 0x45, 0x57, 0x4f, 0xa8, 0xa8, 0xc3, 0xa8, 0x37, 0xe0, 0xb8, 0x3d, 0x6c, 0x52,
-	0x46, 0x38, 0xa1, 0x3e, 0x9a, 0x82, 0xe0, 0x4d, 0xb0, 0x42, 0x3a, 0x8e, 0xd5, 0xa7, 0x01, 0x8f,
-	0xd2, 0x5d, 0x14, 0xe7, 0xec, 0xdc, 0x71, 0xa8, 0xa6, 0x4c, 0xbd, 0xda, 0xa4, 0x01, 0x37, 0x6b,
-	0x28, 0x45, 0x3a, 0x8e, 0x78, 0x75, 0xe1, 0x0e, 0x48, 0xe1, 0x21, 0xc7, 0xbe, 0x58, 0xe6, 0x25,
-	0x11, 0x70, 0xb5, 0x24, 0xaf, 0xa1, 0xd2, 0xf4, 0x1a, 0x2a, 0x55, 0xfc, 0x91, 0x9e, 0xfb, 0xe5,
-	0xa7, 0x9b, 0x6b, 0xf3, 0x4d, 0x31, 0xa6, 0x30, 0x34, 0x63, 0xd8, 0x4a, 0xfe, 0x19, 0x9d, 0xd9,
-	0xbf, 0x15, 0x90, 0x9d, 0xba, 0x46, 0x4d, 0xda, 0x26, 0x8c, 0xd3, 0x60, 0x64, 0xf8, 0x3c, 0x18,
-	0xc1, 0x26, 0x48, 0xd1, 0x3e, 0x0e, 0x6c, 0x3e, 0xbb, 0x58, 0x36, 0x5f, 0x2e, 0xf1, 0x04, 0x78,
-	0x63, 0x8a, 0x8a, 0x0e, 0x15, 0x9a, 0x91, 0xcc, 0x4f, 0x27, 0xfe, 0xca, 0xe9, 0xdc, 0x01, 0x4b,
-	0x83, 0xbe, 0x2b, 0xfa, 0x9a, 0xf8, 0x3f, 0x7d, 0x9d, 0x80, 0xe0, 0x3a, 0x48, 0xf4, 0x58, 0x57,
-	0xcc, 0x2a, 0xad, 0xbf, 0xf9, 0x3c, 0x54, 0x21, 0xb2, 0x1f, 0x4d, 0xb3, 0xdc, 0xc1, 0x8c, 0xd9,
-	0x5d, 0x8c, 0x22, 0x17, 0x0d, 0x01, 0xf8, 0x32, 0x11, 0xbc, 0x02, 0xd2, 0x1d, 0x8f, 0x3a, 0x0f,
-	0xad, 0x7d, 0x4c, 0xba, 0xfb, 0x5c, 0xee, 0x11, 0x5a, 0x11, 0xba, 0x6d, 0xa1, 0x82, 0x39, 0xb0,
-	0xcc, 0x87, 0x16, 0xf1, 0x5d, 0x3c, 0x94, 0x85, 0xa0, 0x25, 0x3e, 0x34, 0x23, 0x51, 0xb3, 0xc1,
-	0xc2, 0x0e, 0x75, 0xb1, 0x07, 0x75, 0x90, 0x78, 0x88, 0x47, 0xf2, 0xb0, 0xe8, 0xb7, 0x9e, 0x87,
-	0xea, 0x46, 0x97, 0xf0, 0xfd, 0x41, 0xa7, 0xe4, 0xd0, 0x5e, 0xd9, 0x23, 0x3e, 0x2e, 0x53, 0x16,
-	0xa5, 0x44, 0xfd, 0xb2, 0x47, 0x3a, 0xac, 0xdc, 0x19, 0x71, 0xcc, 0x4a, 0xdb, 0x78, 0xa8, 0x47,
-	0x2f, 0x28, 0x02, 0x47, 0x8b, 0x27, 0x3f, 0x1c, 0x71, 0x71, 0xe4, 0xa4, 0x70, 0xfd, 0x2f, 0x05,
-	0x80, 0xd9, 0xa5, 0x05, 0xdf, 0x07, 0x97, 0x2a, 0xd5, 0xaa, 0xd1, 0x6a, 0x59, 0xed, 0xdd, 0xa6,
-	0x61, 0xdd, 0xaf, 0xb7, 0x9a, 0x46, 0xd5, 0xfc, 0xd8, 0x34, 0x6a, 0x99, 0x58, 0x3e, 0x77, 0x78,
-	0x54, 0x5c, 0x9b, 0x39, 0xdf, 0xf7, 0x59, 0x1f, 0x3b, 0x64, 0x8f, 0x60, 0x17, 0x6e, 0x00, 0x38,
-	0x8f, 0xab, 0x37, 0xf4, 0x46, 0x6d, 0x37, 0xa3, 0xe4, 0x57, 0x0f, 0x8f, 0x8a, 0x99, 0x19, 0xa4,
-	0x4e, 0x3b, 0xd4, 0x1d, 0xc1, 0x0f, 0x40, 0x76, 0xde, 0xbb, 0x51, 0xff, 0x64, 0xd7, 0xaa, 0xd4,
-	0x6a, 0xc8, 0x68, 0xb5, 0x32, 0xf1, 0x17, 0xc3, 0x34, 0x7c, 0x6f, 0x54, 0x91, 0x1f, 0x07, 0xb8,
-	0x09, 0xd6, 0xe6, 0x81, 0xc6, 0xa7, 0x06, 0xda, 0x15, 0x91, 0x12, 0xf9, 0x4b, 0x87, 0x47, 0xc5,
-	0x8b, 0x33, 0x94, 0x71, 0x80, 0x83, 0x51, 0x14, 0x2c, 0xbf, 0xfc, 0xc5, 0xf7, 0x85, 0xd8, 0xe3,
-	0x1f, 0x0a, 0xb1, 0xeb, 0x3f, 0x26, 0x40, 0xf1, 0xb4, 0x0d, 0x83, 0x18, 0xdc, 0xaa, 0x36, 0xea,
-	0x6d, 0x54, 0xa9, 0xb6, 0xad, 0x6a, 0xa3, 0x66, 0x58, 0xdb, 0x66, 0xab, 0xdd, 0x40, 0xbb, 0x56,
-	0xa3, 0x69, 0xa0, 0x4a, 0xdb, 0x6c, 0xd4, 0x4f, 0x6a, 0x4d, 0xf9, 0xf0, 0xa8, 0x78, 0xe3, 0x34,
-	0xee, 0xf9, 0x86, 0x7d, 0x06, 0xae, 0x9d, 0x29, 0x8c, 0x59, 0x37, 0xdb, 0x19, 0x25, 0xbf, 0x7e,
-	0x78, 0x54, 0xbc, 0x7a, 0x1a, 0xbf, 0xe9, 0x13, 0x0e, 0x1f, 0x80, 0x8d, 0x33, 0x11, 0xef, 0x98,
-	0x77, 0x51, 0xa5, 0x6d, 0x64, 0xe2, 0xf9, 0x1b, 0x87, 0x47, 0xc5, 0x77, 0x4f, 0xe3, 0xde, 0x21,
-	0xdd, 0xc0, 0xe6, 0xf8, 0xcc, 0xf4, 0x77, 0x8d, 0xba, 0xd1, 0x32, 0x5b, 0x99, 0xc4, 0xd9, 0xe8,
-	0xef, 0x62, 0x1f, 0x33, 0xc2, 0xf2, 0xc9, 0x68, 0x58, 0x7a, 0xed, 0xc9, 0x1f, 0x85, 0xd8, 0xe3,
-	0xe3, 0x82, 0xf2, 0xe4, 0xb8, 0xa0, 0x3c, 0x3d, 0x2e, 0x28, 0xbf, 0x1f, 0x17, 0x94, 0xaf, 0x9e,
-	0x15, 0x62, 0x4f, 0x9f, 0x15, 0x62, 0xbf, 0x3d, 0x2b, 0xc4, 0x3e, 0xd7, 0x5e, 0xdc, 0xff, 0xe8,
-	0x30, 0xbb, 0xe5, 0xa1, 0xfc, 0x47, 0x13, 0x3f, 0x68, 0x9d, 0x45, 0x71, 0x93, 0xbd, 0xf7, 0x4f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xff, 0xd0, 0xc1, 0xc1, 0x09, 0x00, 0x00,
+func (m *InactiveContractInfo) Reset()         { *m = InactiveContractInfo{} }
+func (m *InactiveContractInfo) String() string { return proto.CompactTextString(m) }
+func (*InactiveContractInfo) ProtoMessage()    {}
+func (*InactiveContractInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
 }
-
-func (this *AccessTypeParam) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *InactiveContractInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InactiveContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InactiveContractInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *InactiveContractInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InactiveContractInfo.Merge(m, src)
+}
+func (m *InactiveContractInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *InactiveContractInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_InactiveContractInfo.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AccessTypeParam)
-	if !ok {
-		that2, ok := that.(AccessTypeParam)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_InactiveContractInfo proto.InternalMessageInfo
+
+// UploadSession stores the state of a chunked code upload
+type UploadSession struct {
+	// Checksum is the declared sha256 hash of the complete code bytes as
+	// uploaded, raw or gzip compressed
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// TotalSize is the declared size in bytes of the complete code
+	TotalSize uint64 `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// ReceivedSize is the size in bytes of all chunks received so far
+	ReceivedSize uint64 `protobuf:"varint,3,opt,name=received_size,json=receivedSize,proto3" json:"received_size,omitempty"`
+	// ChunkCount is the number of chunks received so far
+	ChunkCount uint32 `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	// InstantiatePermission access control to apply on contract creation,
+	// optional
+	InstantiatePermission *AccessConfig `protobuf:"bytes,5,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// Deposit is the amount that is held in escrow until the session is
+	// committed
+	Deposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"deposit"`
+	// ExpiryHeight is the block height at which an uncommitted session is
+	// deleted and the deposit is burned
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *UploadSession) Reset()         { *m = UploadSession{} }
+func (m *UploadSession) String() string { return proto.CompactTextString(m) }
+func (*UploadSession) ProtoMessage()    {}
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{14}
+}
+func (m *UploadSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
-func (this *AccessConfig) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UploadSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadSession.Merge(m, src)
+}
+func (m *UploadSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadSession.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AccessConfig)
-	if !ok {
-		that2, ok := that.(AccessConfig)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_UploadSession proto.InternalMessageInfo
+
+// PendingMigration stores a migration that is queued until the migration delay
+// of the contract has passed
+type PendingMigration struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Sender is the address that requested the migration
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// CodeID references the new WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg json encoded message to be passed to the contract on migration
+	Msg RawContractMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// ExecuteHeight is the block height at which the migration is executed
+	ExecuteHeight int64 `protobuf:"varint,5,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+}
+
+func (m *PendingMigration) Reset()         { *m = PendingMigration{} }
+func (m *PendingMigration) String() string { return proto.CompactTextString(m) }
+func (*PendingMigration) ProtoMessage()    {}
+func (*PendingMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{15}
+}
+func (m *PendingMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Permission != that1.Permission {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	return true
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PendingMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMigration.Merge(m, src)
+}
+func (m *PendingMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMigration.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_PendingMigration proto.InternalMessageInfo
+
+// MigrationAllowlist restricts the codes a contract can be migrated to. A
+// migration is allowed when the target code ID or its checksum is listed.
+type MigrationAllowlist struct {
+	// CodeIDs are the allowed target code IDs
+	CodeIDs []uint64 `protobuf:"varint,1,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// Checksums are the allowed target code checksums
+	Checksums [][]byte `protobuf:"bytes,2,rep,name=checksums,proto3" json:"checksums,omitempty"`
+	// Governance is set when the allowlist was set by governance. Such an
+	// allowlist can not be changed by the admin.
+	Governance bool `protobuf:"varint,3,opt,name=governance,proto3" json:"governance,omitempty"`
+}
+
+func (m *MigrationAllowlist) Reset()         { *m = MigrationAllowlist{} }
+func (m *MigrationAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MigrationAllowlist) ProtoMessage()    {}
+func (*MigrationAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{16}
+}
+func (m *MigrationAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationAllowlist.Merge(m, src)
+}
+func (m *MigrationAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationAllowlist proto.InternalMessageInfo
+
+// Schedule is a callback that a contract registered to be called by the end
+// blocker at a future height, optionally repeated in an interval
+type Schedule struct {
+	// ID is the unique identifier of the schedule
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the smart contract that is called
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// NextHeight is the block height at which the callback is executed next
+	NextHeight int64 `protobuf:"varint,3,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// Interval is the number of blocks between the executions, 0 to execute
+	// the callback once
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Msg json encoded message that is passed to the contract in the
+	// scheduled_callback sudo msg
+	Msg RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// GasLimit is the gas limit of each execution
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// GasDeposit is the remaining prepaid deposit that pays for the gas of the
+	// executions
+	GasDeposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,7,rep,name=gas_deposit,json=gasDeposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"gas_deposit"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{17}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+// PrivilegedContract is a contract that governance registered to receive a
+// sudo call on every begin and/or end block
+type PrivilegedContract struct {
+	// Contract is the address of the smart contract that is called
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// BeginBlock is true when the contract is called on begin block
+	BeginBlock bool `protobuf:"varint,2,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// EndBlock is true when the contract is called on end block
+	EndBlock bool `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// GasLimit is the gas limit of each call
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *PrivilegedContract) Reset()         { *m = PrivilegedContract{} }
+func (m *PrivilegedContract) String() string { return proto.CompactTextString(m) }
+func (*PrivilegedContract) ProtoMessage()    {}
+func (*PrivilegedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{18}
+}
+func (m *PrivilegedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivilegedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivilegedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivilegedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivilegedContract.Merge(m, src)
+}
+func (m *PrivilegedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivilegedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivilegedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivilegedContract proto.InternalMessageInfo
+
+// AcceptedStargateQuery is a gRPC query that contracts are allowed to call
+// with a stargate query
+type AcceptedStargateQuery struct {
+	// Path is the full gRPC method path, such as
+	// "/cosmos.bank.v1beta1.Query/Balance"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// ResponseType is the full proto name of the response message, such as
+	// "cosmos.bank.v1beta1.QueryBalanceResponse". The response is decoded into
+	// this type and encoded again so that the result is deterministic.
+	ResponseType string `protobuf:"bytes,2,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty"`
+	// Encoding is the encoding of the response that is returned to the contract
+	Encoding StargateQueryEncoding `protobuf:"varint,3,opt,name=encoding,proto3,enum=cosmwasm.wasm.v1.StargateQueryEncoding" json:"encoding,omitempty"`
+}
+
+func (m *AcceptedStargateQuery) Reset()         { *m = AcceptedStargateQuery{} }
+func (m *AcceptedStargateQuery) String() string { return proto.CompactTextString(m) }
+func (*AcceptedStargateQuery) ProtoMessage()    {}
+func (*AcceptedStargateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{19}
+}
+func (m *AcceptedStargateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedStargateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedStargateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptedStargateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedStargateQuery.Merge(m, src)
+}
+func (m *AcceptedStargateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedStargateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedStargateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedStargateQuery proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.StargateQueryEncoding", StargateQueryEncoding_name, StargateQueryEncoding_value)
+	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
+	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
+	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
+	proto.RegisterType((*CodeMetadata)(nil), "cosmwasm.wasm.v1.CodeMetadata")
+	proto.RegisterType((*ContractStorage)(nil), "cosmwasm.wasm.v1.ContractStorage")
+	proto.RegisterType((*StorageQuota)(nil), "cosmwasm.wasm.v1.StorageQuota")
+	proto.RegisterType((*FeeAllowance)(nil), "cosmwasm.wasm.v1.FeeAllowance")
+	proto.RegisterType((*ContractMetadata)(nil), "cosmwasm.wasm.v1.ContractMetadata")
+	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
+	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
+	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
+	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*InactiveContractInfo)(nil), "cosmwasm.wasm.v1.InactiveContractInfo")
+	proto.RegisterType((*UploadSession)(nil), "cosmwasm.wasm.v1.UploadSession")
+	proto.RegisterType((*PendingMigration)(nil), "cosmwasm.wasm.v1.PendingMigration")
+	proto.RegisterType((*MigrationAllowlist)(nil), "cosmwasm.wasm.v1.MigrationAllowlist")
+	proto.RegisterType((*Schedule)(nil), "cosmwasm.wasm.v1.Schedule")
+	proto.RegisterType((*PrivilegedContract)(nil), "cosmwasm.wasm.v1.PrivilegedContract")
+	proto.RegisterType((*AcceptedStargateQuery)(nil), "cosmwasm.wasm.v1.AcceptedStargateQuery")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
+
+var fileDescriptor_e6155d98fa173e02 = []byte{
+	// 2625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x38, 0xcb, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x5a, 0x92, 0xa2, 0xc8, 0x21, 0xa5, 0x30, 0x13, 0x49, 0xa6, 0x18, 0x99, 0x4b, 0xaf, 0xf3,
+	0x50, 0x12, 0x5b, 0x8c, 0x15, 0xfc, 0x7e, 0x45, 0x8d, 0x34, 0x29, 0x5f, 0x96, 0x99, 0x5a, 0x24,
+	0x3d, 0xa4, 0x1a, 0xa8, 0x68, 0xb0, 0x1d, 0xee, 0x8e, 0xa9, 0xad, 0x97, 0xbb, 0xcc, 0xce, 0x52,
+	0x26, 0xd3, 0x63, 0x2f, 0x85, 0x82, 0x02, 0xed, 0xa1, 0x40, 0x2f, 0x04, 0x02, 0xb4, 0x40, 0x93,
+	0xf6, 0xda, 0x63, 0xff, 0x80, 0xa0, 0xbd, 0x04, 0x39, 0xf5, 0xc4, 0xb6, 0xca, 0xa5, 0xa7, 0x1e,
+	0x78, 0x4c, 0x2e, 0xc5, 0xcc, 0xec, 0x92, 0xab, 0x97, 0x45, 0x03, 0xe9, 0xc5, 0xde, 0xf9, 0xde,
+	0xef, 0xef, 0xa3, 0xc0, 0xa6, 0x66, 0xd3, 0xee, 0x13, 0x4c, 0xbb, 0x79, 0xfe, 0xcf, 0xd1, 0x9d,
+	0xbc, 0x3b, 0xec, 0x11, 0xba, 0xdd, 0x73, 0x6c, 0xd7, 0x86, 0x29, 0x1f, 0xbb, 0xcd, 0xff, 0x39,
+	0xba, 0x93, 0xd9, 0x60, 0x10, 0x9b, 0xaa, 0x1c, 0x9f, 0x17, 0x0f, 0x41, 0x9c, 0xc9, 0x8a, 0x57,
+	0xbe, 0x8d, 0x29, 0xc9, 0x1f, 0xdd, 0x69, 0x13, 0x17, 0xdf, 0xc9, 0x6b, 0xb6, 0x61, 0x79, 0xf8,
+	0xd5, 0x8e, 0xdd, 0xb1, 0x05, 0x1f, 0xfb, 0xf2, 0xa0, 0x1b, 0x1d, 0xdb, 0xee, 0x98, 0x24, 0xcf,
+	0x5f, 0xed, 0xfe, 0xa3, 0x3c, 0xb6, 0x86, 0x02, 0xa5, 0x7c, 0x00, 0x9e, 0x2b, 0x68, 0x1a, 0xa1,
+	0xb4, 0x35, 0xec, 0x91, 0x06, 0x76, 0x70, 0x17, 0x96, 0xc1, 0xe2, 0x11, 0x36, 0xfb, 0x24, 0x2d,
+	0xe5, 0xa4, 0xad, 0x95, 0x9d, 0xcd, 0xed, 0xb3, 0x06, 0x6e, 0xcf, 0x38, 0x8a, 0xa9, 0xc9, 0x58,
+	0x4e, 0x0e, 0x71, 0xd7, 0xbc, 0xab, 0x70, 0x26, 0x05, 0x09, 0xe6, 0xbb, 0x91, 0xdf, 0x7e, 0x22,
+	0x4b, 0xca, 0xdf, 0x24, 0x90, 0x14, 0xd4, 0x25, 0xdb, 0x7a, 0x64, 0x74, 0x60, 0x13, 0x80, 0x1e,
+	0x71, 0xba, 0x06, 0xa5, 0x86, 0x6d, 0xcd, 0xa5, 0x61, 0x6d, 0x32, 0x96, 0x9f, 0x17, 0x1a, 0x66,
+	0x9c, 0x0a, 0x0a, 0x88, 0x81, 0xb7, 0xc0, 0x12, 0xd6, 0x75, 0x87, 0x50, 0x9a, 0x0e, 0xe5, 0xa4,
+	0xad, 0x78, 0x11, 0x4e, 0xc6, 0xf2, 0x8a, 0xe0, 0xf1, 0x10, 0x0a, 0xf2, 0x49, 0xe0, 0x0e, 0x88,
+	0x7b, 0x9f, 0x84, 0xa6, 0xc3, 0xb9, 0xf0, 0x56, 0xbc, 0xb8, 0x3a, 0x19, 0xcb, 0xa9, 0x53, 0xf4,
+	0x84, 0x2a, 0x68, 0x46, 0xe6, 0x79, 0xf3, 0xf3, 0x04, 0x88, 0xf2, 0x18, 0x51, 0x68, 0x03, 0xa8,
+	0xd9, 0x3a, 0x51, 0xfb, 0x3d, 0xd3, 0xc6, 0xba, 0x8a, 0xb9, 0xbd, 0xdc, 0x9f, 0xc4, 0x4e, 0xf6,
+	0x32, 0x7f, 0x44, 0x0c, 0x8a, 0x37, 0x3e, 0x1f, 0xcb, 0x0b, 0x93, 0xb1, 0xbc, 0x21, 0x34, 0x9e,
+	0x97, 0xa3, 0xa0, 0x14, 0x03, 0xee, 0x73, 0x98, 0x60, 0x85, 0xbf, 0x94, 0x40, 0xd6, 0xb0, 0xa8,
+	0x8b, 0x2d, 0xd7, 0xc0, 0x2e, 0x51, 0x75, 0xf2, 0x08, 0xf7, 0x4d, 0x57, 0x0d, 0x44, 0x33, 0x34,
+	0x47, 0x34, 0x5f, 0x9b, 0x8c, 0xe5, 0x97, 0x85, 0xde, 0xa7, 0x4b, 0x53, 0xd0, 0x66, 0x80, 0xa0,
+	0x2c, 0xf0, 0x8d, 0x59, 0xcc, 0xbf, 0x0f, 0x56, 0x3a, 0x98, 0xaa, 0xdd, 0xbe, 0xe9, 0x1a, 0x3d,
+	0xd3, 0x20, 0x4e, 0x3a, 0x9c, 0x93, 0xb6, 0x22, 0xc5, 0x8d, 0xc9, 0x58, 0x5e, 0x13, 0x0a, 0x4e,
+	0xe3, 0x15, 0xb4, 0xdc, 0xc1, 0x74, 0x6f, 0xfa, 0x86, 0xdf, 0x03, 0xcb, 0x42, 0x83, 0x46, 0x54,
+	0xcd, 0xa6, 0x6e, 0x3a, 0xc2, 0x05, 0xa4, 0x27, 0x63, 0x79, 0x35, 0x68, 0xa1, 0x87, 0x56, 0x50,
+	0xd2, 0x7f, 0x97, 0x6c, 0xea, 0xc2, 0xbb, 0x20, 0xa9, 0xd9, 0xdd, 0x9e, 0x61, 0x7a, 0xdc, 0x8b,
+	0x9c, 0xfb, 0xda, 0x64, 0x2c, 0xbf, 0xe0, 0xc7, 0x75, 0x86, 0x55, 0x50, 0xc2, 0x7b, 0x72, 0xde,
+	0x1f, 0x83, 0x74, 0xdf, 0x32, 0x3e, 0xec, 0x13, 0xd5, 0xc4, 0x6d, 0x62, 0x32, 0xb7, 0x55, 0xcd,
+	0x21, 0xd8, 0xb5, 0x9d, 0x74, 0x34, 0x27, 0x6d, 0xc5, 0x8a, 0x37, 0x27, 0x63, 0x59, 0x16, 0x72,
+	0x2e, 0xa3, 0x54, 0xd0, 0x9a, 0x40, 0x3d, 0x60, 0x98, 0x06, 0x71, 0x4a, 0x02, 0x0e, 0xdf, 0x06,
+	0xcb, 0x5d, 0x3c, 0x50, 0x59, 0xf4, 0x55, 0x6a, 0x7c, 0x44, 0xd2, 0x4b, 0x67, 0x1d, 0x3b, 0x85,
+	0x56, 0x50, 0xa2, 0x8b, 0x07, 0xef, 0x63, 0xda, 0x6d, 0x1a, 0x1f, 0x11, 0xf8, 0x2e, 0x58, 0x61,
+	0x68, 0xa1, 0x8e, 0xb3, 0xc7, 0xce, 0x06, 0xf6, 0x34, 0x5e, 0x41, 0xc9, 0x2e, 0x1e, 0x70, 0x23,
+	0xb8, 0x80, 0x36, 0xc8, 0x30, 0x02, 0x9d, 0x30, 0x8f, 0x79, 0xfd, 0xea, 0x01, 0x5b, 0xe2, 0x5c,
+	0xd8, 0xcb, 0x93, 0xb1, 0x7c, 0x63, 0x26, 0xec, 0x62, 0x5a, 0x05, 0x5d, 0xeb, 0xe2, 0x41, 0x39,
+	0x80, 0x9b, 0x1a, 0xf9, 0xa9, 0x04, 0xd2, 0xd4, 0xb5, 0x1d, 0xdc, 0x61, 0xb5, 0xd3, 0xb3, 0xa9,
+	0xc1, 0x6b, 0x47, 0x6d, 0x0f, 0x5d, 0x92, 0x06, 0xb9, 0xf0, 0x56, 0xc2, 0xab, 0x43, 0x9b, 0x6e,
+	0xb3, 0x59, 0xb5, 0xed, 0xcd, 0xaa, 0xed, 0x32, 0xd1, 0x4a, 0xb6, 0x61, 0x15, 0x1f, 0x7a, 0x3d,
+	0xe0, 0xc5, 0xf8, 0x32, 0x59, 0xca, 0x1f, 0xff, 0x21, 0xbf, 0xd2, 0x31, 0xdc, 0xc3, 0x7e, 0x7b,
+	0x5b, 0xb3, 0xbb, 0x79, 0xd3, 0xb0, 0x48, 0xde, 0x6c, 0x77, 0x6f, 0x53, 0xfd, 0xb1, 0x37, 0x45,
+	0x3d, 0x89, 0x14, 0xad, 0x79, 0x42, 0xca, 0x42, 0x46, 0x83, 0x38, 0xc5, 0xa1, 0x3b, 0x0d, 0x87,
+	0x66, 0x5b, 0xae, 0x83, 0x35, 0x57, 0xf5, 0x55, 0x31, 0xf1, 0x34, 0x9d, 0xb8, 0x28, 0x1c, 0x17,
+	0xd3, 0x8a, 0x70, 0x94, 0x3c, 0x5c, 0x53, 0xa0, 0x98, 0x0a, 0x0a, 0x55, 0xb0, 0x71, 0x21, 0xdf,
+	0x63, 0x32, 0xa4, 0xe9, 0x24, 0x57, 0xf1, 0xd2, 0x64, 0x2c, 0xe7, 0x9e, 0xa2, 0x82, 0x91, 0x2a,
+	0x68, 0xfd, 0xbc, 0x86, 0x1f, 0x90, 0x21, 0x85, 0x3a, 0x78, 0x91, 0xba, 0xd8, 0xe9, 0xb0, 0x5e,
+	0xfd, 0xb0, 0x4f, 0x9c, 0xa1, 0xca, 0x9a, 0x6b, 0x1a, 0xf1, 0x65, 0xae, 0xe2, 0x95, 0xc9, 0x58,
+	0x56, 0xfc, 0x78, 0x5e, 0x4a, 0xac, 0xa0, 0x6b, 0x3e, 0xf6, 0x21, 0x43, 0xee, 0x62, 0xea, 0x87,
+	0x6a, 0x24, 0x81, 0x75, 0x6f, 0x10, 0x51, 0xc2, 0xdb, 0xdc, 0x4f, 0x48, 0x7a, 0x85, 0xe7, 0x74,
+	0xe3, 0xc2, 0x9c, 0xf2, 0x84, 0x3e, 0xf0, 0x12, 0x7a, 0xdd, 0x6b, 0x9a, 0x0b, 0xc5, 0xb0, 0x74,
+	0xde, 0x7c, 0x7a, 0x3a, 0x45, 0x2e, 0x57, 0x05, 0x7f, 0x53, 0xb0, 0x7b, 0x19, 0xe5, 0x53, 0x78,
+	0x41, 0x19, 0x4b, 0x20, 0x56, 0xb2, 0x75, 0x52, 0xb5, 0x1e, 0xd9, 0xf0, 0x45, 0x10, 0xe7, 0xf3,
+	0xf3, 0x10, 0xd3, 0x43, 0x3e, 0x7e, 0x93, 0x28, 0xc6, 0x00, 0xf7, 0x31, 0x3d, 0x84, 0x69, 0xb0,
+	0xe4, 0x77, 0x35, 0xdf, 0x0b, 0xc8, 0x7f, 0xc2, 0x26, 0x80, 0xc1, 0xf1, 0xa7, 0xf1, 0xc1, 0x9c,
+	0x5e, 0x9c, 0x6b, 0x7c, 0x47, 0x98, 0xa7, 0xe8, 0xf9, 0x00, 0xbf, 0x40, 0xc0, 0xbb, 0x20, 0xd6,
+	0x25, 0x2e, 0xd6, 0xb1, 0x8b, 0xd3, 0xd1, 0xcb, 0x44, 0x31, 0xcb, 0xf7, 0x3c, 0x2a, 0x34, 0xa5,
+	0x7f, 0x2f, 0x12, 0x0b, 0xa7, 0x22, 0xef, 0x45, 0x62, 0x91, 0xd4, 0xa2, 0xe2, 0x82, 0x64, 0x90,
+	0x0a, 0xae, 0x83, 0x28, 0xb5, 0xfb, 0x8e, 0x26, 0x36, 0x72, 0x1c, 0x79, 0x2f, 0xe6, 0x5e, 0xbb,
+	0x6f, 0x98, 0x3a, 0x99, 0xba, 0xe7, 0x3d, 0xe1, 0x0e, 0x58, 0x9b, 0x46, 0x45, 0xc5, 0xae, 0x4b,
+	0xa8, 0x8b, 0x5d, 0xb6, 0x22, 0xc2, 0x3c, 0x42, 0x2f, 0xf8, 0x11, 0x2a, 0xcc, 0x50, 0xca, 0x48,
+	0x02, 0xcf, 0x9d, 0x29, 0x3d, 0xb8, 0x0a, 0x16, 0x45, 0x9b, 0x30, 0xc5, 0x11, 0x24, 0x1e, 0xf0,
+	0x27, 0x60, 0xc9, 0x2f, 0x8b, 0xd0, 0x55, 0x65, 0xf1, 0x06, 0x0b, 0xd6, 0xbc, 0x59, 0xf7, 0xc5,
+	0x42, 0x08, 0x22, 0xbc, 0x75, 0xf8, 0x4a, 0x41, 0xfc, 0x5b, 0xd1, 0x41, 0xd2, 0x33, 0xeb, 0x61,
+	0xdf, 0x76, 0x31, 0xcb, 0x3c, 0x6b, 0xa4, 0xa0, 0x7d, 0xb1, 0x2e, 0x1e, 0x88, 0x86, 0xdc, 0x00,
+	0xec, 0x5b, 0xf4, 0x5f, 0x88, 0xe3, 0x96, 0xba, 0x78, 0xc0, 0x5b, 0x69, 0x13, 0xc4, 0xfb, 0x96,
+	0x69, 0x74, 0x0d, 0x97, 0xe8, 0x5c, 0x41, 0x0c, 0xcd, 0x00, 0xca, 0xaf, 0x25, 0x90, 0xbc, 0x47,
+	0x48, 0xc1, 0x34, 0xed, 0x27, 0xd8, 0x12, 0x41, 0xee, 0x38, 0xd8, 0x72, 0x89, 0x1f, 0x7d, 0xff,
+	0x09, 0x3b, 0x20, 0x41, 0x7b, 0xc4, 0xd2, 0x55, 0xce, 0xfb, 0x2d, 0x87, 0x02, 0x70, 0xd1, 0x0f,
+	0x98, 0x64, 0xe5, 0x33, 0x09, 0xa4, 0xfc, 0xcc, 0x4c, 0x8b, 0x22, 0x07, 0x12, 0x3a, 0xa1, 0x9a,
+	0x63, 0xf4, 0x5c, 0xff, 0x92, 0x8a, 0xa3, 0x20, 0x88, 0x59, 0xfe, 0x84, 0xb4, 0xa9, 0xe1, 0x12,
+	0xbf, 0x3c, 0xbc, 0x27, 0x7c, 0x05, 0xc4, 0x0c, 0xcd, 0xb6, 0xd4, 0xbe, 0x63, 0xf0, 0x08, 0xc4,
+	0x8b, 0x89, 0x93, 0xb1, 0xbc, 0x54, 0xd5, 0x6c, 0x6b, 0x1f, 0x55, 0xd1, 0x12, 0x43, 0xee, 0x3b,
+	0x06, 0x4b, 0x83, 0x8b, 0x3b, 0x34, 0x1d, 0x61, 0x47, 0x12, 0xe2, 0xdf, 0x77, 0xaf, 0xff, 0xfb,
+	0x13, 0x59, 0xfa, 0xf2, 0xcf, 0xb7, 0xd7, 0x7c, 0x8b, 0x58, 0x1b, 0x56, 0x06, 0x2e, 0xb1, 0xf8,
+	0xd5, 0xf0, 0x71, 0x18, 0x24, 0x83, 0x18, 0x78, 0x13, 0x2c, 0xf1, 0x52, 0x34, 0x74, 0x91, 0xa4,
+	0x22, 0x38, 0x19, 0xcb, 0x51, 0xde, 0xbf, 0x65, 0x14, 0x65, 0xa8, 0xaa, 0xfe, 0x94, 0x46, 0x5d,
+	0x05, 0x8b, 0x58, 0xef, 0x1a, 0xa2, 0x72, 0xe3, 0x48, 0x3c, 0x18, 0x94, 0xef, 0x3f, 0x7e, 0x32,
+	0xc4, 0x91, 0x78, 0xc0, 0x77, 0x3c, 0x29, 0x44, 0xf7, 0x3a, 0xf9, 0xa5, 0x0b, 0x3a, 0xb9, 0x4d,
+	0x6d, 0xb3, 0xef, 0x92, 0xd6, 0xa0, 0xc1, 0x2a, 0xcd, 0xb0, 0x2d, 0xe4, 0x33, 0xc1, 0xdb, 0x20,
+	0x61, 0xb4, 0x35, 0xb5, 0x67, 0x3b, 0x2e, 0x33, 0x37, 0xca, 0x23, 0xb3, 0x7c, 0x32, 0x96, 0xe3,
+	0xd5, 0x62, 0xa9, 0x61, 0x3b, 0x6e, 0xb5, 0x8c, 0xe2, 0x46, 0x5b, 0xe3, 0x9f, 0x3a, 0xdc, 0x03,
+	0x71, 0xe2, 0xfb, 0xcd, 0x57, 0x7c, 0x62, 0x67, 0x75, 0x5b, 0x5c, 0xda, 0xdb, 0xfe, 0xa5, 0xbd,
+	0x5d, 0xb0, 0x86, 0xc5, 0x8d, 0xbf, 0x5e, 0x16, 0x2e, 0x34, 0x93, 0x00, 0x6f, 0x82, 0x65, 0x96,
+	0x72, 0xc3, 0xea, 0xa8, 0xc2, 0xe3, 0x18, 0xf7, 0x2d, 0xe9, 0x01, 0x0b, 0xdc, 0xf1, 0x57, 0xc1,
+	0x73, 0x5d, 0xa3, 0xe3, 0xf0, 0x8e, 0x55, 0x75, 0x62, 0xe2, 0xa1, 0x58, 0xe8, 0x68, 0x65, 0x0a,
+	0x2e, 0x33, 0xe8, 0xdd, 0x08, 0x4b, 0x93, 0xf2, 0x8d, 0x04, 0xd2, 0xbe, 0x62, 0x16, 0xf2, 0xfb,
+	0x06, 0x5b, 0x37, 0xc3, 0x8a, 0xe5, 0x3a, 0x43, 0xd8, 0x00, 0x71, 0xbb, 0x47, 0x04, 0x93, 0x77,
+	0x89, 0xef, 0x5c, 0x34, 0xaf, 0xce, 0xb1, 0xd7, 0x7d, 0x2e, 0x76, 0x51, 0xa2, 0x99, 0x90, 0x60,
+	0xae, 0x43, 0x97, 0xe6, 0xfa, 0x1d, 0xb0, 0xd4, 0xef, 0xe9, 0xd8, 0xef, 0xbe, 0xb9, 0xb3, 0xe4,
+	0x31, 0xc1, 0x2d, 0x10, 0xee, 0xd2, 0x0e, 0xcf, 0x7c, 0xb2, 0xb8, 0xfe, 0xf5, 0x58, 0x86, 0x08,
+	0x3f, 0x99, 0xb5, 0x07, 0xa5, 0xb8, 0x43, 0x10, 0x23, 0x51, 0x10, 0x80, 0xe7, 0x05, 0xc1, 0x1b,
+	0x20, 0xd9, 0x36, 0x6d, 0xed, 0xb1, 0x7a, 0x48, 0x8c, 0xce, 0xa1, 0xeb, 0x8d, 0x8e, 0x04, 0x87,
+	0xdd, 0xe7, 0x20, 0x36, 0x3d, 0xdc, 0x81, 0x6a, 0x58, 0x3a, 0x19, 0xf8, 0xd3, 0xc3, 0x1d, 0x54,
+	0xd9, 0x53, 0xc1, 0x60, 0x71, 0xcf, 0xd6, 0x89, 0x09, 0x8b, 0x20, 0xfc, 0x98, 0x0c, 0xc5, 0xca,
+	0x29, 0xbe, 0xf9, 0xf5, 0x58, 0xbe, 0x75, 0xb6, 0xad, 0x6d, 0xca, 0x4c, 0xb2, 0xad, 0xbc, 0x69,
+	0xb4, 0x69, 0x9e, 0xcf, 0xa9, 0xed, 0xfb, 0x44, 0x0c, 0x28, 0xc4, 0x98, 0x59, 0x19, 0x8b, 0x5f,
+	0x5a, 0x21, 0x3e, 0x96, 0xc5, 0x43, 0xf9, 0x52, 0x02, 0xab, 0x55, 0x0b, 0x6b, 0xae, 0x71, 0x44,
+	0x4e, 0xb5, 0xd2, 0x3a, 0x88, 0x3a, 0x04, 0xd3, 0x69, 0xb7, 0x7b, 0x2f, 0x98, 0x07, 0x89, 0x9e,
+	0x63, 0xf7, 0x6c, 0x8a, 0xcd, 0x59, 0xe8, 0x57, 0x4e, 0xc6, 0x32, 0x68, 0x78, 0xe0, 0x6a, 0x19,
+	0x01, 0x9f, 0xa4, 0xaa, 0xc3, 0x7b, 0x6c, 0x76, 0x70, 0x05, 0xcf, 0x9c, 0x86, 0x20, 0x23, 0x2b,
+	0x59, 0x32, 0xe8, 0x19, 0xce, 0xd0, 0x8f, 0x25, 0x4b, 0x4a, 0x18, 0x25, 0x05, 0x50, 0x04, 0xd3,
+	0xab, 0xc4, 0xff, 0x84, 0xc0, 0xf2, 0x7e, 0x70, 0xa7, 0xc3, 0x0c, 0x88, 0x69, 0x87, 0x44, 0x7b,
+	0x4c, 0xfb, 0xdd, 0xe9, 0xe2, 0xf6, 0xde, 0xf0, 0x3a, 0x00, 0xae, 0xed, 0x62, 0xef, 0xfe, 0x15,
+	0x29, 0x88, 0x73, 0x08, 0xbf, 0x3e, 0x6f, 0x82, 0x65, 0x87, 0x68, 0xc4, 0x38, 0x22, 0xba, 0xa0,
+	0x10, 0x7b, 0x22, 0xe9, 0x03, 0x39, 0x91, 0x0c, 0x12, 0xda, 0x61, 0xdf, 0x7a, 0xac, 0x6a, 0x76,
+	0xdf, 0x12, 0xa6, 0x2d, 0x23, 0xc0, 0x41, 0x25, 0x06, 0x81, 0xfb, 0x60, 0x3d, 0x78, 0x03, 0x04,
+	0x7e, 0x48, 0xcd, 0x75, 0x07, 0xa0, 0xb5, 0x00, 0x77, 0xe0, 0x87, 0x51, 0x60, 0x3b, 0x46, 0xff,
+	0x37, 0xdb, 0xf1, 0x5c, 0xd8, 0x97, 0xce, 0x87, 0x5d, 0xf9, 0x8b, 0x04, 0x52, 0x0d, 0x31, 0x3a,
+	0xf6, 0xfc, 0xd1, 0xc0, 0x63, 0xee, 0x55, 0x94, 0x57, 0x43, 0xd3, 0x37, 0xbf, 0x32, 0x88, 0x35,
+	0x3b, 0x26, 0xbc, 0x57, 0xb0, 0xa9, 0xc3, 0x97, 0x36, 0xf5, 0xdc, 0x4d, 0x09, 0x5f, 0x06, 0x2b,
+	0x64, 0x40, 0xb4, 0xbe, 0x4b, 0x7c, 0xeb, 0x17, 0xb9, 0xf5, 0xcb, 0x1e, 0xd4, 0x33, 0xff, 0x23,
+	0x00, 0xa7, 0x66, 0xf3, 0x65, 0x6c, 0x1a, 0xd4, 0x65, 0x8b, 0xcb, 0xb3, 0x85, 0xad, 0xfc, 0xf0,
+	0x56, 0x44, 0x2c, 0x2e, 0x61, 0x0c, 0x45, 0x4b, 0xc2, 0x1a, 0xbe, 0xe3, 0xfd, 0x5a, 0xa2, 0x7c,
+	0x31, 0x27, 0xd1, 0x0c, 0x00, 0xb3, 0x00, 0x74, 0xec, 0x23, 0xe2, 0x58, 0x6c, 0xc1, 0x7b, 0x27,
+	0x40, 0x00, 0xa2, 0xfc, 0x29, 0x04, 0x62, 0x4d, 0xed, 0x90, 0xe8, 0x7d, 0x93, 0xc0, 0x75, 0x10,
+	0x9a, 0xae, 0xae, 0xe8, 0xc9, 0x58, 0x0e, 0x55, 0xcb, 0x28, 0x64, 0xe8, 0xa7, 0x42, 0x19, 0x3a,
+	0x13, 0x4a, 0x19, 0x24, 0x2c, 0x32, 0x70, 0x7d, 0x07, 0xc3, 0xdc, 0x41, 0xc0, 0x40, 0xde, 0x80,
+	0xc9, 0x80, 0x98, 0x61, 0xb9, 0xc4, 0x39, 0xc2, 0x62, 0x85, 0x45, 0xd0, 0xf4, 0xed, 0x87, 0x72,
+	0xf1, 0xea, 0x50, 0xbe, 0x08, 0xe2, 0xec, 0xb0, 0x17, 0xe7, 0x47, 0x54, 0x88, 0xe9, 0x60, 0xca,
+	0x8f, 0x06, 0x76, 0x9d, 0x30, 0xa4, 0x5f, 0x8a, 0x4b, 0xdf, 0xee, 0x75, 0xd2, 0xc1, 0xd4, 0x3b,
+	0xca, 0x95, 0x8f, 0x25, 0x00, 0x1b, 0x8e, 0x71, 0x64, 0x98, 0xa4, 0x43, 0x74, 0xdf, 0xd0, 0xa7,
+	0x96, 0x9a, 0x0c, 0x12, 0x6d, 0xd2, 0x31, 0x2c, 0x95, 0x0f, 0x5d, 0x1e, 0xbe, 0x18, 0x02, 0x1c,
+	0x54, 0x64, 0x10, 0xe6, 0x19, 0x3b, 0xac, 0x04, 0x5a, 0x24, 0x28, 0x46, 0x2c, 0x7d, 0x8a, 0x9c,
+	0xb9, 0x1d, 0x39, 0xed, 0xb6, 0xf2, 0x1b, 0x09, 0xac, 0xb1, 0x2e, 0xed, 0xb9, 0x44, 0x6f, 0x06,
+	0x7f, 0xe6, 0xb0, 0x63, 0xa6, 0x87, 0xdd, 0x43, 0xcf, 0x18, 0xfe, 0x2d, 0x06, 0x09, 0xed, 0xd9,
+	0x16, 0x25, 0x2a, 0xf3, 0xcf, 0xcb, 0x64, 0xd2, 0x07, 0xb2, 0x05, 0x07, 0x4b, 0x20, 0x46, 0x2c,
+	0xcd, 0x66, 0x9d, 0xc4, 0x6d, 0x59, 0xd9, 0x79, 0xf5, 0xfc, 0x64, 0x38, 0xa5, 0xab, 0xe2, 0x91,
+	0xa3, 0x29, 0xe3, 0xeb, 0x9f, 0x85, 0x00, 0x98, 0xfd, 0x19, 0x06, 0xfe, 0x3f, 0xb8, 0x56, 0x28,
+	0x95, 0x2a, 0xcd, 0xa6, 0xda, 0x3a, 0x68, 0x54, 0xd4, 0xfd, 0x5a, 0xb3, 0x51, 0x29, 0x55, 0xef,
+	0x55, 0x2b, 0xe5, 0xd4, 0x42, 0x66, 0xe3, 0x78, 0x94, 0x5b, 0x9b, 0x11, 0xef, 0x5b, 0xb4, 0x47,
+	0x34, 0xe3, 0x91, 0x41, 0x74, 0x78, 0x0b, 0xc0, 0x20, 0x5f, 0xad, 0x5e, 0xac, 0x97, 0x0f, 0x52,
+	0x52, 0x66, 0xf5, 0x78, 0x94, 0x4b, 0xcd, 0x58, 0x6a, 0x76, 0xdb, 0xd6, 0x87, 0xf0, 0x3b, 0x20,
+	0x1d, 0xa4, 0xae, 0xd7, 0x1e, 0x1c, 0xa8, 0x85, 0x72, 0x19, 0x55, 0x9a, 0xcd, 0x54, 0xe8, 0xac,
+	0x9a, 0xba, 0x65, 0x0e, 0x0b, 0xd3, 0x3f, 0x91, 0xad, 0x05, 0x19, 0x2b, 0x3f, 0xac, 0xa0, 0x03,
+	0xae, 0x29, 0x9c, 0xb9, 0x76, 0x3c, 0xca, 0xbd, 0x30, 0xe3, 0xaa, 0x1c, 0x11, 0x67, 0xc8, 0x95,
+	0xbd, 0x03, 0x36, 0x83, 0x3c, 0x85, 0xda, 0x81, 0x5a, 0xbf, 0xe7, 0xab, 0xab, 0x34, 0x53, 0x91,
+	0xcc, 0xe6, 0xf1, 0x28, 0x97, 0x9e, 0xb1, 0x16, 0xac, 0x61, 0xfd, 0x51, 0xc1, 0xff, 0x13, 0x5b,
+	0x26, 0xf6, 0x8b, 0xdf, 0x65, 0x17, 0x3e, 0xfd, 0x7d, 0x76, 0xe1, 0xf5, 0x6f, 0x16, 0x41, 0xee,
+	0xaa, 0xb3, 0x03, 0x12, 0xf0, 0x66, 0xa9, 0x5e, 0x6b, 0xa1, 0x42, 0xa9, 0xa5, 0x96, 0xea, 0xe5,
+	0x8a, 0x7a, 0xbf, 0xda, 0x6c, 0xd5, 0xd1, 0x81, 0x5a, 0x6f, 0x54, 0x50, 0xa1, 0x55, 0xad, 0xd7,
+	0x2e, 0x0a, 0x6d, 0xfe, 0x78, 0x94, 0x7b, 0xe3, 0x2a, 0xd9, 0xc1, 0x80, 0xbf, 0x0f, 0x5e, 0x9b,
+	0x4b, 0x4d, 0xb5, 0x56, 0x6d, 0xa5, 0xa4, 0xcc, 0xd6, 0xf1, 0x28, 0xf7, 0xd2, 0x55, 0xf2, 0xab,
+	0x96, 0xe1, 0xc2, 0x0f, 0xc0, 0xad, 0xb9, 0x04, 0xef, 0x55, 0x77, 0x51, 0xa1, 0x55, 0x49, 0x85,
+	0x32, 0x6f, 0x1c, 0x8f, 0x72, 0xaf, 0x5e, 0x25, 0x5b, 0x0c, 0x4d, 0x32, 0xb7, 0xf8, 0xdd, 0x4a,
+	0xad, 0xd2, 0xac, 0x36, 0x53, 0xe1, 0xf9, 0xc4, 0xef, 0x12, 0x8b, 0x50, 0x83, 0xc2, 0x9f, 0x82,
+	0xb7, 0xe6, 0x12, 0x5f, 0x28, 0xef, 0x55, 0x6b, 0x6a, 0x03, 0xd5, 0x1b, 0xf5, 0x66, 0xa5, 0x9c,
+	0x8a, 0x64, 0xee, 0x1c, 0x8f, 0x72, 0xb7, 0xaf, 0xd2, 0xc2, 0x6f, 0x5d, 0x71, 0xbb, 0x10, 0xfd,
+	0x19, 0x75, 0xb1, 0x1a, 0x6c, 0xb4, 0x2a, 0xe5, 0xd4, 0xe2, 0x33, 0xe8, 0xf2, 0x27, 0x06, 0xfc,
+	0x19, 0x78, 0xfb, 0x99, 0xfd, 0x2a, 0x3c, 0x50, 0x4b, 0x85, 0x5a, 0xa9, 0xf2, 0xa0, 0x52, 0x4e,
+	0x45, 0x33, 0xdf, 0x3d, 0x1e, 0xe5, 0xfe, 0xef, 0x19, 0x1c, 0xc4, 0x66, 0x89, 0x2d, 0x1d, 0x93,
+	0xe8, 0x99, 0x08, 0xeb, 0x80, 0xd7, 0xff, 0x20, 0x81, 0xb5, 0x0b, 0xa7, 0x09, 0xdc, 0x05, 0xb9,
+	0x66, 0xab, 0x80, 0x76, 0x0b, 0xad, 0x8a, 0xfa, 0x70, 0xbf, 0x82, 0x0e, 0xd4, 0x4a, 0xad, 0x54,
+	0x2f, 0x57, 0x6b, 0xbb, 0xcc, 0x92, 0x56, 0xbd, 0xb8, 0x7f, 0x2f, 0xb5, 0x90, 0xb9, 0x71, 0x3c,
+	0xca, 0x5d, 0xbf, 0x50, 0x40, 0xc3, 0xfb, 0x51, 0x02, 0xdf, 0x05, 0x9b, 0x97, 0x09, 0x7a, 0xaf,
+	0x59, 0xaf, 0xa5, 0xa4, 0xcc, 0xf5, 0xe3, 0x51, 0x6e, 0xe3, 0x42, 0x21, 0x8c, 0x40, 0x58, 0x5a,
+	0x2c, 0x7f, 0xfe, 0xaf, 0xec, 0xc2, 0xa7, 0x27, 0x59, 0xe9, 0xf3, 0x93, 0xac, 0xf4, 0xc5, 0x49,
+	0x56, 0xfa, 0xe7, 0x49, 0x56, 0xfa, 0xd5, 0x57, 0xd9, 0x85, 0x2f, 0xbe, 0xca, 0x2e, 0xfc, 0xfd,
+	0xab, 0xec, 0xc2, 0x8f, 0x94, 0xb3, 0xcb, 0x84, 0x8d, 0x4b, 0x3d, 0x3f, 0xe0, 0xff, 0x8b, 0x8d,
+	0xd2, 0x8e, 0xf2, 0xdf, 0x4a, 0x6f, 0xfd, 0x77, 0x00, 0xb1, 0x6f, 0x59, 0xb0, 0x26, 0x19, 0x00,
+	0x00,
+}
+
+func (this *AccessTypeParam) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessTypeParam)
+	if !ok {
+		that2, ok := that.(AccessTypeParam)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Permission != that1.Permission {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
 	if that1 == nil {
@@ -616,6 +1378,43 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.CompileCost != that1.CompileCost {
 		return false
 	}
+	if this.UniqueLabelPerCreator != that1.UniqueLabelPerCreator {
+		return false
+	}
+	if this.MaxWasmSize != that1.MaxWasmSize {
+		return false
+	}
+	if this.MaxLabelSize != that1.MaxLabelSize {
+		return false
+	}
+	if this.MaxDecompressedWasmSize != that1.MaxDecompressedWasmSize {
+		return false
+	}
+	if len(this.StorageDepositPerByte) != len(that1.StorageDepositPerByte) {
+		return false
+	}
+	for i := range this.StorageDepositPerByte {
+		if !this.StorageDepositPerByte[i].Equal(&that1.StorageDepositPerByte[i]) {
+			return false
+		}
+	}
+	if this.MaxContractStorageBytes != that1.MaxContractStorageBytes {
+		return false
+	}
+	if this.MaxContractStorageKeys != that1.MaxContractStorageKeys {
+		return false
+	}
+	if this.StargateQueryGasPerByte != that1.StargateQueryGasPerByte {
+		return false
+	}
+	if len(this.UploadSessionDeposit) != len(that1.UploadSessionDeposit) {
+		return false
+	}
+	for i := range this.UploadSessionDeposit {
+		if !this.UploadSessionDeposit[i].Equal(&that1.UploadSessionDeposit[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *CodeInfo) Equal(that interface{}) bool {
@@ -646,16 +1445,19 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
 		return false
 	}
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
+	}
 	return true
 }
-func (this *ContractInfo) Equal(that interface{}) bool {
+func (this *CodeMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractInfo)
+	that1, ok := that.(*CodeMetadata)
 	if !ok {
-		that2, ok := that.(ContractInfo)
+		that2, ok := that.(CodeMetadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -667,37 +1469,60 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
+	if this.Source != that1.Source {
 		return false
 	}
-	if this.Creator != that1.Creator {
+	if this.Builder != that1.Builder {
 		return false
 	}
-	if this.Admin != that1.Admin {
+	if !bytes.Equal(this.CodeHashAttestation, that1.CodeHashAttestation) {
 		return false
 	}
-	if this.Label != that1.Label {
+	return true
+}
+func (this *ContractStorage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorage)
+	if !ok {
+		that2, ok := that.(ContractStorage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.Created.Equal(that1.Created) {
+	if this.Bytes != that1.Bytes {
 		return false
 	}
-	if this.IBCPortID != that1.IBCPortID {
+	if len(this.Deposit) != len(that1.Deposit) {
 		return false
 	}
-	if !this.Extension.Equal(that1.Extension) {
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	if this.Keys != that1.Keys {
 		return false
 	}
 	return true
 }
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+func (this *StorageQuota) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
+	that1, ok := that.(*StorageQuota)
 	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
+		that2, ok := that.(StorageQuota)
 		if ok {
 			that1 = &that2
 		} else {
@@ -709,28 +1534,25 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Operation != that1.Operation {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
+	if this.MaxBytes != that1.MaxBytes {
 		return false
 	}
-	if !this.Updated.Equal(that1.Updated) {
+	if this.MaxKeys != that1.MaxKeys {
 		return false
 	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
+	if this.Unlimited != that1.Unlimited {
 		return false
 	}
 	return true
 }
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+func (this *FeeAllowance) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AbsoluteTxPosition)
+	that1, ok := that.(*FeeAllowance)
 	if !ok {
-		that2, ok := that.(AbsoluteTxPosition)
+		that2, ok := that.(FeeAllowance)
 		if ok {
 			that1 = &that2
 		} else {
@@ -742,22 +1564,27 @@ func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BlockHeight != that1.BlockHeight {
+	if this.Grantee != that1.Grantee {
 		return false
 	}
-	if this.TxIndex != that1.TxIndex {
+	if len(this.SpendLimit) != len(that1.SpendLimit) {
 		return false
 	}
+	for i := range this.SpendLimit {
+		if !this.SpendLimit[i].Equal(&that1.SpendLimit[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *Model) Equal(that interface{}) bool {
+func (this *ContractMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Model)
+	that1, ok := that.(*ContractMetadata)
 	if !ok {
-		that2, ok := that.(Model)
+		that2, ok := that.(ContractMetadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -769,558 +1596,3795 @@ func (this *Model) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if this.Description != that1.Description {
 		return false
 	}
-	if !bytes.Equal(this.Value, that1.Value) {
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.IconURI != that1.IconURI {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
 		return false
 	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
 	return true
 }
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	if m.Permission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
+	if this.Creator != that1.Creator {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Admin != that1.Admin {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompileCost != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
-		i--
-		dAtA[i] = 0x28
+	if this.Label != that1.Label {
+		return false
 	}
-	if m.InstanceCost != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
-		i--
-		dAtA[i] = 0x20
+	if !this.Created.Equal(that1.Created) {
+		return false
 	}
-	if m.GasMultiplier != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
-		i--
-		dAtA[i] = 0x18
+	if this.IBCPortID != that1.IBCPortID {
+		return false
 	}
-	if m.InstantiateDefaultPermission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
-		i--
-		dAtA[i] = 0x10
+	if !this.Extension.Equal(that1.Extension) {
+		return false
 	}
-	{
-		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if this.PendingAdmin != that1.PendingAdmin {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MigrationDelay != that1.MigrationDelay {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
+	if this.Operation != that1.Operation {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Updated.Equal(that1.Updated) {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
 }
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Extension != nil {
-		{
-			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	that1, ok := that.(*AbsoluteTxPosition)
+	if !ok {
+		that2, ok := that.(AbsoluteTxPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
+	if this.BlockHeight != that1.BlockHeight {
+		return false
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	if this.TxIndex != that1.TxIndex {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+func (this *InactiveContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*InactiveContractInfo)
+	if !ok {
+		that2, ok := that.(InactiveContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if this.Reason != that1.Reason {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.ProposalID != that1.ProposalID {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Deactivated.Equal(that1.Deactivated) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UploadSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+
+	that1, ok := that.(*UploadSession)
+	if !ok {
+		that2, ok := that.(UploadSession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if !bytes.Equal(this.Checksum, that1.Checksum) {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	if this.TotalSize != that1.TotalSize {
+		return false
 	}
-	if m.GasMultiplier != 0 {
-		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	if this.ReceivedSize != that1.ReceivedSize {
+		return false
 	}
-	if m.InstanceCost != 0 {
-		n += 1 + sovTypes(uint64(m.InstanceCost))
+	if this.ChunkCount != that1.ChunkCount {
+		return false
 	}
-	if m.CompileCost != 0 {
-		n += 1 + sovTypes(uint64(m.CompileCost))
+	if !this.InstantiatePermission.Equal(that1.InstantiatePermission) {
+		return false
 	}
-	return n
-}
-
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return true
 }
-
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PendingMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+
+	that1, ok := that.(*PendingMigration)
+	if !ok {
+		that2, ok := that.(PendingMigration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Contract != that1.Contract {
+		return false
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Sender != that1.Sender {
+		return false
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
 	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.ExecuteHeight != that1.ExecuteHeight {
+		return false
 	}
-	return n
+	return true
 }
+func (this *MigrationAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MigrationAllowlist)
+	if !ok {
+		that2, ok := that.(MigrationAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+	if len(this.CodeIDs) != len(that1.CodeIDs) {
+		return false
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	for i := range this.CodeIDs {
+		if this.CodeIDs[i] != that1.CodeIDs[i] {
+			return false
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(this.Checksums) != len(that1.Checksums) {
+		return false
 	}
-	return n
+	for i := range this.Checksums {
+		if !bytes.Equal(this.Checksums[i], that1.Checksums[i]) {
+			return false
+		}
+	}
+	if this.Governance != that1.Governance {
+		return false
+	}
+	return true
 }
+func (this *Schedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if len(this.GasDeposit) != len(that1.GasDeposit) {
+		return false
+	}
+	for i := range this.GasDeposit {
+		if !this.GasDeposit[i].Equal(&that1.GasDeposit[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PrivilegedContract) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrivilegedContract)
+	if !ok {
+		that2, ok := that.(PrivilegedContract)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.BeginBlock != that1.BeginBlock {
+		return false
+	}
+	if this.EndBlock != that1.EndBlock {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *AcceptedStargateQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AcceptedStargateQuery)
+	if !ok {
+		that2, ok := that.(AcceptedStargateQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.ResponseType != that1.ResponseType {
+		return false
+	}
+	if this.Encoding != that1.Encoding {
+		return false
+	}
+	return true
+}
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadSessionDeposit) > 0 {
+		for iNdEx := len(m.UploadSessionDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadSessionDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.StargateQueryGasPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StargateQueryGasPerByte))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxContractStorageKeys != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractStorageKeys))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxContractStorageBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractStorageBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for iNdEx := len(m.StorageDepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MaxDecompressedWasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxDecompressedWasmSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxLabelSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLabelSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxWasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWasmSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UniqueLabelPerCreator {
+		i--
+		if m.UniqueLabelPerCreator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHashAttestation) > 0 {
+		i -= len(m.CodeHashAttestation)
+		copy(dAtA[i:], m.CodeHashAttestation)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHashAttestation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keys != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Keys))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxKeys != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxKeys))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IconURI) > 0 {
+		i -= len(m.IconURI)
+		copy(dAtA[i:], m.IconURI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IconURI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigrationDelay != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MigrationDelay))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PendingAdmin) > 0 {
+		i -= len(m.PendingAdmin)
+		copy(dAtA[i:], m.PendingAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PendingAdmin)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Extension != nil {
+		{
+			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InactiveContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InactiveContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InactiveContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deactivated != nil {
+		{
+			size, err := m.Deactivated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceivedSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReceivedSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Governance {
+		i--
+		if m.Governance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checksums[iNdEx])
+			copy(dAtA[i:], m.Checksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checksums[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA10 := make([]byte, len(m.CodeIDs)*10)
+		var j9 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTypes(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasDeposit) > 0 {
+		for iNdEx := len(m.GasDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivilegedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivilegedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivilegedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndBlock {
+		i--
+		if m.EndBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeginBlock {
+		i--
+		if m.BeginBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedStargateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedStargateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedStargateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encoding != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Encoding))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ResponseType) > 0 {
+		i -= len(m.ResponseType)
+		copy(dAtA[i:], m.ResponseType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ResponseType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.UniqueLabelPerCreator {
+		n += 2
+	}
+	if m.MaxWasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWasmSize))
+	}
+	if m.MaxLabelSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLabelSize))
+	}
+	if m.MaxDecompressedWasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxDecompressedWasmSize))
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for _, e := range m.StorageDepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxContractStorageBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractStorageBytes))
+	}
+	if m.MaxContractStorageKeys != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractStorageKeys))
+	}
+	if m.StargateQueryGasPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.StargateQueryGasPerByte))
+	}
+	if len(m.UploadSessionDeposit) > 0 {
+		for _, e := range m.UploadSessionDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CodeHashAttestation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		n += 1 + sovTypes(uint64(m.Bytes))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Keys != 0 {
+		n += 1 + sovTypes(uint64(m.Keys))
+	}
+	return n
+}
+
+func (m *StorageQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBytes))
+	}
+	if m.MaxKeys != 0 {
+		n += 1 + sovTypes(uint64(m.MaxKeys))
+	}
+	if m.Unlimited {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IconURI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PendingAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MigrationDelay != 0 {
+		n += 1 + sovTypes(uint64(m.MigrationDelay))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *InactiveContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	if m.Deactivated != nil {
+		l = m.Deactivated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *UploadSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovTypes(uint64(m.TotalSize))
+	}
+	if m.ReceivedSize != 0 {
+		n += 1 + sovTypes(uint64(m.ReceivedSize))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovTypes(uint64(m.ChunkCount))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *PendingMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteHeight))
+	}
+	return n
+}
+
+func (m *MigrationAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Checksums) > 0 {
+		for _, b := range m.Checksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Governance {
+		n += 2
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if len(m.GasDeposit) > 0 {
+		for _, e := range m.GasDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrivilegedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BeginBlock {
+		n += 2
+	}
+	if m.EndBlock {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *AcceptedStargateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ResponseType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Encoding != 0 {
+		n += 1 + sovTypes(uint64(m.Encoding))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueLabelPerCreator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UniqueLabelPerCreator = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmSize", wireType)
+			}
+			m.MaxWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLabelSize", wireType)
+			}
+			m.MaxLabelSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLabelSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDecompressedWasmSize", wireType)
+			}
+			m.MaxDecompressedWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDecompressedWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositPerByte = append(m.StorageDepositPerByte, types.DecCoin{})
+			if err := m.StorageDepositPerByte[len(m.StorageDepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStorageBytes", wireType)
+			}
+			m.MaxContractStorageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractStorageBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStorageKeys", wireType)
+			}
+			m.MaxContractStorageKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractStorageKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryGasPerByte", wireType)
+			}
+			m.StargateQueryGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StargateQueryGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSessionDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadSessionDeposit = append(m.UploadSessionDeposit, types.Coin{})
+			if err := m.UploadSessionDeposit[len(m.UploadSessionDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &CodeMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHashAttestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHashAttestation = append(m.CodeHashAttestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHashAttestation == nil {
+				m.CodeHashAttestation = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractStorage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeys", wireType)
+			}
+			m.MaxKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlimited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IconURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extension == nil {
+				m.Extension = &types1.Any{}
+			}
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDelay", wireType)
+			}
+			m.MigrationDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrationDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1343,17 +5407,17 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Value = 0
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1363,11 +5427,100 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1389,7 +5542,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,17 +5565,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Permission = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1432,16 +5585,16 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			var stringLen uint64
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1451,24 +5604,11 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1490,7 +5630,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Model) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1513,17 +5653,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1533,30 +5673,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InactiveContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InactiveContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InactiveContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1566,16 +5791,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			m.GasMultiplier = 0
+			m.ProposalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1585,16 +5823,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasMultiplier |= uint64(b&0x7F) << shift
+				m.ProposalID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
 			}
-			m.InstanceCost = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1604,16 +5842,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstanceCost |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deactivated == nil {
+				m.Deactivated = &AbsoluteTxPosition{}
+			}
+			if err := m.Deactivated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.CompileCost = 0
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1623,7 +5878,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompileCost |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1649,7 +5904,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *UploadSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,15 +5927,15 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UploadSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UploadSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1707,16 +5962,73 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedSize", wireType)
+			}
+			m.ReceivedSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1726,27 +6038,31 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1773,10 +6089,30 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1798,7 +6134,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *PendingMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,17 +6157,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1841,14 +6177,27 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,13 +6225,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var stringLen uint64
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1892,29 +6241,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1924,29 +6260,31 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
 			}
-			var msglen int
+			m.ExecuteHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1956,33 +6294,142 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExecuteHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrationAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
 			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1992,29 +6439,29 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			m.Checksums = append(m.Checksums, make([]byte, postIndex-iNdEx))
+			copy(m.Checksums[len(m.Checksums)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2024,28 +6471,12 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
-			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Governance = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2067,7 +6498,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *Schedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2090,17 +6521,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Operation = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2110,16 +6541,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2129,16 +6560,67 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2148,33 +6630,50 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDeposit", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2184,24 +6683,24 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
+			m.GasDeposit = append(m.GasDeposit, types.Coin{})
+			if err := m.GasDeposit[len(m.GasDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2225,7 +6724,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *PrivilegedContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2248,17 +6747,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrivilegedContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrivilegedContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2268,16 +6767,29 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
 			}
-			m.TxIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2287,7 +6799,47 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeginBlock = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndBlock = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2313,7 +6865,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *AcceptedStargateQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2336,17 +6888,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptedStargateQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptedStargateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2356,31 +6908,29 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2390,26 +6940,43 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.ResponseType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			m.Encoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Encoding |= StargateQueryEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
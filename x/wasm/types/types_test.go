@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"context"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -45,12 +46,16 @@ func TestContractInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *ContractInfo) { c.Admin = "invalid address" },
 			expError:   true,
 		},
-		"label empty": {
-			srcMutator: func(c *ContractInfo) { c.Label = "" },
+		"pending admin set": {
+			srcMutator: func(c *ContractInfo) { c.PendingAdmin = sdk.AccAddress(randBytes(SDKAddrLen)).String() },
+			expError:   false,
+		},
+		"pending admin not an address": {
+			srcMutator: func(c *ContractInfo) { c.PendingAdmin = "invalid address" },
 			expError:   true,
 		},
-		"label exceeds limit": {
-			srcMutator: func(c *ContractInfo) { c.Label = strings.Repeat("a", MaxLabelSize+1) },
+		"label empty": {
+			srcMutator: func(c *ContractInfo) { c.Label = "" },
 			expError:   true,
 		},
 		"invalid extension": {
@@ -110,6 +115,32 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
 			expError:   true,
 		},
+		"Instantiate config any of addresses": {
+			srcMutator: func(c *CodeInfo) {
+				c.InstantiateConfig = AccessTypeAnyOfAddresses.With(make([]byte, SDKAddrLen), bytes.Repeat([]byte{1}, SDKAddrLen))
+			},
+		},
+		"Instantiate config any of addresses empty": {
+			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{Permission: AccessTypeAnyOfAddresses} },
+			expError:   true,
+		},
+		"metadata": {
+			srcMutator: func(c *CodeInfo) {
+				c.Metadata = &CodeMetadata{
+					Source:              "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+					Builder:             "cosmwasm/workspace-optimizer:0.12.9",
+					CodeHashAttestation: c.CodeHash,
+				}
+			},
+		},
+		"metadata invalid": {
+			srcMutator: func(c *CodeInfo) { c.Metadata = &CodeMetadata{Source: "http://example.com"} },
+			expError:   true,
+		},
+		"metadata code hash attestation does not match": {
+			srcMutator: func(c *CodeInfo) { c.Metadata = &CodeMetadata{CodeHashAttestation: bytes.Repeat([]byte{1}, 32)} },
+			expError:   true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -124,6 +155,133 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 	}
 }
 
+func TestCodeMetadataValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src      CodeMetadata
+		expError bool
+	}{
+		"empty": {},
+		"all set": {
+			src: CodeMetadata{
+				Source:              "https://github.com/CosmWasm/cosmwasm/tree/v1.0.0/contracts/hackatom",
+				Builder:             "cosmwasm/workspace-optimizer:0.12.9",
+				CodeHashAttestation: bytes.Repeat([]byte{1}, 32),
+			},
+		},
+		"builder with registry": {
+			src: CodeMetadata{Builder: "ghcr.io/cosmwasm/rust-optimizer:0.12.9"},
+		},
+		"source not https": {
+			src:      CodeMetadata{Source: "http://github.com/CosmWasm/cosmwasm"},
+			expError: true,
+		},
+		"source not absolute": {
+			src:      CodeMetadata{Source: "github.com/CosmWasm/cosmwasm"},
+			expError: true,
+		},
+		"source too long": {
+			src:      CodeMetadata{Source: "https://example.com/" + strings.Repeat("a", MaxSourceURLSize)},
+			expError: true,
+		},
+		"builder without tag": {
+			src:      CodeMetadata{Builder: "cosmwasm/workspace-optimizer"},
+			expError: true,
+		},
+		"builder without repository": {
+			src:      CodeMetadata{Builder: "optimizer:0.12.9"},
+			expError: true,
+		},
+		"builder upper case name": {
+			src:      CodeMetadata{Builder: "CosmWasm/workspace-optimizer:0.12.9"},
+			expError: true,
+		},
+		"code hash attestation wrong size": {
+			src:      CodeMetadata{CodeHashAttestation: []byte{1}},
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got := spec.src.ValidateBasic()
+			if spec.expError {
+				require.Error(t, got)
+				return
+			}
+			require.NoError(t, got)
+		})
+	}
+}
+
+func TestContractMetadataValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src      ContractMetadata
+		expError bool
+	}{
+		"empty": {},
+		"all set": {
+			src: ContractMetadata{
+				Description: "An example contract",
+				Website:     "https://example.com",
+				IconURI:     "https://example.com/icon.png",
+				Tags:        []string{"defi", "liquid-staking"},
+			},
+		},
+		"ipfs icon": {
+			src: ContractMetadata{IconURI: "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"},
+		},
+		"description too long": {
+			src:      ContractMetadata{Description: strings.Repeat("a", MaxContractDescriptionSize+1)},
+			expError: true,
+		},
+		"website not https": {
+			src:      ContractMetadata{Website: "http://example.com"},
+			expError: true,
+		},
+		"website ipfs": {
+			src:      ContractMetadata{Website: "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"},
+			expError: true,
+		},
+		"icon not absolute": {
+			src:      ContractMetadata{IconURI: "example.com/icon.png"},
+			expError: true,
+		},
+		"icon too long": {
+			src:      ContractMetadata{IconURI: "https://example.com/" + strings.Repeat("a", MaxContractURISize)},
+			expError: true,
+		},
+		"too many tags": {
+			src:      ContractMetadata{Tags: strings.Split(strings.Repeat("a,", MaxContractTags)+"b", ",")},
+			expError: true,
+		},
+		"tag upper case": {
+			src:      ContractMetadata{Tags: []string{"DeFi"}},
+			expError: true,
+		},
+		"tag empty": {
+			src:      ContractMetadata{Tags: []string{""}},
+			expError: true,
+		},
+		"tag too long": {
+			src:      ContractMetadata{Tags: []string{strings.Repeat("a", MaxContractTagSize+1)}},
+			expError: true,
+		},
+		"duplicate tags": {
+			src:      ContractMetadata{Tags: []string{"defi", "defi"}},
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got := spec.src.ValidateBasic()
+			if spec.expError {
+				require.Error(t, got)
+				return
+			}
+			require.NoError(t, got)
+		})
+	}
+}
+
 func TestContractInfoSetExtension(t *testing.T) {
 	anyTime := time.Now().UTC()
 	aNestedProtobufExt := func() ContractInfoExtension {
@@ -445,6 +603,56 @@ func TestAccesConfigSubset(t *testing.T) {
 			check:    AccessConfig{Permission: AccessTypeNobody},
 			isSubSet: false,
 		},
+		"any of addresses(same) <= only": {
+			superSet: AccessConfig{Permission: AccessTypeOnlyAddress, Address: "owner"},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner"}},
+			isSubSet: true,
+		},
+		"any of addresses(more) > only": {
+			superSet: AccessConfig{Permission: AccessTypeOnlyAddress, Address: "owner"},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "other"}},
+			isSubSet: false,
+		},
+		"any of addresses <= everybody": {
+			superSet: AccessConfig{Permission: AccessTypeEverybody},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"foobar"}},
+			isSubSet: true,
+		},
+		"any of addresses > nobody": {
+			superSet: AccessConfig{Permission: AccessTypeNobody},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"foobar"}},
+			isSubSet: false,
+		},
+		"nobody <= any of addresses": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner"}},
+			check:    AccessConfig{Permission: AccessTypeNobody},
+			isSubSet: true,
+		},
+		"only(contained) <= any of addresses": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "other"}},
+			check:    AccessConfig{Permission: AccessTypeOnlyAddress, Address: "other"},
+			isSubSet: true,
+		},
+		"only(not contained) > any of addresses": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "other"}},
+			check:    AccessConfig{Permission: AccessTypeOnlyAddress, Address: "foobar"},
+			isSubSet: false,
+		},
+		"any of addresses(subset) <= any of addresses": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "other", "third"}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"third", "owner"}},
+			isSubSet: true,
+		},
+		"any of addresses(other) > any of addresses": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "other"}},
+			check:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner", "foobar"}},
+			isSubSet: false,
+		},
+		"everybody > any of addresses": {
+			superSet: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"owner"}},
+			check:    AccessConfig{Permission: AccessTypeEverybody},
+			isSubSet: false,
+		},
 	}
 
 	for name, spec := range specs {
@@ -454,3 +662,126 @@ func TestAccesConfigSubset(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageQuotaCheck(t *testing.T) {
+	specs := map[string]struct {
+		quota    StorageQuota
+		defaults StorageQuota
+		bytes    uint64
+		keys     uint64
+		expErr   bool
+	}{
+		"no limits": {
+			bytes: 1_000_000,
+			keys:  1_000,
+		},
+		"within limits": {
+			quota: StorageQuota{MaxBytes: 100, MaxKeys: 2},
+			bytes: 100,
+			keys:  2,
+		},
+		"bytes exceed limit": {
+			quota:  StorageQuota{MaxBytes: 100},
+			bytes:  101,
+			expErr: true,
+		},
+		"keys exceed limit": {
+			quota:  StorageQuota{MaxKeys: 2},
+			keys:   3,
+			expErr: true,
+		},
+		"bytes exceed default limit": {
+			quota:    StorageQuota{MaxKeys: 2},
+			defaults: StorageQuota{MaxBytes: 100},
+			bytes:    101,
+			expErr:   true,
+		},
+		"override of default limit": {
+			quota:    StorageQuota{MaxBytes: 200},
+			defaults: StorageQuota{MaxBytes: 100},
+			bytes:    101,
+		},
+		"unlimited override of default limits": {
+			quota:    StorageQuota{Unlimited: true},
+			defaults: StorageQuota{MaxBytes: 100, MaxKeys: 2},
+			bytes:    101,
+			keys:     3,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.quota.WithDefaults(spec.defaults).Check(spec.bytes, spec.keys)
+			if spec.expErr {
+				assert.True(t, ErrLimit.Is(err), err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStorageQuotaValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    StorageQuota
+		expErr bool
+	}{
+		"empty": {},
+		"limits": {
+			src: StorageQuota{MaxBytes: 100, MaxKeys: 2},
+		},
+		"unlimited": {
+			src: StorageQuota{Unlimited: true},
+		},
+		"unlimited with max bytes": {
+			src:    StorageQuota{MaxBytes: 100, Unlimited: true},
+			expErr: true,
+		},
+		"unlimited with max keys": {
+			src:    StorageQuota{MaxKeys: 2, Unlimited: true},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				assert.True(t, ErrInvalid.Is(err), err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestScheduleValidateBasic(t *testing.T) {
+	myAddr := sdk.AccAddress(rand.Bytes(ContractAddrLen)).String()
+	specs := map[string]struct {
+		src    Schedule
+		expErr bool
+	}{
+		"all good": {
+			src: Schedule{ID: 1, Contract: myAddr, NextHeight: 1, Interval: 1, Msg: []byte(`{}`), GasLimit: 1},
+		},
+		"max interval": {
+			src: Schedule{ID: 1, Contract: myAddr, NextHeight: 1, Interval: math.MaxInt32, Msg: []byte(`{}`), GasLimit: 1},
+		},
+		"interval exceeds max": {
+			src:    Schedule{ID: 1, Contract: myAddr, NextHeight: 1, Interval: math.MaxInt32 + 1, Msg: []byte(`{}`), GasLimit: 1},
+			expErr: true,
+		},
+		"empty gas limit": {
+			src:    Schedule{ID: 1, Contract: myAddr, NextHeight: 1, Msg: []byte(`{}`)},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1,33 +1,121 @@
 package types
 
 import (
+	"net/url"
+	"regexp"
+	"strings"
+
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
-var (
-	// MaxLabelSize is the longest label that can be used when Instantiating a contract
-	MaxLabelSize = 128 // extension point for chains to customize via compile flag.
+const (
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract
+	MaxSaltSize = 64
+
+	// MaxSourceURLSize is the longest source url that can be stored with a code
+	MaxSourceURLSize = 256
+
+	// MaxBuilderSize is the longest builder image name with tag that can be stored with a code
+	MaxBuilderSize = 128
+
+	// MaxContractDescriptionSize is the longest description that can be stored with a contract
+	MaxContractDescriptionSize = 512
+
+	// MaxContractURISize is the longest website or icon uri that can be stored with a contract
+	MaxContractURISize = 256
 
-	// MaxWasmSize is the largest a compiled contract code can be when storing code on chain
-	MaxWasmSize = 800 * 1024 // extension point for chains to customize via compile flag.
+	// MaxContractTags is the maximum number of tags that can be stored with a contract
+	MaxContractTags = 10
+
+	// MaxContractTagSize is the longest tag that can be stored with a contract
+	MaxContractTagSize = 32
+
+	// MaxMigrationDelay is the longest number of blocks a migration of a contract can be queued
+	MaxMigrationDelay = 1_000_000
 )
 
+// builderRegexp matches a docker image name with a mandatory tag, e.g. "cosmwasm/workspace-optimizer:0.12.9"
+var builderRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+
+// tagRegexp matches a lowercase keyword with inner dashes, e.g. "defi" or "liquid-staking"
+var tagRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// validateWasmCode ensures the code is not empty. The size limits are params and checked by the keeper.
 func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "is required")
 	}
-	if len(s) > MaxWasmSize {
-		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxWasmSize)
-	}
 	return nil
 }
 
+// validateLabel ensures the label is not empty. The length limit is a param and checked by the keeper.
 func validateLabel(label string) error {
 	if label == "" {
 		return sdkerrors.Wrap(ErrEmpty, "is required")
 	}
-	if len(label) > MaxLabelSize {
-		return sdkerrors.Wrap(ErrLimit, "cannot be longer than 128 characters")
+	return nil
+}
+
+// ValidateSalt ensure salt constraints
+func ValidateSalt(salt []byte) error {
+	switch n := len(salt); {
+	case n == 0:
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	case n > MaxSaltSize:
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}
+
+// validateSourceURL ensures the source is an absolute https url
+func validateSourceURL(source string) error {
+	return validateURI(source, MaxSourceURLSize, "https")
+}
+
+// validateURI ensures the uri is not longer than maxSize and is absolute with one of the given schemes. A host is
+// required for all schemes.
+func validateURI(uri string, maxSize int, schemes ...string) error {
+	if len(uri) > maxSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d characters", maxSize)
+	}
+	u, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	var knownScheme bool
+	for _, s := range schemes {
+		if u.Scheme == s {
+			knownScheme = true
+			break
+		}
+	}
+	if !knownScheme {
+		return sdkerrors.Wrapf(ErrInvalid, "must use %s", strings.Join(schemes, " or "))
+	}
+	if u.Host == "" {
+		return sdkerrors.Wrap(ErrInvalid, "host is required")
+	}
+	return nil
+}
+
+// validateBuilder ensures the builder is a docker image name with tag
+func validateBuilder(builder string) error {
+	if len(builder) > MaxBuilderSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d characters", MaxBuilderSize)
+	}
+	if !builderRegexp.MatchString(builder) {
+		return sdkerrors.Wrap(ErrInvalid, "must be a docker image name with tag")
+	}
+	return nil
+}
+
+// validateTag ensures the tag is a short lowercase keyword
+func validateTag(tag string) error {
+	if len(tag) > MaxContractTagSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d characters", MaxContractTagSize)
+	}
+	if !tagRegexp.MatchString(tag) {
+		return sdkerrors.Wrap(ErrInvalid, "must be lowercase alphanumeric with inner dashes")
 	}
 	return nil
 }